@@ -4,6 +4,8 @@
 package azure
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -30,3 +32,332 @@ func QuotedStringSlice(strs []string) string {
 
 	return sb.String()
 }
+
+// PolicyViolation describes a request which was denied by Azure Policy, as parsed from the `RequestDisallowedByPolicy`
+// error returned by Azure Resource Manager.
+type PolicyViolation struct {
+	PolicyAssignmentId          string
+	PolicyAssignmentName        string
+	PolicyAssignmentDisplayName string
+
+	PolicyDefinitionId          string
+	PolicyDefinitionName        string
+	PolicyDefinitionDisplayName string
+
+	PolicySetDefinitionId          string
+	PolicySetDefinitionName        string
+	PolicySetDefinitionDisplayName string
+
+	Effect string
+
+	// Fields contains the evaluated expressions which caused the request to be denied, when these are returned by the API
+	Fields []PolicyViolationField
+}
+
+// PolicyViolationField describes a field within the request which caused the request to be denied by Azure Policy
+type PolicyViolationField struct {
+	Path        string
+	Alias       string
+	Operator    string
+	Value       interface{}
+	TargetValue interface{}
+}
+
+// String returns a human-readable description of the Policy Violation
+func (v PolicyViolation) String() string {
+	lines := make([]string, 0)
+	if item := describePolicyItem(v.PolicyAssignmentDisplayName, v.PolicyAssignmentName, v.PolicyAssignmentId); item != "" {
+		lines = append(lines, fmt.Sprintf("Policy Assignment: %s", item))
+	}
+	if item := describePolicyItem(v.PolicySetDefinitionDisplayName, v.PolicySetDefinitionName, v.PolicySetDefinitionId); item != "" {
+		lines = append(lines, fmt.Sprintf("Policy Set Definition: %s", item))
+	}
+	if item := describePolicyItem(v.PolicyDefinitionDisplayName, v.PolicyDefinitionName, v.PolicyDefinitionId); item != "" {
+		lines = append(lines, fmt.Sprintf("Policy Definition: %s", item))
+	}
+	if v.Effect != "" {
+		lines = append(lines, fmt.Sprintf("Effect: %s", v.Effect))
+	}
+	for _, field := range v.Fields {
+		name := field.Path
+		if name == "" {
+			name = field.Alias
+		}
+		line := fmt.Sprintf("Field: %q", name)
+		if field.Value != nil {
+			line += fmt.Sprintf(" with the value %s", formatPolicyValue(field.Value))
+		}
+		if field.Operator != "" {
+			line += fmt.Sprintf(" matched the condition %q", field.Operator)
+			if field.TargetValue != nil {
+				line += fmt.Sprintf(" %s", formatPolicyValue(field.TargetValue))
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func describePolicyItem(displayName, name, id string) string {
+	label := displayName
+	if label == "" {
+		label = name
+	}
+
+	switch {
+	case label != "" && id != "":
+		return fmt.Sprintf("%q (%s)", label, id)
+	case label != "":
+		return fmt.Sprintf("%q", label)
+	}
+	return id
+}
+
+func formatPolicyValue(input interface{}) string {
+	if v, ok := input.(string); ok {
+		return fmt.Sprintf("%q", v)
+	}
+	if b, err := json.Marshal(input); err == nil {
+		return string(b)
+	}
+	return fmt.Sprintf("%v", input)
+}
+
+// PolicyViolationsError wraps an error returned from Azure Resource Manager when a request was denied by Azure Policy,
+// describing the Policy Assignment(s) and Definition(s) responsible for the denial.
+type PolicyViolationsError struct {
+	Err        error
+	Violations []PolicyViolation
+}
+
+func (e *PolicyViolationsError) Error() string {
+	descriptions := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		descriptions = append(descriptions, v.String())
+	}
+
+	return fmt.Sprintf("%+v\n\nThe request was denied by Azure Policy:\n\n%s", e.Err, strings.Join(descriptions, "\n\n"))
+}
+
+func (e *PolicyViolationsError) Unwrap() error {
+	return e.Err
+}
+
+// WithPolicyViolationDetails returns a PolicyViolationsError wrapping `err` when `err` is the result of a request being
+// denied by Azure Policy - otherwise `err` is returned unchanged.
+func WithPolicyViolationDetails(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var existing *PolicyViolationsError
+	if errors.As(err, &existing) {
+		return err
+	}
+
+	violations := ParsePolicyViolations(err.Error())
+	if len(violations) == 0 {
+		return err
+	}
+
+	return &PolicyViolationsError{
+		Err:        err,
+		Violations: violations,
+	}
+}
+
+// ParsePolicyViolations parses the Policy Violations from a `RequestDisallowedByPolicy` error returned by Azure Resource
+// Manager. `input` can either be the raw JSON error response, or an error message which contains it.
+//
+// The `additionalInfo` block of the error response contains the most detail (including the effect and the offending
+// fields) and is used when present, otherwise the `Policy identifiers` within the error message are used.
+func ParsePolicyViolations(input string) []PolicyViolation {
+	if !strings.Contains(input, "RequestDisallowedByPolicy") && !strings.Contains(input, "PolicyViolation") {
+		return nil
+	}
+
+	violations := make([]PolicyViolation, 0)
+	for _, value := range decodeEmbeddedJson(input) {
+		violations = appendPolicyViolationsFromAdditionalInfo(violations, value)
+	}
+
+	if len(violations) == 0 {
+		violations = parsePolicyIdentifiers(input)
+	}
+
+	return deduplicatePolicyViolations(violations)
+}
+
+// decodeEmbeddedJson returns each of the top-level JSON objects found within `input`
+func decodeEmbeddedJson(input string) []interface{} {
+	output := make([]interface{}, 0)
+
+	for i := 0; i < len(input); i++ {
+		if input[i] != '{' {
+			continue
+		}
+
+		decoder := json.NewDecoder(strings.NewReader(input[i:]))
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			continue
+		}
+
+		output = append(output, value)
+		i += int(decoder.InputOffset()) - 1
+	}
+
+	return output
+}
+
+func appendPolicyViolationsFromAdditionalInfo(violations []PolicyViolation, input interface{}) []PolicyViolation {
+	switch v := input.(type) {
+	case []interface{}:
+		for _, item := range v {
+			violations = appendPolicyViolationsFromAdditionalInfo(violations, item)
+		}
+
+	case map[string]interface{}:
+		if infoType, ok := v["type"].(string); ok && strings.EqualFold(infoType, "PolicyViolation") {
+			if info, ok := v["info"].(map[string]interface{}); ok {
+				return append(violations, policyViolationFromInfo(info))
+			}
+		}
+
+		for _, item := range v {
+			violations = appendPolicyViolationsFromAdditionalInfo(violations, item)
+		}
+	}
+
+	return violations
+}
+
+func policyViolationFromInfo(info map[string]interface{}) PolicyViolation {
+	value := func(key string) string {
+		if v, ok := info[key].(string); ok {
+			return v
+		}
+		return ""
+	}
+
+	violation := PolicyViolation{
+		PolicyAssignmentId:             value("policyAssignmentId"),
+		PolicyAssignmentName:           value("policyAssignmentName"),
+		PolicyAssignmentDisplayName:    value("policyAssignmentDisplayName"),
+		PolicyDefinitionId:             value("policyDefinitionId"),
+		PolicyDefinitionName:           value("policyDefinitionName"),
+		PolicyDefinitionDisplayName:    value("policyDefinitionDisplayName"),
+		PolicySetDefinitionId:          value("policySetDefinitionId"),
+		PolicySetDefinitionName:        value("policySetDefinitionName"),
+		PolicySetDefinitionDisplayName: value("policySetDefinitionDisplayName"),
+		Effect:                         value("policyDefinitionEffect"),
+		Fields:                         make([]PolicyViolationField, 0),
+	}
+
+	details, ok := info["evaluationDetails"].(map[string]interface{})
+	if !ok {
+		return violation
+	}
+	expressions, ok := details["evaluatedExpressions"].([]interface{})
+	if !ok {
+		return violation
+	}
+
+	for _, item := range expressions {
+		expression, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if kind, ok := expression["expressionKind"].(string); !ok || !strings.EqualFold(kind, "Field") {
+			continue
+		}
+
+		field := PolicyViolationField{
+			Value:       expression["expressionValue"],
+			TargetValue: expression["targetValue"],
+		}
+		if v, ok := expression["path"].(string); ok {
+			field.Path = v
+		}
+		if v, ok := expression["expression"].(string); ok {
+			field.Alias = v
+		}
+		if v, ok := expression["operator"].(string); ok {
+			field.Operator = v
+		}
+		violation.Fields = append(violation.Fields, field)
+	}
+
+	return violation
+}
+
+// parsePolicyIdentifiers parses the `Policy identifiers: '[...]'` section of the error message, which contains the
+// names and IDs of the Policy Assignment(s) and Definition(s) responsible for the denial
+func parsePolicyIdentifiers(input string) []PolicyViolation {
+	const prefix = "Policy identifiers: '"
+
+	index := strings.Index(input, prefix)
+	if index == -1 {
+		return nil
+	}
+	raw := input[index+len(prefix):]
+	end := strings.Index(raw, "]'")
+	if end == -1 {
+		return nil
+	}
+	raw = raw[:end+1]
+
+	// the message may have been quoted when it was embedded in the error (e.g. by AutoRest)
+	if strings.Contains(raw, `\"`) {
+		raw = strings.ReplaceAll(raw, `\"`, `"`)
+	}
+
+	type policyIdentifier struct {
+		Name string `json:"name"`
+		Id   string `json:"id"`
+	}
+	var identifiers []struct {
+		PolicyAssignment    *policyIdentifier `json:"policyAssignment"`
+		PolicyDefinition    *policyIdentifier `json:"policyDefinition"`
+		PolicySetDefinition *policyIdentifier `json:"policySetDefinition"`
+	}
+	if err := json.Unmarshal([]byte(raw), &identifiers); err != nil {
+		return nil
+	}
+
+	output := make([]PolicyViolation, 0)
+	for _, identifier := range identifiers {
+		violation := PolicyViolation{}
+		if v := identifier.PolicyAssignment; v != nil {
+			violation.PolicyAssignmentName = v.Name
+			violation.PolicyAssignmentId = v.Id
+		}
+		if v := identifier.PolicyDefinition; v != nil {
+			violation.PolicyDefinitionName = v.Name
+			violation.PolicyDefinitionId = v.Id
+		}
+		if v := identifier.PolicySetDefinition; v != nil {
+			violation.PolicySetDefinitionName = v.Name
+			violation.PolicySetDefinitionId = v.Id
+		}
+		output = append(output, violation)
+	}
+
+	return output
+}
+
+func deduplicatePolicyViolations(input []PolicyViolation) []PolicyViolation {
+	output := make([]PolicyViolation, 0)
+	seen := make(map[string]struct{})
+	for _, v := range input {
+		key := strings.ToLower(fmt.Sprintf("%s|%s|%s", v.PolicyAssignmentId, v.PolicySetDefinitionId, v.PolicyDefinitionId))
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		output = append(output, v)
+	}
+
+	return output
+}
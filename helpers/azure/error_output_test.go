@@ -4,6 +4,9 @@
 package azure_test
 
 import (
+	"errors"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -41,3 +44,115 @@ func TestQuotedStringSlice(t *testing.T) {
 		}
 	}
 }
+
+func TestParsePolicyViolations(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    string
+		expected []azure.PolicyViolation
+	}{
+		{
+			name:     "not a policy violation",
+			input:    `unexpected status 409 (409 Conflict) with error: Conflict: The resource already exists`,
+			expected: []azure.PolicyViolation{},
+		},
+		{
+			name:  "raw error response",
+			input: `{"error":{"code":"RequestDisallowedByPolicy","target":"example","message":"Resource 'example' was disallowed by policy.","additionalInfo":[{"type":"PolicyViolation","info":{"evaluationDetails":{"evaluatedExpressions":[{"result":"True","expressionKind":"Field","expression":"type","path":"type","expressionValue":"Microsoft.Storage/storageAccounts","targetValue":"Microsoft.Storage/storageAccounts","operator":"Equals"},{"result":"True","expressionKind":"Field","expression":"Microsoft.Storage/storageAccounts/minimumTlsVersion","path":"properties.minimumTlsVersion","expressionValue":"TLS1_0","targetValue":"TLS1_2","operator":"NotEquals"}]},"policyDefinitionDisplayName":"Storage accounts should have the specified minimum TLS version","policyDefinitionId":"/providers/Microsoft.Authorization/policyDefinitions/fe83a0eb","policyDefinitionName":"fe83a0eb","policyDefinitionEffect":"Deny","policyAssignmentId":"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/tls","policyAssignmentName":"tls","policyAssignmentDisplayName":"Require TLS 1.2"}}]}}`,
+			expected: []azure.PolicyViolation{
+				{
+					PolicyAssignmentId:          "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/tls",
+					PolicyAssignmentName:        "tls",
+					PolicyAssignmentDisplayName: "Require TLS 1.2",
+					PolicyDefinitionId:          "/providers/Microsoft.Authorization/policyDefinitions/fe83a0eb",
+					PolicyDefinitionName:        "fe83a0eb",
+					PolicyDefinitionDisplayName: "Storage accounts should have the specified minimum TLS version",
+					Effect:                      "Deny",
+					Fields: []azure.PolicyViolationField{
+						{
+							Path:        "type",
+							Alias:       "type",
+							Operator:    "Equals",
+							Value:       "Microsoft.Storage/storageAccounts",
+							TargetValue: "Microsoft.Storage/storageAccounts",
+						},
+						{
+							Path:        "properties.minimumTlsVersion",
+							Alias:       "Microsoft.Storage/storageAccounts/minimumTlsVersion",
+							Operator:    "NotEquals",
+							Value:       "TLS1_0",
+							TargetValue: "TLS1_2",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "error message",
+			input: `creating Storage Account: unexpected status 403 (403 Forbidden) with error: RequestDisallowedByPolicy: Resource 'example' was disallowed by policy. Policy identifiers: '[{"policyAssignment":{"name":"Allowed locations","id":"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/locations"},"policyDefinition":{"name":"Allowed locations","id":"/providers/Microsoft.Authorization/policyDefinitions/e56962a6","version":"1.0.0"}}]'.`,
+			expected: []azure.PolicyViolation{
+				{
+					PolicyAssignmentId:   "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/locations",
+					PolicyAssignmentName: "Allowed locations",
+					PolicyDefinitionId:   "/providers/Microsoft.Authorization/policyDefinitions/e56962a6",
+					PolicyDefinitionName: "Allowed locations",
+				},
+			},
+		},
+		{
+			name:  "quoted error message",
+			input: `creating Virtual Network: network.VirtualNetworksClient#CreateOrUpdate: Failure sending request: StatusCode=403 -- Original Error: Code="RequestDisallowedByPolicy" Message="Resource 'example' was disallowed by policy. Policy identifiers: '[{\"policyAssignment\":{\"name\":\"Deny Virtual Networks\",\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/vnets\"},\"policyDefinition\":{\"name\":\"Deny Virtual Networks\",\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyDefinitions/vnets\"},\"policySetDefinition\":{\"name\":\"Networking\",\"id\":\"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policySetDefinitions/networking\"}}]'." Target="example"`,
+			expected: []azure.PolicyViolation{
+				{
+					PolicyAssignmentId:      "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/vnets",
+					PolicyAssignmentName:    "Deny Virtual Networks",
+					PolicyDefinitionId:      "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyDefinitions/vnets",
+					PolicyDefinitionName:    "Deny Virtual Networks",
+					PolicySetDefinitionId:   "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policySetDefinitions/networking",
+					PolicySetDefinitionName: "Networking",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := azure.ParsePolicyViolations(v.input)
+		if len(actual) == 0 && len(v.expected) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestWithPolicyViolationDetails(t *testing.T) {
+	if azure.WithPolicyViolationDetails(nil) != nil {
+		t.Fatalf("Expected a nil error to remain nil")
+	}
+
+	unrelated := errors.New("unexpected status 404 (404 Not Found) with error: ResourceGroupNotFound: Resource group 'example' could not be found.")
+	if actual := azure.WithPolicyViolationDetails(unrelated); actual != unrelated {
+		t.Fatalf("Expected an unrelated error to be returned unchanged but got %+v", actual)
+	}
+
+	denied := errors.New(`unexpected status 403 (403 Forbidden) with error: RequestDisallowedByPolicy: Resource 'example' was disallowed by policy. Policy identifiers: '[{"policyAssignment":{"name":"Allowed locations","id":"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/locations"},"policyDefinition":{"name":"Allowed locations","id":"/providers/Microsoft.Authorization/policyDefinitions/e56962a6"}}]'.`)
+	actual := azure.WithPolicyViolationDetails(denied)
+
+	var violations *azure.PolicyViolationsError
+	if !errors.As(actual, &violations) {
+		t.Fatalf("Expected a PolicyViolationsError but got %T", actual)
+	}
+	if !errors.Is(actual, denied) {
+		t.Fatalf("Expected the PolicyViolationsError to wrap the original error")
+	}
+	if !strings.Contains(actual.Error(), `Policy Assignment: "Allowed locations" (/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/locations)`) {
+		t.Fatalf("Expected the error to describe the Policy Assignment but got %q", actual.Error())
+	}
+
+	if again := azure.WithPolicyViolationDetails(actual); again != actual {
+		t.Fatalf("Expected an existing PolicyViolationsError to be returned unchanged")
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cidr
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+)
+
+// Parse parses an IPv4 or IPv6 CIDR, which must be the network address of the prefix (e.g. `10.0.1.0/24` rather than `10.0.1.5/24`)
+func Parse(input string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(input)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	if prefix.Masked() != prefix {
+		return netip.Prefix{}, fmt.Errorf("%q has host bits set, the network address is %q", input, prefix.Masked().String())
+	}
+
+	return prefix, nil
+}
+
+// ParseAll parses each of the CIDRs using Parse
+func ParseAll(input []string) ([]netip.Prefix, error) {
+	result := make([]netip.Prefix, 0, len(input))
+	for _, v := range input {
+		prefix, err := Parse(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, prefix)
+	}
+
+	return result, nil
+}
+
+// Allocate returns the first available prefix of each of the requested prefix lengths, in order. Each prefix is
+// allocated from the first address space (of the same address family) with a free block of that size which doesn't
+// overlap any of the used prefixes, or a prefix allocated previously.
+func Allocate(addressSpaces []netip.Prefix, used []netip.Prefix, prefixLengths []int) ([]netip.Prefix, error) {
+	unavailable := make([]netip.Prefix, 0, len(used)+len(prefixLengths))
+	unavailable = append(unavailable, used...)
+
+	result := make([]netip.Prefix, 0, len(prefixLengths))
+	for _, prefixLength := range prefixLengths {
+		prefix, ok := allocatePrefix(addressSpaces, unavailable, prefixLength)
+		if !ok {
+			return nil, fmt.Errorf("no /%d prefix is available within the address spaces %s", prefixLength, formatPrefixes(addressSpaces))
+		}
+
+		result = append(result, prefix)
+		unavailable = append(unavailable, prefix)
+	}
+
+	return result, nil
+}
+
+func allocatePrefix(addressSpaces []netip.Prefix, unavailable []netip.Prefix, prefixLength int) (netip.Prefix, bool) {
+	for _, addressSpace := range addressSpaces {
+		bits := addressSpace.Addr().BitLen()
+		if prefixLength < addressSpace.Bits() || prefixLength > bits {
+			continue
+		}
+
+		size := blockSize(bits, prefixLength)
+		end := new(big.Int).Add(addressToInt(addressSpace.Addr()), blockSize(bits, addressSpace.Bits()))
+
+		// the address space is aligned, as such so is each candidate block within it
+		candidate := addressToInt(addressSpace.Addr())
+		for new(big.Int).Add(candidate, size).Cmp(end) <= 0 {
+			prefix := netip.PrefixFrom(intToAddress(candidate, addressSpace.Addr().Is4()), prefixLength)
+
+			overlapping, ok := firstOverlap(prefix, unavailable)
+			if !ok {
+				return prefix, true
+			}
+
+			// skip past the end of the overlapping prefix, rounding up to the next aligned block
+			next := new(big.Int).Add(addressToInt(overlapping.Addr()), blockSize(bits, overlapping.Bits()))
+			if minimum := new(big.Int).Add(candidate, size); next.Cmp(minimum) < 0 {
+				next = minimum
+			}
+			remainder := new(big.Int).Mod(next, size)
+			if remainder.Sign() != 0 {
+				next.Add(next, new(big.Int).Sub(size, remainder))
+			}
+			candidate = next
+		}
+	}
+
+	return netip.Prefix{}, false
+}
+
+func firstOverlap(prefix netip.Prefix, others []netip.Prefix) (netip.Prefix, bool) {
+	for _, other := range others {
+		if other.Addr().Is4() == prefix.Addr().Is4() && other.Overlaps(prefix) {
+			return other, true
+		}
+	}
+
+	return netip.Prefix{}, false
+}
+
+func blockSize(bits, prefixLength int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))
+}
+
+func addressToInt(input netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(input.AsSlice())
+}
+
+func intToAddress(input *big.Int, ipv4 bool) netip.Addr {
+	length := 16
+	if ipv4 {
+		length = 4
+	}
+
+	address, _ := netip.AddrFromSlice(input.FillBytes(make([]byte, length)))
+	return address
+}
+
+func formatPrefixes(input []netip.Prefix) string {
+	result := make([]string, 0, len(input))
+	for _, v := range input {
+		result = append(result, v.String())
+	}
+
+	return fmt.Sprintf("%q", result)
+}
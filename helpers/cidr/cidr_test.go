@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cidr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "10.0.0.0/16",
+			Valid: true,
+		},
+		{
+			Input: "10.0.1.5/24",
+			Valid: false,
+		},
+		{
+			Input: "10.0.0.0",
+			Valid: false,
+		},
+		{
+			Input: "fd00:db8::/64",
+			Valid: true,
+		},
+		{
+			Input: "fd00:db8::1/64",
+			Valid: false,
+		},
+		{
+			Input: "",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, err := Parse(tc.Input)
+			if valid := err == nil; valid != tc.Valid {
+				t.Fatalf("expected %q to be valid %t but got %t (%v)", tc.Input, tc.Valid, valid, err)
+			}
+		})
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	cases := []struct {
+		Name          string
+		AddressSpaces []string
+		Used          []string
+		PrefixLengths []int
+		Expected      []string
+		ExpectedError string
+	}{
+		{
+			Name:          "Empty Address Space",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			PrefixLengths: []int{24, 24, 26},
+			Expected:      []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/26"},
+		},
+		{
+			Name:          "Skips Used Prefixes",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.0/24", "10.0.1.0/28"},
+			PrefixLengths: []int{24, 28},
+			Expected:      []string{"10.0.2.0/24", "10.0.1.16/28"},
+		},
+		{
+			Name:          "Aligns After Smaller Prefix",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.64/26"},
+			PrefixLengths: []int{25, 26},
+			Expected:      []string{"10.0.0.128/25", "10.0.0.0/26"},
+		},
+		{
+			Name:          "Skips Larger Used Prefix",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.0/17"},
+			PrefixLengths: []int{24},
+			Expected:      []string{"10.0.128.0/24"},
+		},
+		{
+			Name:          "Second Address Space",
+			AddressSpaces: []string{"10.0.0.0/24", "10.1.0.0/16"},
+			Used:          []string{"10.0.0.0/25"},
+			PrefixLengths: []int{25, 24},
+			Expected:      []string{"10.0.0.128/25", "10.1.0.0/24"},
+		},
+		{
+			Name:          "Prefix Larger Than Address Space",
+			AddressSpaces: []string{"10.0.0.0/24", "10.1.0.0/16"},
+			PrefixLengths: []int{20},
+			Expected:      []string{"10.1.0.0/20"},
+		},
+		{
+			Name:          "IPv6",
+			AddressSpaces: []string{"10.0.0.0/16", "fd00:db8::/48"},
+			Used:          []string{"fd00:db8::/64"},
+			PrefixLengths: []int{64},
+			Expected:      []string{"fd00:db8:0:1::/64"},
+		},
+		{
+			Name:          "Exhausted",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			Used:          []string{"10.0.0.0/25"},
+			PrefixLengths: []int{25, 25},
+			ExpectedError: `no /25 prefix is available within the address spaces ["10.0.0.0/24"]`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			addressSpaces, err := ParseAll(tc.AddressSpaces)
+			if err != nil {
+				t.Fatalf("parsing address spaces: %+v", err)
+			}
+			used, err := ParseAll(tc.Used)
+			if err != nil {
+				t.Fatalf("parsing used prefixes: %+v", err)
+			}
+
+			result, err := Allocate(addressSpaces, used, tc.PrefixLengths)
+			if tc.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+					t.Fatalf("expected an error containing %q but got %v", tc.ExpectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			actual := make([]string, 0)
+			for _, v := range result {
+				actual = append(actual, v.String())
+			}
+			if strings.Join(actual, ",") != strings.Join(tc.Expected, ",") {
+				t.Fatalf("expected %q but got %q", tc.Expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,595 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatelink
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Cloud is the Azure Cloud used to determine the Private DNS Zone names, which differ between the Public and Sovereign Clouds
+type Cloud string
+
+const (
+	CloudChina        Cloud = "china"
+	CloudPublic       Cloud = "public"
+	CloudUSGovernment Cloud = "usgovernment"
+)
+
+// regionPlaceholder is replaced with the (normalised) location of the resource, for services which use regional Private DNS Zones
+const regionPlaceholder = "{region}"
+
+// dnsZones contains the Private DNS Zone names for a subresource in each Cloud - a Cloud is omitted when the
+// subresource isn't available (or doesn't support Private Endpoints) in that Cloud
+type dnsZones map[Cloud][]string
+
+// knownDnsZones contains the Private DNS Zone names for each resource type and subresource which can be the target
+// of a Private Endpoint, as documented at:
+// https://learn.microsoft.com/azure/private-link/private-endpoint-dns
+var knownDnsZones = map[string]map[string]dnsZones{
+	"Microsoft.ApiManagement/service": {
+		"Gateway": {
+			CloudPublic:       {"privatelink.azure-api.net"},
+			CloudUSGovernment: {"privatelink.azure-api.us"},
+			CloudChina:        {"privatelink.azure-api.cn"},
+		},
+	},
+	"Microsoft.AppConfiguration/configurationStores": {
+		"configurationStores": {
+			CloudPublic:       {"privatelink.azconfig.io"},
+			CloudUSGovernment: {"privatelink.azconfig.azure.us"},
+			CloudChina:        {"privatelink.azconfig.azure.cn"},
+		},
+	},
+	"Microsoft.Authorization/resourceManagementPrivateLinks": {
+		"ResourceManagement": {
+			CloudPublic:       {"privatelink.azure.com"},
+			CloudUSGovernment: {"privatelink.azure.us"},
+			CloudChina:        {"privatelink.azure.cn"},
+		},
+	},
+	"Microsoft.Automation/automationAccounts": {
+		"Webhook": {
+			CloudPublic:       {"privatelink.azure-automation.net"},
+			CloudUSGovernment: {"privatelink.azure-automation.us"},
+			CloudChina:        {"privatelink.azure-automation.cn"},
+		},
+		"DSCAndHybridWorker": {
+			CloudPublic:       {"privatelink.azure-automation.net"},
+			CloudUSGovernment: {"privatelink.azure-automation.us"},
+			CloudChina:        {"privatelink.azure-automation.cn"},
+		},
+	},
+	"Microsoft.Batch/batchAccounts": {
+		"batchAccount": {
+			CloudPublic:       {"privatelink.batch.azure.com"},
+			CloudUSGovernment: {"privatelink.batch.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.batch.chinacloudapi.cn"},
+		},
+		"nodeManagement": {
+			CloudPublic:       {"privatelink.batch.azure.com"},
+			CloudUSGovernment: {"privatelink.batch.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.batch.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.Cache/redis": {
+		"redisCache": {
+			CloudPublic:       {"privatelink.redis.cache.windows.net"},
+			CloudUSGovernment: {"privatelink.redis.cache.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.redis.cache.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.Cache/redisEnterprise": {
+		"redisEnterprise": {
+			CloudPublic: {"privatelink.redisenterprise.cache.azure.net"},
+		},
+	},
+	"Microsoft.CognitiveServices/accounts": {
+		"account": {
+			CloudPublic:       {"privatelink.cognitiveservices.azure.com", "privatelink.openai.azure.com", "privatelink.services.ai.azure.com"},
+			CloudUSGovernment: {"privatelink.cognitiveservices.azure.us", "privatelink.openai.azure.us"},
+			CloudChina:        {"privatelink.cognitiveservices.azure.cn", "privatelink.openai.azure.cn"},
+		},
+	},
+	"Microsoft.Compute/diskAccesses": {
+		"disks": {
+			CloudPublic:       {"privatelink.blob.core.windows.net"},
+			CloudUSGovernment: {"privatelink.blob.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.blob.core.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.ContainerRegistry/registries": {
+		"registry": {
+			CloudPublic:       {"privatelink.azurecr.io", "{region}.data.privatelink.azurecr.io"},
+			CloudUSGovernment: {"privatelink.azurecr.us", "{region}.data.privatelink.azurecr.us"},
+			CloudChina:        {"privatelink.azurecr.cn", "{region}.data.privatelink.azurecr.cn"},
+		},
+	},
+	"Microsoft.ContainerService/managedClusters": {
+		"management": {
+			CloudPublic:       {"privatelink.{region}.azmk8s.io"},
+			CloudUSGovernment: {"privatelink.{region}.cx.aks.containerservice.azure.us"},
+			CloudChina:        {"privatelink.{region}.cx.prod.service.azk8s.cn"},
+		},
+	},
+	"Microsoft.Dashboard/grafana": {
+		"grafana": {
+			CloudPublic:       {"privatelink.grafana.azure.com"},
+			CloudUSGovernment: {"privatelink.grafana.azure.us"},
+		},
+	},
+	"Microsoft.Databricks/workspaces": {
+		"databricks_ui_api": {
+			CloudPublic:       {"privatelink.azuredatabricks.net"},
+			CloudUSGovernment: {"privatelink.databricks.azure.us"},
+			CloudChina:        {"privatelink.databricks.azure.cn"},
+		},
+		"browser_authentication": {
+			CloudPublic:       {"privatelink.azuredatabricks.net"},
+			CloudUSGovernment: {"privatelink.databricks.azure.us"},
+			CloudChina:        {"privatelink.databricks.azure.cn"},
+		},
+	},
+	"Microsoft.DataFactory/factories": {
+		"dataFactory": {
+			CloudPublic:       {"privatelink.datafactory.azure.net"},
+			CloudUSGovernment: {"privatelink.datafactory.azure.us"},
+			CloudChina:        {"privatelink.datafactory.azure.cn"},
+		},
+		"portal": {
+			CloudPublic:       {"privatelink.adf.azure.com"},
+			CloudUSGovernment: {"privatelink.adf.azure.us"},
+			CloudChina:        {"privatelink.adf.azure.cn"},
+		},
+	},
+	"Microsoft.DBforMariaDB/servers": {
+		"mariadbServer": {
+			CloudPublic:       {"privatelink.mariadb.database.azure.com"},
+			CloudUSGovernment: {"privatelink.mariadb.database.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.mariadb.database.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.DBforMySQL/flexibleServers": {
+		"mysqlServer": {
+			CloudPublic:       {"privatelink.mysql.database.azure.com"},
+			CloudUSGovernment: {"privatelink.mysql.database.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.mysql.database.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.DBforPostgreSQL/flexibleServers": {
+		"postgresqlServer": {
+			CloudPublic:       {"privatelink.postgres.database.azure.com"},
+			CloudUSGovernment: {"privatelink.postgres.database.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.postgres.database.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.DBforPostgreSQL/serverGroupsv2": {
+		"coordinator": {
+			CloudPublic: {"privatelink.postgres.cosmos.azure.com"},
+		},
+	},
+	"Microsoft.DesktopVirtualization/hostPools": {
+		"connection": {
+			CloudPublic:       {"privatelink.wvd.microsoft.com"},
+			CloudUSGovernment: {"privatelink.wvd.azure.us"},
+			CloudChina:        {"privatelink.wvd.azure.cn"},
+		},
+	},
+	"Microsoft.DesktopVirtualization/workspaces": {
+		"feed": {
+			CloudPublic:       {"privatelink.wvd.microsoft.com"},
+			CloudUSGovernment: {"privatelink.wvd.azure.us"},
+			CloudChina:        {"privatelink.wvd.azure.cn"},
+		},
+		"global": {
+			CloudPublic:       {"privatelink-global.wvd.microsoft.com"},
+			CloudUSGovernment: {"privatelink-global.wvd.azure.us"},
+			CloudChina:        {"privatelink-global.wvd.azure.cn"},
+		},
+	},
+	"Microsoft.Devices/IotHubs": {
+		"iotHub": {
+			CloudPublic:       {"privatelink.azure-devices.net", "privatelink.servicebus.windows.net"},
+			CloudUSGovernment: {"privatelink.azure-devices.us", "privatelink.servicebus.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.azure-devices.cn", "privatelink.servicebus.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.Devices/provisioningServices": {
+		"iotDps": {
+			CloudPublic:       {"privatelink.azure-devices-provisioning.net"},
+			CloudUSGovernment: {"privatelink.azure-devices-provisioning.us"},
+			CloudChina:        {"privatelink.azure-devices-provisioning.cn"},
+		},
+	},
+	"Microsoft.DocumentDB/databaseAccounts": {
+		"Sql": {
+			CloudPublic:       {"privatelink.documents.azure.com"},
+			CloudUSGovernment: {"privatelink.documents.azure.us"},
+			CloudChina:        {"privatelink.documents.azure.cn"},
+		},
+		"MongoDB": {
+			CloudPublic:       {"privatelink.mongo.cosmos.azure.com"},
+			CloudUSGovernment: {"privatelink.mongo.cosmos.azure.us"},
+			CloudChina:        {"privatelink.mongo.cosmos.azure.cn"},
+		},
+		"Cassandra": {
+			CloudPublic:       {"privatelink.cassandra.cosmos.azure.com"},
+			CloudUSGovernment: {"privatelink.cassandra.cosmos.azure.us"},
+			CloudChina:        {"privatelink.cassandra.cosmos.azure.cn"},
+		},
+		"Gremlin": {
+			CloudPublic:       {"privatelink.gremlin.cosmos.azure.com"},
+			CloudUSGovernment: {"privatelink.gremlin.cosmos.azure.us"},
+			CloudChina:        {"privatelink.gremlin.cosmos.azure.cn"},
+		},
+		"Table": {
+			CloudPublic:       {"privatelink.table.cosmos.azure.com"},
+			CloudUSGovernment: {"privatelink.table.cosmos.azure.us"},
+			CloudChina:        {"privatelink.table.cosmos.azure.cn"},
+		},
+		"Analytical": {
+			CloudPublic:       {"privatelink.analytics.cosmos.azure.com"},
+			CloudUSGovernment: {"privatelink.analytics.cosmos.azure.us"},
+			CloudChina:        {"privatelink.analytics.cosmos.azure.cn"},
+		},
+	},
+	"Microsoft.DocumentDB/mongoClusters": {
+		"MongoCluster": {
+			CloudPublic: {"privatelink.mongocluster.cosmos.azure.com"},
+		},
+	},
+	"Microsoft.EventGrid/domains": {
+		"domain": {
+			CloudPublic:       {"privatelink.eventgrid.azure.net"},
+			CloudUSGovernment: {"privatelink.eventgrid.azure.us"},
+			CloudChina:        {"privatelink.eventgrid.azure.cn"},
+		},
+	},
+	"Microsoft.EventGrid/namespaces": {
+		"topic": {
+			CloudPublic:       {"privatelink.eventgrid.azure.net"},
+			CloudUSGovernment: {"privatelink.eventgrid.azure.us"},
+			CloudChina:        {"privatelink.eventgrid.azure.cn"},
+		},
+		"topicspace": {
+			CloudPublic: {"privatelink.ts.eventgrid.azure.net"},
+		},
+	},
+	"Microsoft.EventGrid/topics": {
+		"topic": {
+			CloudPublic:       {"privatelink.eventgrid.azure.net"},
+			CloudUSGovernment: {"privatelink.eventgrid.azure.us"},
+			CloudChina:        {"privatelink.eventgrid.azure.cn"},
+		},
+	},
+	"Microsoft.EventHub/namespaces": {
+		"namespace": {
+			CloudPublic:       {"privatelink.servicebus.windows.net"},
+			CloudUSGovernment: {"privatelink.servicebus.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.servicebus.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.HDInsight/clusters": {
+		"gateway": {
+			CloudPublic:       {"privatelink.azurehdinsight.net"},
+			CloudUSGovernment: {"privatelink.azurehdinsight.us"},
+			CloudChina:        {"privatelink.azurehdinsight.cn"},
+		},
+		"headnode": {
+			CloudPublic:       {"privatelink.azurehdinsight.net"},
+			CloudUSGovernment: {"privatelink.azurehdinsight.us"},
+			CloudChina:        {"privatelink.azurehdinsight.cn"},
+		},
+	},
+	"Microsoft.HealthcareApis/workspaces": {
+		"healthcareworkspace": {
+			CloudPublic: {"privatelink.workspace.azurehealthcareapis.com", "privatelink.fhir.azurehealthcareapis.com", "privatelink.dicom.azurehealthcareapis.com"},
+		},
+	},
+	"Microsoft.Insights/privateLinkScopes": {
+		"azuremonitor": {
+			CloudPublic:       {"privatelink.monitor.azure.com", "privatelink.oms.opinsights.azure.com", "privatelink.ods.opinsights.azure.com", "privatelink.agentsvc.azure-automation.net", "privatelink.blob.core.windows.net"},
+			CloudUSGovernment: {"privatelink.monitor.azure.us", "privatelink.adx.monitor.azure.us", "privatelink.oms.opinsights.azure.us", "privatelink.ods.opinsights.azure.us", "privatelink.agentsvc.azure-automation.us", "privatelink.blob.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.monitor.azure.cn", "privatelink.oms.opinsights.azure.cn", "privatelink.ods.opinsights.azure.cn", "privatelink.agentsvc.azure-automation.cn", "privatelink.blob.core.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.KeyVault/managedHSMs": {
+		"managedhsm": {
+			CloudPublic:       {"privatelink.managedhsm.azure.net"},
+			CloudUSGovernment: {"privatelink.managedhsm.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.managedhsm.azure.cn"},
+		},
+	},
+	"Microsoft.KeyVault/vaults": {
+		"vault": {
+			CloudPublic:       {"privatelink.vaultcore.azure.net"},
+			CloudUSGovernment: {"privatelink.vaultcore.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.vaultcore.azure.cn"},
+		},
+	},
+	"Microsoft.Kusto/clusters": {
+		"cluster": {
+			CloudPublic:       {"privatelink.{region}.kusto.windows.net", "privatelink.blob.core.windows.net", "privatelink.queue.core.windows.net", "privatelink.table.core.windows.net"},
+			CloudUSGovernment: {"privatelink.{region}.kusto.usgovcloudapi.net", "privatelink.blob.core.usgovcloudapi.net", "privatelink.queue.core.usgovcloudapi.net", "privatelink.table.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.{region}.kusto.chinacloudapi.cn", "privatelink.blob.core.chinacloudapi.cn", "privatelink.queue.core.chinacloudapi.cn", "privatelink.table.core.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.MachineLearningServices/workspaces": {
+		"amlworkspace": {
+			CloudPublic:       {"privatelink.api.azureml.ms", "privatelink.notebooks.azure.net"},
+			CloudUSGovernment: {"privatelink.api.ml.azure.us", "privatelink.notebooks.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.api.ml.azure.cn", "privatelink.notebooks.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.Monitor/accounts": {
+		"prometheusMetrics": {
+			CloudPublic: {"privatelink.{region}.prometheus.monitor.azure.com"},
+		},
+	},
+	"Microsoft.Purview/accounts": {
+		"account": {
+			CloudPublic: {"privatelink.purview.azure.com"},
+		},
+		"portal": {
+			CloudPublic: {"privatelink.purviewstudio.azure.com"},
+		},
+	},
+	"Microsoft.RecoveryServices/vaults": {
+		"AzureBackup": {
+			CloudPublic:       {"privatelink.{region}.backup.windowsazure.com"},
+			CloudUSGovernment: {"privatelink.{region}.backup.windowsazure.us"},
+			CloudChina:        {"privatelink.{region}.backup.windowsazure.cn"},
+		},
+		"AzureSiteRecovery": {
+			CloudPublic:       {"privatelink.siterecovery.windowsazure.com"},
+			CloudUSGovernment: {"privatelink.siterecovery.windowsazure.us"},
+			CloudChina:        {"privatelink.siterecovery.windowsazure.cn"},
+		},
+	},
+	"Microsoft.Relay/namespaces": {
+		"namespace": {
+			CloudPublic:       {"privatelink.servicebus.windows.net"},
+			CloudUSGovernment: {"privatelink.servicebus.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.servicebus.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.Search/searchServices": {
+		"searchService": {
+			CloudPublic:       {"privatelink.search.windows.net"},
+			CloudUSGovernment: {"privatelink.search.azure.us"},
+			CloudChina:        {"privatelink.search.azure.cn"},
+		},
+	},
+	"Microsoft.ServiceBus/namespaces": {
+		"namespace": {
+			CloudPublic:       {"privatelink.servicebus.windows.net"},
+			CloudUSGovernment: {"privatelink.servicebus.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.servicebus.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.SignalRService/SignalR": {
+		"signalr": {
+			CloudPublic:       {"privatelink.service.signalr.net"},
+			CloudUSGovernment: {"privatelink.signalr.azure.us"},
+			CloudChina:        {"privatelink.signalr.azure.cn"},
+		},
+	},
+	"Microsoft.SignalRService/WebPubSub": {
+		"webpubsub": {
+			CloudPublic:       {"privatelink.webpubsub.azure.com"},
+			CloudUSGovernment: {"privatelink.webpubsub.azure.us"},
+			CloudChina:        {"privatelink.webpubsub.azure.cn"},
+		},
+	},
+	"Microsoft.Sql/servers": {
+		"sqlServer": {
+			CloudPublic:       {"privatelink.database.windows.net"},
+			CloudUSGovernment: {"privatelink.database.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.database.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.Storage/storageAccounts": {
+		"blob": {
+			CloudPublic:       {"privatelink.blob.core.windows.net"},
+			CloudUSGovernment: {"privatelink.blob.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.blob.core.chinacloudapi.cn"},
+		},
+		"blob_secondary": {
+			CloudPublic:       {"privatelink.blob.core.windows.net"},
+			CloudUSGovernment: {"privatelink.blob.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.blob.core.chinacloudapi.cn"},
+		},
+		"dfs": {
+			CloudPublic:       {"privatelink.dfs.core.windows.net"},
+			CloudUSGovernment: {"privatelink.dfs.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.dfs.core.chinacloudapi.cn"},
+		},
+		"dfs_secondary": {
+			CloudPublic:       {"privatelink.dfs.core.windows.net"},
+			CloudUSGovernment: {"privatelink.dfs.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.dfs.core.chinacloudapi.cn"},
+		},
+		"file": {
+			CloudPublic:       {"privatelink.file.core.windows.net"},
+			CloudUSGovernment: {"privatelink.file.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.file.core.chinacloudapi.cn"},
+		},
+		"queue": {
+			CloudPublic:       {"privatelink.queue.core.windows.net"},
+			CloudUSGovernment: {"privatelink.queue.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.queue.core.chinacloudapi.cn"},
+		},
+		"queue_secondary": {
+			CloudPublic:       {"privatelink.queue.core.windows.net"},
+			CloudUSGovernment: {"privatelink.queue.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.queue.core.chinacloudapi.cn"},
+		},
+		"table": {
+			CloudPublic:       {"privatelink.table.core.windows.net"},
+			CloudUSGovernment: {"privatelink.table.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.table.core.chinacloudapi.cn"},
+		},
+		"table_secondary": {
+			CloudPublic:       {"privatelink.table.core.windows.net"},
+			CloudUSGovernment: {"privatelink.table.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.table.core.chinacloudapi.cn"},
+		},
+		"web": {
+			CloudPublic:       {"privatelink.web.core.windows.net"},
+			CloudUSGovernment: {"privatelink.web.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.web.core.chinacloudapi.cn"},
+		},
+		"web_secondary": {
+			CloudPublic:       {"privatelink.web.core.windows.net"},
+			CloudUSGovernment: {"privatelink.web.core.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.web.core.chinacloudapi.cn"},
+		},
+	},
+	"Microsoft.StorageSync/storageSyncServices": {
+		"afs": {
+			CloudPublic:       {"privatelink.afs.azure.net"},
+			CloudUSGovernment: {"privatelink.afs.azure.us"},
+			CloudChina:        {"privatelink.afs.azure.cn"},
+		},
+	},
+	"Microsoft.Synapse/privateLinkHubs": {
+		"Web": {
+			CloudPublic:       {"privatelink.azuresynapse.net"},
+			CloudUSGovernment: {"privatelink.azuresynapse.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.azuresynapse.azure.cn"},
+		},
+	},
+	"Microsoft.Synapse/workspaces": {
+		"Sql": {
+			CloudPublic:       {"privatelink.sql.azuresynapse.net"},
+			CloudUSGovernment: {"privatelink.sql.azuresynapse.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.sql.azuresynapse.azure.cn"},
+		},
+		"SqlOnDemand": {
+			CloudPublic:       {"privatelink.sql.azuresynapse.net"},
+			CloudUSGovernment: {"privatelink.sql.azuresynapse.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.sql.azuresynapse.azure.cn"},
+		},
+		"Dev": {
+			CloudPublic:       {"privatelink.dev.azuresynapse.net"},
+			CloudUSGovernment: {"privatelink.dev.azuresynapse.usgovcloudapi.net"},
+			CloudChina:        {"privatelink.dev.azuresynapse.azure.cn"},
+		},
+	},
+	"Microsoft.Web/sites": {
+		"sites": {
+			CloudPublic:       {"privatelink.azurewebsites.net"},
+			CloudUSGovernment: {"privatelink.azurewebsites.us"},
+			CloudChina:        {"privatelink.chinacloudsites.cn"},
+		},
+	},
+}
+
+// CloudForEnvironment returns the Cloud for the name of an Environment - either as specified in the `environment`
+// field of the Provider block (e.g. `usgovernment`) or as returned from the SDK (e.g. `USGovernment`)
+func CloudForEnvironment(input string) (Cloud, error) {
+	switch strings.ToLower(input) {
+	case "public", "global", "canary":
+		return CloudPublic, nil
+
+	case "usgovernment", "usgovernmentl4", "usgovernmentl5", "dod":
+		return CloudUSGovernment, nil
+
+	case "china":
+		return CloudChina, nil
+	}
+
+	return "", fmt.Errorf("the Private DNS Zones for the environment %q aren't known - possible values are `public`, `usgovernment` and `china`", input)
+}
+
+// DnsZoneNames returns the names of the Private DNS Zones used by a Private Endpoint connected to the subresources
+// `subresourceNames` of the resource type `resourceType` (e.g. `Microsoft.Storage/storageAccounts`) within the Cloud
+// `cloud`. Services which use regional Private DNS Zones require the `location` of the resource to be specified.
+//
+// Each Private DNS Zone name is only returned once, in the order of the subresources.
+func DnsZoneNames(cloud Cloud, resourceType string, subresourceNames []string, location string) ([]string, error) {
+	subresources, ok := lookup(knownDnsZones, resourceType)
+	if !ok {
+		return nil, fmt.Errorf("the Private DNS Zones for the resource type %q aren't known", resourceType)
+	}
+
+	if len(subresourceNames) == 0 {
+		return nil, fmt.Errorf("at least one subresource name must be specified - possible values for %q are %s", resourceType, possibleSubresourceNames(subresources))
+	}
+
+	region := strings.ReplaceAll(strings.ToLower(location), " ", "")
+
+	output := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, subresourceName := range subresourceNames {
+		zones, ok := lookup(subresources, subresourceName)
+		if !ok {
+			return nil, fmt.Errorf("the Private DNS Zones for the subresource %q of %q aren't known - possible values are %s", subresourceName, resourceType, possibleSubresourceNames(subresources))
+		}
+
+		names, ok := zones[cloud]
+		if !ok {
+			return nil, fmt.Errorf("Private Endpoints for the subresource %q of %q aren't available in the %q cloud", subresourceName, resourceType, string(cloud))
+		}
+
+		for _, name := range names {
+			if strings.Contains(name, regionPlaceholder) {
+				if region == "" {
+					return nil, fmt.Errorf("the Private DNS Zones for the subresource %q of %q are regional, a location must be specified", subresourceName, resourceType)
+				}
+				name = strings.ReplaceAll(name, regionPlaceholder, region)
+			}
+
+			if _, exists := seen[name]; exists {
+				continue
+			}
+			seen[name] = struct{}{}
+			output = append(output, name)
+		}
+	}
+
+	return output, nil
+}
+
+// ResourceTypeFromID returns the resource type (e.g. `Microsoft.Storage/storageAccounts`) of the top-level resource
+// referenced by the Resource ID `input`
+func ResourceTypeFromID(input string) (string, error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if !strings.EqualFold(segments[i], "providers") {
+			continue
+		}
+
+		if i+3 >= len(segments) {
+			break
+		}
+
+		return fmt.Sprintf("%s/%s", segments[i+1], segments[i+2]), nil
+	}
+
+	return "", fmt.Errorf("determining the resource type from the Resource ID %q", input)
+}
+
+// lookup returns the value for the key `name` within `input`, where the key is compared case-insensitively
+func lookup[T any](input map[string]T, name string) (T, bool) {
+	for k, v := range input {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+
+	var empty T
+	return empty, false
+}
+
+func possibleSubresourceNames(input map[string]dnsZones) string {
+	names := make([]string, 0, len(input))
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return fmt.Sprintf("`%s`", strings.Join(names, "`, `"))
+}
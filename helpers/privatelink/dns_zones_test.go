@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatelink
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCloudForEnvironment(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected Cloud
+		Valid    bool
+	}{
+		{
+			Input:    "public",
+			Expected: CloudPublic,
+			Valid:    true,
+		},
+		{
+			Input:    "Public",
+			Expected: CloudPublic,
+			Valid:    true,
+		},
+		{
+			Input:    "USGovernment",
+			Expected: CloudUSGovernment,
+			Valid:    true,
+		},
+		{
+			Input:    "USGovernmentL5",
+			Expected: CloudUSGovernment,
+			Valid:    true,
+		},
+		{
+			Input:    "china",
+			Expected: CloudChina,
+			Valid:    true,
+		},
+		{
+			Input: "FromEnvironment",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		actual, err := CloudForEnvironment(tc.Input)
+		if err != nil {
+			if tc.Valid {
+				t.Fatalf("expected %q to be valid but got: %+v", tc.Input, err)
+			}
+			continue
+		}
+
+		if !tc.Valid {
+			t.Fatalf("expected %q to be invalid but got %q", tc.Input, actual)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestDnsZoneNames(t *testing.T) {
+	cases := []struct {
+		Cloud            Cloud
+		ResourceType     string
+		SubresourceNames []string
+		Location         string
+		Expected         []string
+		ExpectedError    string
+	}{
+		{
+			Cloud:            CloudPublic,
+			ResourceType:     "Microsoft.Storage/storageAccounts",
+			SubresourceNames: []string{"blob"},
+			Expected:         []string{"privatelink.blob.core.windows.net"},
+		},
+		{
+			Cloud:            CloudUSGovernment,
+			ResourceType:     "microsoft.storage/storageaccounts",
+			SubresourceNames: []string{"blob", "BLOB_SECONDARY", "dfs"},
+			Expected:         []string{"privatelink.blob.core.usgovcloudapi.net", "privatelink.dfs.core.usgovcloudapi.net"},
+		},
+		{
+			Cloud:            CloudChina,
+			ResourceType:     "Microsoft.KeyVault/vaults",
+			SubresourceNames: []string{"vault"},
+			Expected:         []string{"privatelink.vaultcore.azure.cn"},
+		},
+		{
+			Cloud:            CloudPublic,
+			ResourceType:     "Microsoft.ContainerRegistry/registries",
+			SubresourceNames: []string{"registry"},
+			Location:         "West Europe",
+			Expected:         []string{"privatelink.azurecr.io", "westeurope.data.privatelink.azurecr.io"},
+		},
+		{
+			Cloud:            CloudPublic,
+			ResourceType:     "Microsoft.ContainerService/managedClusters",
+			SubresourceNames: []string{"management"},
+			ExpectedError:    "a location must be specified",
+		},
+		{
+			Cloud:            CloudChina,
+			ResourceType:     "Microsoft.Purview/accounts",
+			SubresourceNames: []string{"account"},
+			ExpectedError:    "aren't available in the \"china\" cloud",
+		},
+		{
+			Cloud:            CloudPublic,
+			ResourceType:     "Microsoft.Storage/storageAccounts",
+			SubresourceNames: []string{"bucket"},
+			ExpectedError:    "possible values are `blob`",
+		},
+		{
+			Cloud:            CloudPublic,
+			ResourceType:     "Microsoft.Example/widgets",
+			SubresourceNames: []string{"widget"},
+			ExpectedError:    "aren't known",
+		},
+		{
+			Cloud:            CloudPublic,
+			ResourceType:     "Microsoft.Storage/storageAccounts",
+			SubresourceNames: []string{},
+			ExpectedError:    "at least one subresource name must be specified",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q / %+v in %q", tc.ResourceType, tc.SubresourceNames, tc.Cloud)
+
+		actual, err := DnsZoneNames(tc.Cloud, tc.ResourceType, tc.SubresourceNames, tc.Location)
+		if err != nil {
+			if tc.ExpectedError == "" {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			if !strings.Contains(err.Error(), tc.ExpectedError) {
+				t.Fatalf("expected the error to contain %q but got: %+v", tc.ExpectedError, err)
+			}
+			continue
+		}
+
+		if tc.ExpectedError != "" {
+			t.Fatalf("expected an error containing %q but got %+v", tc.ExpectedError, actual)
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestResourceTypeFromID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Valid    bool
+	}{
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example",
+			Expected: "Microsoft.Storage/storageAccounts",
+			Valid:    true,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Sql/servers/example/databases/example",
+			Expected: "Microsoft.Sql/servers",
+			Valid:    true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Valid: false,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		actual, err := ResourceTypeFromID(tc.Input)
+		if err != nil {
+			if tc.Valid {
+				t.Fatalf("expected %q to be valid but got: %+v", tc.Input, err)
+			}
+			continue
+		}
+
+		if !tc.Valid {
+			t.Fatalf("expected %q to be invalid but got %q", tc.Input, actual)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
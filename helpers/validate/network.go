@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/cidr"
 )
 
 // CIDR is a SchemaValidateFunc which tests if the provided value is a valid IPv4 CIDR
@@ -21,6 +23,22 @@ func CIDR(i interface{}, k string) (warnings []string, errors []error) {
 	return warnings, errors
 }
 
+// CIDRNetworkAddress is a SchemaValidateFunc which tests if the provided value is a valid IPv4 or IPv6 CIDR
+// without any host bits set, e.g. `10.0.1.0/24` rather than `10.0.1.5/24`
+func CIDRNetworkAddress(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := cidr.Parse(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid CIDR network address: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+
 func IPv4Address(i interface{}, k string) (warnings []string, errors []error) {
 	v, ok := i.(string)
 	if !ok {
@@ -46,6 +46,48 @@ func TestCIDR(t *testing.T) {
 	}
 }
 
+func TestCIDRNetworkAddress(t *testing.T) {
+	cases := []struct {
+		CIDR   string
+		Errors int
+	}{
+		{
+			CIDR:   "",
+			Errors: 1,
+		},
+		{
+			CIDR:   "10.0.0.0",
+			Errors: 1,
+		},
+		{
+			CIDR:   "10.0.1.0/24",
+			Errors: 0,
+		},
+		{
+			CIDR:   "10.0.1.5/24",
+			Errors: 1,
+		},
+		{
+			CIDR:   "fd00:db8::/64",
+			Errors: 0,
+		},
+		{
+			CIDR:   "fd00:db8::1/64",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.CIDR, func(t *testing.T) {
+			_, errors := CIDRNetworkAddress(tc.CIDR, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected CIDRNetworkAddress to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
 func TestIPv4Address(t *testing.T) {
 	cases := []struct {
 		IP     string
@@ -86,5 +86,13 @@ func Default() UserFeatures {
 		DatabricksWorkspace: DatabricksWorkspaceFeatures{
 			ForceDelete: false,
 		},
+		PreflightValidation: PreflightValidationFeatures{
+			Enabled:                 false,
+			CheckPolicyRestrictions: false,
+		},
+		FirewallPolicy: FirewallPolicyFeatures{
+			RuleAnalysisEnabled:        false,
+			FailOnRuleAnalysisFindings: true,
+		},
 	}
 }
@@ -23,6 +23,8 @@ type UserFeatures struct {
 	RecoveryService          RecoveryServiceFeatures
 	NetApp                   NetAppFeatures
 	DatabricksWorkspace      DatabricksWorkspaceFeatures
+	PreflightValidation      PreflightValidationFeatures
+	FirewallPolicy           FirewallPolicyFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -121,3 +123,13 @@ type NetAppFeatures struct {
 type DatabricksWorkspaceFeatures struct {
 	ForceDelete bool
 }
+
+type PreflightValidationFeatures struct {
+	Enabled                 bool
+	CheckPolicyRestrictions bool
+}
+
+type FirewallPolicyFeatures struct {
+	RuleAnalysisEnabled        bool
+	FailOnRuleAnalysisFindings bool
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Mode defines how a resource is locked when using ByHierarchy
+type Mode int
+
+const (
+	// Shared allows any number of callers to hold the lock at once, and is used when a resource is being
+	// depended on (for example an NSG being associated with a Subnet), or when one of its children is being modified.
+	Shared Mode = iota
+
+	// Exclusive allows only a single caller to hold the lock, and blocks until no other caller holds a lock on
+	// either the resource or any of its children.
+	Exclusive
+)
+
+func (m Mode) String() string {
+	if m == Exclusive {
+		return "exclusive"
+	}
+	return "shared"
+}
+
+// Request is a request to lock the ARM Resource ID `ID` in the specified `Mode` using ByHierarchy
+type Request struct {
+	ID   string
+	Mode Mode
+}
+
+// SharedByID returns a Request to take a Shared lock on the specified ARM Resource ID
+func SharedByID(id string) Request {
+	return Request{
+		ID:   id,
+		Mode: Shared,
+	}
+}
+
+// ExclusiveByID returns a Request to take an Exclusive lock on the specified ARM Resource ID
+func ExclusiveByID(id string) Request {
+	return Request{
+		ID:   id,
+		Mode: Exclusive,
+	}
+}
+
+// legacyResourceNames maps the (lower-cased) ARM Resource Types which can be locked using ByHierarchy to
+// the names used by callers of ByName, so that callers which haven't yet been migrated continue to be
+// serialized against callers of ByHierarchy. The position in this list is the order in which these locks
+// are acquired, which matches the order used by existing callers of ByName (e.g. NSG -> VNet -> Subnet).
+var legacyResourceNames = []struct {
+	resourceType string
+	name         string
+}{
+	{resourceType: "microsoft.network/natgateways", name: "azurerm_nat_gateway"},
+	{resourceType: "microsoft.network/networksecuritygroups", name: "azurerm_network_security_group"},
+	{resourceType: "microsoft.network/routetables", name: "azurerm_route_table"},
+	{resourceType: "microsoft.network/virtualnetworks", name: "azurerm_virtual_network"},
+	{resourceType: "microsoft.network/virtualnetworks/subnets", name: "azurerm_subnet"},
+}
+
+// ByHierarchy locks the specified ARM Resource IDs, taking into account the parent/child relationship between them:
+//
+// * a Shared lock is taken on every parent of each requested Resource ID - for example locking a Subnet takes a
+// Shared lock on its Virtual Network, meaning that Subnets within the same Virtual Network can be locked concurrently
+// whilst an Exclusive lock on the Virtual Network waits for (and then blocks) all of them.
+// * locks are always acquired in a deterministic order (parents before children, then lexically), regardless of the
+// order of the requests, which avoids deadlocks between callers locking the same resources in a different order.
+//
+// All of the locks required for an operation must be requested in a single call, and released by calling
+// UnlockByHierarchy with the same requests.
+func ByHierarchy(requests ...Request) {
+	start := time.Now()
+	for _, step := range planHierarchicalLocks(requests) {
+		if step.mode == Exclusive {
+			armMutexKV.Lock(step.key)
+		} else {
+			armMutexKV.RLock(step.key)
+		}
+	}
+
+	if wait := time.Since(start); wait >= time.Second {
+		log.Printf("[INFO] Waited %s to acquire the locks for %s", wait.Round(time.Millisecond), describeRequests(requests))
+	} else {
+		log.Printf("[DEBUG] Waited %s to acquire the locks for %s", wait, describeRequests(requests))
+	}
+}
+
+// UnlockByHierarchy releases the locks previously acquired by calling ByHierarchy with the same requests
+func UnlockByHierarchy(requests ...Request) {
+	steps := planHierarchicalLocks(requests)
+	for i := len(steps) - 1; i >= 0; i-- {
+		step := steps[i]
+		if step.mode == Exclusive {
+			armMutexKV.Unlock(step.key)
+		} else {
+			armMutexKV.RUnlock(step.key)
+		}
+	}
+}
+
+type lockStep struct {
+	key  string
+	mode Mode
+}
+
+type resourceNode struct {
+	// key is the lower-cased Resource ID of this node, which is used as the lock key
+	key string
+
+	// resourceType is the lower-cased Resource Type of this node, e.g. `microsoft.network/virtualnetworks/subnets`
+	resourceType string
+
+	// name is the name of this resource, in the casing it was specified
+	name string
+}
+
+// planHierarchicalLocks returns the locks which need to be acquired (in order) to satisfy the requests
+func planHierarchicalLocks(requests []Request) []lockStep {
+	modes := make(map[string]Mode)
+	legacyKeys := make(map[string]int)
+
+	for _, request := range requests {
+		nodes := parseResourceHierarchy(request.ID)
+		for i, node := range nodes {
+			mode := Shared
+			if i == len(nodes)-1 {
+				mode = request.Mode
+			}
+			if existing, ok := modes[node.key]; !ok || mode > existing {
+				modes[node.key] = mode
+			}
+
+			for priority, legacy := range legacyResourceNames {
+				if legacy.resourceType == node.resourceType {
+					legacyKeys[legacy.name+"."+node.name] = priority
+				}
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(modes))
+	for key := range modes {
+		keys = append(keys, key)
+	}
+	// since a parent's Resource ID is a prefix of its children's, sorting also guarantees parents are locked first
+	sort.Strings(keys)
+
+	legacy := make([]string, 0, len(legacyKeys))
+	for key := range legacyKeys {
+		legacy = append(legacy, key)
+	}
+	sort.Slice(legacy, func(i, j int) bool {
+		if legacyKeys[legacy[i]] != legacyKeys[legacy[j]] {
+			return legacyKeys[legacy[i]] < legacyKeys[legacy[j]]
+		}
+		return legacy[i] < legacy[j]
+	})
+
+	steps := make([]lockStep, 0, len(keys)+len(legacy))
+	for _, key := range keys {
+		steps = append(steps, lockStep{
+			key:  key,
+			mode: modes[key],
+		})
+	}
+	// callers of ByName take these exclusively, so a shared lock is sufficient to serialize against them
+	// without serializing callers of ByHierarchy against one another
+	for _, key := range legacy {
+		steps = append(steps, lockStep{
+			key:  key,
+			mode: Shared,
+		})
+	}
+
+	return steps
+}
+
+// parseResourceHierarchy splits an ARM Resource ID into each of the resources it's comprised of, for example
+// a Subnet ID is split into the Subscription, Resource Group, Virtual Network and Subnet
+func parseResourceHierarchy(id string) []resourceNode {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	nodes := make([]resourceNode, 0)
+
+	resourceType := ""
+	withinProvider := false
+	for i := 0; i+1 < len(segments); i += 2 {
+		key, value := segments[i], segments[i+1]
+		if key == "" || value == "" {
+			break
+		}
+
+		if strings.EqualFold(key, "providers") {
+			resourceType = value
+			withinProvider = true
+			continue
+		}
+
+		if withinProvider {
+			resourceType = resourceType + "/" + key
+		} else {
+			resourceType = key
+		}
+
+		nodes = append(nodes, resourceNode{
+			key:          "/" + strings.ToLower(strings.Join(segments[:i+2], "/")),
+			resourceType: strings.ToLower(resourceType),
+			name:         value,
+		})
+	}
+
+	return nodes
+}
+
+func describeRequests(requests []Request) string {
+	descriptions := make([]string, 0, len(requests))
+	for _, request := range requests {
+		descriptions = append(descriptions, request.Mode.String()+" "+request.ID)
+	}
+	return strings.Join(descriptions, ", ")
+}
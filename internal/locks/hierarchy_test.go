@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testVirtualNetworkId = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	testSubnet1Id        = testVirtualNetworkId + "/subnets/subnet1"
+	testSubnet2Id        = testVirtualNetworkId + "/subnets/subnet2"
+	testNsgId            = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1"
+)
+
+func TestParseResourceHierarchy(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected []resourceNode
+	}{
+		{
+			Input:    "",
+			Expected: []resourceNode{},
+		},
+		{
+			Input: testSubnet1Id,
+			Expected: []resourceNode{
+				{
+					key:          "/subscriptions/12345678-1234-9876-4563-123456789012",
+					resourceType: "subscriptions",
+					name:         "12345678-1234-9876-4563-123456789012",
+				},
+				{
+					key:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1",
+					resourceType: "resourcegroups",
+					name:         "group1",
+				},
+				{
+					key:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1",
+					resourceType: "microsoft.network/virtualnetworks",
+					name:         "network1",
+				},
+				{
+					key:          "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1/subnets/subnet1",
+					resourceType: "microsoft.network/virtualnetworks/subnets",
+					name:         "subnet1",
+				},
+			},
+		},
+		{
+			// extension resources are scoped to their parent
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock1",
+			Expected: []resourceNode{
+				{
+					key:          "/subscriptions/12345678-1234-9876-4563-123456789012",
+					resourceType: "subscriptions",
+					name:         "12345678-1234-9876-4563-123456789012",
+				},
+				{
+					key:          "/subscriptions/12345678-1234-9876-4563-123456789012/providers/microsoft.authorization/locks/lock1",
+					resourceType: "microsoft.authorization/locks",
+					name:         "lock1",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			actual := parseResourceHierarchy(tc.Input)
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestPlanHierarchicalLocks(t *testing.T) {
+	expected := []lockStep{
+		{key: "/subscriptions/12345678-1234-9876-4563-123456789012", mode: Shared},
+		{key: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1", mode: Shared},
+		{key: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.network/networksecuritygroups/nsg1", mode: Shared},
+		{key: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1", mode: Shared},
+		{key: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1/subnets/subnet1", mode: Exclusive},
+		{key: "azurerm_network_security_group.nsg1", mode: Shared},
+		{key: "azurerm_virtual_network.network1", mode: Shared},
+		{key: "azurerm_subnet.subnet1", mode: Shared},
+	}
+
+	// the order of the requests mustn't matter
+	first := planHierarchicalLocks([]Request{ExclusiveByID(testSubnet1Id), SharedByID(testNsgId)})
+	second := planHierarchicalLocks([]Request{SharedByID(testNsgId), ExclusiveByID(testSubnet1Id)})
+
+	if !reflect.DeepEqual(first, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, first)
+	}
+	if !reflect.DeepEqual(second, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, second)
+	}
+}
+
+func TestPlanHierarchicalLocksStrongestModeWins(t *testing.T) {
+	steps := planHierarchicalLocks([]Request{ExclusiveByID(testSubnet1Id), ExclusiveByID(testVirtualNetworkId), SharedByID(testSubnet1Id)})
+
+	modes := make(map[string]Mode)
+	for _, step := range steps {
+		if _, ok := modes[step.key]; ok {
+			t.Fatalf("expected %q to only be locked once", step.key)
+		}
+		modes[step.key] = step.mode
+	}
+
+	if v := modes["/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1"]; v != Exclusive {
+		t.Fatalf("expected the virtual network to be locked exclusively but got %s", v)
+	}
+	if v := modes["/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1/subnets/subnet1"]; v != Exclusive {
+		t.Fatalf("expected the subnet to be locked exclusively but got %s", v)
+	}
+}
+
+func TestByHierarchySiblingsAreConcurrent(t *testing.T) {
+	ByHierarchy(ExclusiveByID(testSubnet1Id))
+	defer UnlockByHierarchy(ExclusiveByID(testSubnet1Id))
+
+	acquired := make(chan struct{})
+	go func() {
+		ByHierarchy(ExclusiveByID(testSubnet2Id))
+		UnlockByHierarchy(ExclusiveByID(testSubnet2Id))
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for a lock on a sibling subnet")
+	}
+}
+
+func TestByHierarchyParentWaitsForChildren(t *testing.T) {
+	ByHierarchy(ExclusiveByID(testSubnet1Id))
+
+	acquired := make(chan struct{})
+	go func() {
+		ByHierarchy(ExclusiveByID(testVirtualNetworkId))
+		UnlockByHierarchy(ExclusiveByID(testVirtualNetworkId))
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatalf("expected the virtual network lock to wait for the subnet lock to be released")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	UnlockByHierarchy(ExclusiveByID(testSubnet1Id))
+
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the virtual network lock")
+	}
+}
+
+func TestByHierarchySerializesWithByName(t *testing.T) {
+	ByName("network1", "azurerm_virtual_network")
+
+	acquired := make(chan struct{})
+	go func() {
+		ByHierarchy(ExclusiveByID(testSubnet1Id))
+		UnlockByHierarchy(ExclusiveByID(testSubnet1Id))
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatalf("expected the subnet lock to wait for the legacy virtual network lock to be released")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	UnlockByName("network1", "azurerm_virtual_network")
+
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the subnet lock")
+	}
+}
+
+func TestByHierarchyContention(t *testing.T) {
+	// each worker requests the same set of locks in a different order, which would deadlock if the
+	// locks were acquired in the order requested
+	requests := [][]Request{
+		{ExclusiveByID(testSubnet1Id), ExclusiveByID(testNsgId)},
+		{ExclusiveByID(testNsgId), ExclusiveByID(testSubnet1Id)},
+		{SharedByID(testNsgId), ExclusiveByID(testSubnet2Id), ExclusiveByID(testSubnet1Id)},
+		{ExclusiveByID(testVirtualNetworkId), SharedByID(testNsgId)},
+	}
+
+	counter := 0
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		for _, request := range requests {
+			wg.Add(1)
+			go func(request []Request) {
+				defer wg.Done()
+				ByHierarchy(request...)
+				defer UnlockByHierarchy(request...)
+
+				// every request holds an exclusive lock on either subnet1 or the virtual network, so this is safe
+				counter++
+			}(request)
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatalf("timed out waiting for the locks - possible deadlock")
+	}
+
+	if expected := 20 * len(requests); counter != expected {
+		t.Fatalf("expected the counter to be %d but got %d", expected, counter)
+	}
+}
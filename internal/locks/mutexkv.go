@@ -13,7 +13,7 @@ import (
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*sync.RWMutex
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
@@ -31,13 +31,29 @@ func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
+// RLock takes a shared lock on the mutex for the given key, which can be held by
+// multiple callers at once but not at the same time as Lock. Caller is
+// responsible for calling RUnlock for the same key
+func (m *mutexKV) RLock(key string) {
+	log.Printf("[DEBUG] Locking %q (shared)", key)
+	m.get(key).RLock()
+	log.Printf("[DEBUG] Locked %q (shared)", key)
+}
+
+// RUnlock releases a shared lock on the mutex for the given key. Caller must have called RLock for the same key first
+func (m *mutexKV) RUnlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q (shared)", key)
+	m.get(key).RUnlock()
+	log.Printf("[DEBUG] Unlocked %q (shared)", key)
+}
+
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *sync.RWMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &sync.RWMutex{}
 		m.store[key] = mutex
 	}
 	return mutex
@@ -46,6 +62,6 @@ func (m *mutexKV) get(key string) *sync.Mutex {
 // newMutexKV returns a properly initialized mutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*sync.RWMutex),
 	}
 }
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+var (
+	// virtualMachineSkus caches the Virtual Machine SKUs available within a Subscription/Location, since the same
+	// information is needed by every Virtual Machine/Scale Set/Node Pool being planned in that Location.
+	virtualMachineSkus     = make(map[string]*virtualMachineSkusCacheEntry)
+	virtualMachineSkusLock = &sync.Mutex{}
+)
+
+// virtualMachineSkusCacheEntry holds the Virtual Machine SKUs for a single Subscription/Location - the lock is held
+// whilst these are retrieved, so that concurrent checks in the same Location share a single API call without
+// blocking the checks for other Locations.
+type virtualMachineSkusCacheEntry struct {
+	sync.Mutex
+
+	loaded bool
+	skus   []skus.ResourceSku
+}
+
+// CheckVirtualMachineSize checks that the Virtual Machine Size `size` is available to the current Subscription
+// within the specified `locationName` and (optionally) the Availability Zones specified in `zones`.
+func CheckVirtualMachineSize(ctx context.Context, client *clients.Client, locationName string, size string, zones []string) error {
+	if locationName == "" || size == "" {
+		return nil
+	}
+
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+	available, err := listVirtualMachineSkus(ctx, client.Compute.SkusClient, subscriptionId, location.Normalize(locationName))
+	if err != nil {
+		log.Printf("[DEBUG] Skipping Preflight Validation of the Virtual Machine Size %q: %+v", size, err)
+		//nolint:nilerr // preflight validation is best-effort, so we skip the check rather than failing the plan
+		return nil
+	}
+
+	return checkVirtualMachineSizeAvailability(available, location.Normalize(locationName), size, zones)
+}
+
+func listVirtualMachineSkus(ctx context.Context, client *skus.SkusClient, subscriptionId commonids.SubscriptionId, locationName string) ([]skus.ResourceSku, error) {
+	key := fmt.Sprintf("%s/%s", subscriptionId.SubscriptionId, locationName)
+
+	virtualMachineSkusLock.Lock()
+	entry, ok := virtualMachineSkus[key]
+	if !ok {
+		entry = &virtualMachineSkusCacheEntry{}
+		virtualMachineSkus[key] = entry
+	}
+	virtualMachineSkusLock.Unlock()
+
+	entry.Lock()
+	defer entry.Unlock()
+
+	if entry.loaded {
+		return entry.skus, nil
+	}
+
+	opts := skus.DefaultResourceSkusListOperationOptions()
+	// by default this API returns every SKU in every Location, so we filter to the Location being validated
+	opts.Filter = pointer.To(fmt.Sprintf("location eq '%s'", locationName))
+	resp, err := client.ResourceSkusListComplete(ctx, subscriptionId, opts)
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource SKUs in %q: %+v", locationName, err)
+	}
+
+	output := make([]skus.ResourceSku, 0)
+	for _, sku := range resp.Items {
+		if strings.EqualFold(pointer.From(sku.ResourceType), "virtualMachines") {
+			output = append(output, sku)
+		}
+	}
+
+	entry.skus = output
+	entry.loaded = true
+	return output, nil
+}
+
+func checkVirtualMachineSizeAvailability(input []skus.ResourceSku, locationName string, size string, zones []string) error {
+	// if no Virtual Machine SKUs are returned at all (e.g. the API isn't available in this Cloud) we can't say
+	// anything about the availability of this size
+	if len(input) == 0 {
+		return nil
+	}
+
+	for _, sku := range input {
+		if !strings.EqualFold(pointer.From(sku.Name), size) {
+			continue
+		}
+
+		if sku.Restrictions != nil {
+			for _, restriction := range *sku.Restrictions {
+				reason := string(pointer.From(restriction.ReasonCode))
+
+				switch pointer.From(restriction.Type) {
+				case skus.ResourceSkuRestrictionsTypeLocation:
+					return fmt.Errorf("the Virtual Machine Size %q is not available to this Subscription in %q (reason: %s)", size, locationName, reason)
+
+				case skus.ResourceSkuRestrictionsTypeZone:
+					restrictedZones := make([]string, 0)
+					if restriction.RestrictionInfo != nil {
+						restrictedZones = pointer.From(restriction.RestrictionInfo.Zones)
+					}
+					if unavailable := intersect(zones, restrictedZones); len(unavailable) > 0 {
+						return fmt.Errorf("the Virtual Machine Size %q is not available to this Subscription in Availability Zone(s) %s of %q (reason: %s)", size, strings.Join(unavailable, ", "), locationName, reason)
+					}
+				}
+			}
+		}
+
+		if len(zones) > 0 {
+			supportedZones := make([]string, 0)
+			if sku.LocationInfo != nil {
+				for _, info := range *sku.LocationInfo {
+					if location.Normalize(pointer.From(info.Location)) == locationName && info.Zones != nil {
+						supportedZones = append(supportedZones, *info.Zones...)
+					}
+				}
+			}
+
+			if unsupported := difference(zones, supportedZones); len(unsupported) > 0 {
+				return fmt.Errorf("the Virtual Machine Size %q is not supported in Availability Zone(s) %s of %q", size, strings.Join(unsupported, ", "), locationName)
+			}
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("the Virtual Machine Size %q was not found in %q", size, locationName)
+}
+
+// intersect returns the sorted values present in both `first` and `second`
+func intersect(first []string, second []string) []string {
+	output := make([]string, 0)
+	for _, v := range first {
+		for _, other := range second {
+			if strings.EqualFold(v, other) {
+				output = append(output, v)
+				break
+			}
+		}
+	}
+	sort.Strings(output)
+	return output
+}
+
+// difference returns the sorted values present in `first` but not in `second`
+func difference(first []string, second []string) []string {
+	output := make([]string, 0)
+	for _, v := range first {
+		found := false
+		for _, other := range second {
+			if strings.EqualFold(v, other) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			output = append(output, v)
+		}
+	}
+	sort.Strings(output)
+	return output
+}
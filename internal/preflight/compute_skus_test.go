@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+)
+
+func TestCheckVirtualMachineSizeAvailability(t *testing.T) {
+	available := []skus.ResourceSku{
+		{
+			Name:         pointer.To("Standard_F2"),
+			ResourceType: pointer.To("virtualMachines"),
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("WestEurope"),
+					Zones:    pointer.To(zones.Schema{"1", "2", "3"}),
+				},
+			},
+		},
+		{
+			Name:         pointer.To("Standard_M416ms_v2"),
+			ResourceType: pointer.To("virtualMachines"),
+			Restrictions: &[]skus.ResourceSkuRestrictions{
+				{
+					Type:       pointer.To(skus.ResourceSkuRestrictionsTypeLocation),
+					ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+					RestrictionInfo: &skus.ResourceSkuRestrictionInfo{
+						Locations: pointer.To([]string{"westeurope"}),
+					},
+				},
+			},
+		},
+		{
+			Name:         pointer.To("Standard_D2s_v3"),
+			ResourceType: pointer.To("virtualMachines"),
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("westeurope"),
+					Zones:    pointer.To(zones.Schema{"1", "2", "3"}),
+				},
+			},
+			Restrictions: &[]skus.ResourceSkuRestrictions{
+				{
+					Type:       pointer.To(skus.ResourceSkuRestrictionsTypeZone),
+					ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+					RestrictionInfo: &skus.ResourceSkuRestrictionInfo{
+						Locations: pointer.To([]string{"westeurope"}),
+						Zones:     pointer.To([]string{"3"}),
+					},
+				},
+			},
+		},
+		{
+			Name:         pointer.To("Standard_B1s"),
+			ResourceType: pointer.To("virtualMachines"),
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("westeurope"),
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		Name   string
+		Input  []skus.ResourceSku
+		Size   string
+		Zones  []string
+		Errors bool
+	}{
+		{
+			Name:   "No SKUs returned",
+			Input:  []skus.ResourceSku{},
+			Size:   "Standard_F2",
+			Errors: false,
+		},
+		{
+			Name:   "Available",
+			Input:  available,
+			Size:   "standard_f2",
+			Errors: false,
+		},
+		{
+			Name:   "Available in Zones",
+			Input:  available,
+			Size:   "Standard_F2",
+			Zones:  []string{"1", "3"},
+			Errors: false,
+		},
+		{
+			Name:   "Not Found",
+			Input:  available,
+			Size:   "Standard_Z1",
+			Errors: true,
+		},
+		{
+			Name:   "Restricted in Location",
+			Input:  available,
+			Size:   "Standard_M416ms_v2",
+			Errors: true,
+		},
+		{
+			Name:   "Restricted in another Zone",
+			Input:  available,
+			Size:   "Standard_D2s_v3",
+			Zones:  []string{"1", "2"},
+			Errors: false,
+		},
+		{
+			Name:   "Restricted in Zone",
+			Input:  available,
+			Size:   "Standard_D2s_v3",
+			Zones:  []string{"2", "3"},
+			Errors: true,
+		},
+		{
+			Name:   "Not Zonal",
+			Input:  available,
+			Size:   "Standard_B1s",
+			Errors: false,
+		},
+		{
+			Name:   "Not Zonal with Zones",
+			Input:  available,
+			Size:   "Standard_B1s",
+			Zones:  []string{"1"},
+			Errors: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := checkVirtualMachineSizeAvailability(v.Input, "westeurope", v.Size, v.Zones)
+		if v.Errors && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.Errors && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/deployments"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// deploymentName is the name of the Template Deployment used for validation - since validating a Template
+// Deployment doesn't persist it, this can be shared between resources.
+const deploymentName = "terraform-preflight-validation"
+
+// blockingErrorCodes are the error codes returned from the validation API which indicate the resource can't be
+// provisioned as configured. Since the resource submitted for validation only contains the fields known during the
+// plan, other errors (e.g. about missing required properties) are expected and are ignored.
+var blockingErrorCodes = map[string]struct{}{
+	"LocationNotAvailableForResourceType": {},
+	"OperationNotAllowed":                 {},
+	"QuotaExceeded":                       {},
+	"RequestDisallowedByPolicy":           {},
+	"SkuNotAvailable":                     {},
+}
+
+// Resource is the ARM representation of the resource being validated, containing the fields known during the plan.
+type Resource struct {
+	Type       string
+	ApiVersion string
+	Name       string
+	Location   string
+	Kind       string
+	Sku        map[string]interface{}
+	Zones      []string
+	Tags       map[string]interface{}
+	Properties map[string]interface{}
+}
+
+// content returns the ARM representation of the resource, as used within a Template Deployment
+func (r Resource) content() map[string]interface{} {
+	resource := map[string]interface{}{
+		"type":       r.Type,
+		"apiVersion": r.ApiVersion,
+		"name":       r.Name,
+		"location":   r.Location,
+	}
+	if r.Kind != "" {
+		resource["kind"] = r.Kind
+	}
+	if len(r.Sku) > 0 {
+		resource["sku"] = r.Sku
+	}
+	if len(r.Zones) > 0 {
+		resource["zones"] = r.Zones
+	}
+	if len(r.Tags) > 0 {
+		resource["tags"] = r.Tags
+	}
+	if len(r.Properties) > 0 {
+		resource["properties"] = r.Properties
+	}
+
+	return resource
+}
+
+func (r Resource) template() interface{} {
+	return map[string]interface{}{
+		"$schema":        "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+		"contentVersion": "1.0.0.0",
+		"resources": []interface{}{
+			r.content(),
+		},
+	}
+}
+
+// ValidateResource submits `resource` to the Template Deployment validation API within the Resource Group
+// `resourceGroupName`, which performs the same policy, quota and SKU checks as would be made when the resource
+// is provisioned. When `check_policy_restrictions` is enabled the resource is first evaluated using CheckPolicyRestrictions.
+//
+// The What-If API is intentionally not used: it runs the same preflight checks as Validate, but is a long-running
+// operation which commonly takes longer than the time available to a check during the plan - and the additional
+// information it returns (the predicted changes to the resource) is already provided by the Terraform plan.
+func ValidateResource(ctx context.Context, client *clients.Client, resourceGroupName string, resource Resource) error {
+	if resourceGroupName == "" || resource.Location == "" {
+		return nil
+	}
+
+	if err := CheckPolicyRestrictions(ctx, client, resourceGroupName, resource); err != nil {
+		return err
+	}
+
+	id := deployments.NewResourceGroupProviderDeploymentID(client.Account.SubscriptionId, resourceGroupName, deploymentName)
+	payload := deployments.Deployment{
+		Properties: deployments.DeploymentProperties{
+			Mode:     deployments.DeploymentModeIncremental,
+			Template: pointer.To(resource.template()),
+		},
+	}
+
+	resp, err := client.Resource.DeploymentsClient.Validate(ctx, id, payload)
+	if err != nil {
+		// the Resource Group may be created as a part of this plan
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] Skipping Preflight Validation of %s %q since the Resource Group %q was not found", resource.Type, resource.Name, resourceGroupName)
+			//nolint:nilerr // preflight validation is best-effort, so we skip the check rather than failing the plan
+			return nil
+		}
+
+		validationError := errorFromResponse(resp)
+		if validationError == nil {
+			log.Printf("[DEBUG] Skipping Preflight Validation of %s %q: %+v", resource.Type, resource.Name, err)
+			//nolint:nilerr // preflight validation is best-effort, so we skip the check rather than failing the plan
+			return nil
+		}
+
+		return validationErrorToError(resource, *validationError)
+	}
+
+	result, err := validationResult(ctx, resp)
+	if err != nil {
+		log.Printf("[DEBUG] Skipping Preflight Validation of %s %q: %+v", resource.Type, resource.Name, err)
+		//nolint:nilerr // preflight validation is best-effort, so we skip the check rather than failing the plan
+		return nil
+	}
+
+	if result != nil && result.Error != nil {
+		return validationErrorToError(resource, *result.Error)
+	}
+
+	return nil
+}
+
+// validationResult returns the result of the validation - which is returned in the response when the validation
+// completes synchronously, or in the final response of the long-running operation when the API returns a 202.
+func validationResult(ctx context.Context, resp deployments.ValidateOperationResponse) (*deployments.DeploymentValidateResult, error) {
+	if resp.HttpResponse == nil || resp.HttpResponse.StatusCode != http.StatusAccepted {
+		return resultFromHttpResponse(resp.HttpResponse), nil
+	}
+
+	if err := resp.Poller.PollUntilDone(ctx); err != nil {
+		// when the validation fails the details of the error are returned in the final response
+		if latest := resp.Poller.LatestResponse(); latest != nil {
+			if result := resultFromHttpResponse(latest.Response); result != nil && result.Error != nil {
+				return result, nil
+			}
+		}
+
+		return nil, fmt.Errorf("polling for the validation result: %+v", err)
+	}
+
+	if latest := resp.Poller.LatestResponse(); latest != nil {
+		return resultFromHttpResponse(latest.Response), nil
+	}
+
+	return nil, nil
+}
+
+func resultFromHttpResponse(resp *http.Response) *deployments.DeploymentValidateResult {
+	if resp == nil || resp.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || len(body) == 0 {
+		return nil
+	}
+	// reset the body so that it can be read again
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var result deployments.DeploymentValidateResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil
+	}
+
+	return &result
+}
+
+func errorFromResponse(resp deployments.ValidateOperationResponse) *deployments.ErrorResponse {
+	if result := resultFromHttpResponse(resp.HttpResponse); result != nil && result.Error != nil {
+		return result.Error
+	}
+
+	if resp.OData != nil && resp.OData.Error != nil && resp.OData.Error.Code != nil {
+		return &deployments.ErrorResponse{
+			Code:    resp.OData.Error.Code,
+			Message: resp.OData.Error.Message,
+		}
+	}
+
+	return nil
+}
+
+func validationErrorToError(resource Resource, input deployments.ErrorResponse) error {
+	messages := blockingErrors(input)
+	if len(messages) == 0 {
+		log.Printf("[DEBUG] Ignoring non-blocking Preflight Validation error for %s %q: %s: %s", resource.Type, resource.Name, pointer.From(input.Code), pointer.From(input.Message))
+		return nil
+	}
+
+	return fmt.Errorf("%s %q:\n\n%s", resource.Type, resource.Name, strings.Join(messages, "\n"))
+}
+
+// blockingErrors returns a description of each error (and nested error) with a code listed in blockingErrorCodes
+func blockingErrors(input deployments.ErrorResponse) []string {
+	output := make([]string, 0)
+
+	code := pointer.From(input.Code)
+	if _, ok := blockingErrorCodes[code]; ok {
+		message := fmt.Sprintf("%s: %s", code, pointer.From(input.Message))
+
+		// the `additionalInfo` for a policy denial describes the Policy Assignment/Definition and the offending fields
+		if code == "RequestDisallowedByPolicy" {
+			if raw, err := json.Marshal(input); err == nil {
+				for _, violation := range azure.ParsePolicyViolations(string(raw)) {
+					message += fmt.Sprintf("\n\n%s", violation)
+				}
+			}
+		}
+
+		output = append(output, message)
+	}
+
+	if input.Details != nil {
+		for _, detail := range *input.Details {
+			output = append(output, blockingErrors(detail)...)
+		}
+	}
+
+	return output
+}
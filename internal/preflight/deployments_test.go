@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/deployments"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+func TestBlockingErrors(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    deployments.ErrorResponse
+		Expected []string
+	}{
+		{
+			Name: "Non-blocking",
+			Input: deployments.ErrorResponse{
+				Code:    pointer.To("InvalidTemplateDeployment"),
+				Message: pointer.To("The template deployment is not valid"),
+				Details: &[]deployments.ErrorResponse{
+					{
+						Code:    pointer.To("InvalidParameter"),
+						Message: pointer.To("Required parameter 'osProfile' is missing (null)."),
+					},
+				},
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Top-level",
+			Input: deployments.ErrorResponse{
+				Code:    pointer.To("SkuNotAvailable"),
+				Message: pointer.To("The requested size is not available"),
+			},
+			Expected: []string{
+				"SkuNotAvailable: The requested size is not available",
+			},
+		},
+		{
+			Name: "Nested",
+			Input: deployments.ErrorResponse{
+				Code:    pointer.To("InvalidTemplateDeployment"),
+				Message: pointer.To("The template deployment failed because of policy violation"),
+				Details: &[]deployments.ErrorResponse{
+					{
+						Code:    pointer.To("RequestDisallowedByPolicy"),
+						Message: pointer.To("Resource 'example' was disallowed by policy."),
+					},
+					{
+						Code:    pointer.To("InvalidParameter"),
+						Message: pointer.To("Required parameter 'osProfile' is missing (null)."),
+					},
+				},
+			},
+			Expected: []string{
+				"RequestDisallowedByPolicy: Resource 'example' was disallowed by policy.",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := blockingErrors(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestErrorFromResponse(t *testing.T) {
+	body := `{"error":{"code":"InvalidTemplateDeployment","message":"failed","details":[{"code":"QuotaExceeded","message":"exceeded"}]}}`
+	resp := deployments.ValidateOperationResponse{
+		HttpResponse: &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+		},
+	}
+
+	actual := errorFromResponse(resp)
+	if actual == nil {
+		t.Fatalf("expected an error to be parsed from the response")
+	}
+	if pointer.From(actual.Code) != "InvalidTemplateDeployment" {
+		t.Fatalf("expected the code `InvalidTemplateDeployment` but got %q", pointer.From(actual.Code))
+	}
+	if actual.Details == nil || len(*actual.Details) != 1 || pointer.From((*actual.Details)[0].Code) != "QuotaExceeded" {
+		t.Fatalf("expected a single nested error with the code `QuotaExceeded` but got %+v", actual.Details)
+	}
+
+	if errorFromResponse(deployments.ValidateOperationResponse{}) != nil {
+		t.Fatalf("expected no error to be parsed from an empty response")
+	}
+}
+
+type fakeValidatePoller struct {
+	body   string
+	failed bool
+}
+
+func (p fakeValidatePoller) Poll(_ context.Context) (*pollers.PollResult, error) {
+	resp := &client.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(p.body)),
+		},
+	}
+	if p.failed {
+		return nil, pollers.PollingFailedError{
+			HttpResponse: resp,
+			Message:      "failed",
+		}
+	}
+
+	return &pollers.PollResult{
+		HttpResponse: resp,
+		Status:       pollers.PollingStatusSucceeded,
+	}, nil
+}
+
+func TestValidationResult(t *testing.T) {
+	failedBody := `{"error":{"code":"InvalidTemplateDeployment","message":"failed","details":[{"code":"SkuNotAvailable","message":"unavailable"}]}}`
+	succeededBody := `{"properties":{"provisioningState":"Succeeded"}}`
+
+	testData := []struct {
+		Name          string
+		StatusCode    int
+		Body          string
+		Poller        pollers.PollerType
+		ExpectedCode  string
+		ExpectedError bool
+	}{
+		{
+			Name:       "Synchronous Success",
+			StatusCode: http.StatusOK,
+			Body:       succeededBody,
+		},
+		{
+			Name:         "Synchronous Failure",
+			StatusCode:   http.StatusOK,
+			Body:         failedBody,
+			ExpectedCode: "InvalidTemplateDeployment",
+		},
+		{
+			Name:       "Asynchronous Success",
+			StatusCode: http.StatusAccepted,
+			Poller:     fakeValidatePoller{body: succeededBody},
+		},
+		{
+			Name:         "Asynchronous Failure in the Result",
+			StatusCode:   http.StatusAccepted,
+			Poller:       fakeValidatePoller{body: failedBody},
+			ExpectedCode: "InvalidTemplateDeployment",
+		},
+		{
+			Name:         "Asynchronous Failed Operation",
+			StatusCode:   http.StatusAccepted,
+			Poller:       fakeValidatePoller{body: failedBody, failed: true},
+			ExpectedCode: "InvalidTemplateDeployment",
+		},
+		{
+			Name:          "Asynchronous Failed Operation without a Result",
+			StatusCode:    http.StatusAccepted,
+			Poller:        fakeValidatePoller{failed: true},
+			ExpectedError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		resp := deployments.ValidateOperationResponse{
+			HttpResponse: &http.Response{
+				StatusCode: v.StatusCode,
+				Body:       io.NopCloser(bytes.NewBufferString(v.Body)),
+			},
+		}
+		if v.Poller != nil {
+			resp.Poller = pollers.NewPoller(v.Poller, 0, 1)
+		}
+
+		ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+		actual, err := validationResult(ctx, resp)
+		cancel()
+
+		if v.ExpectedError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		actualCode := ""
+		if actual != nil && actual.Error != nil {
+			actualCode = pointer.From(actual.Error.Code)
+		}
+		if actualCode != v.ExpectedCode {
+			t.Fatalf("expected the error code %q but got %q", v.ExpectedCode, actualCode)
+		}
+	}
+}
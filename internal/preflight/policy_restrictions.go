@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/policyinsights/2024-10-01/checkpolicyrestrictions"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// CheckPolicyRestrictions evaluates `resource` against the Azure Policies assigned to the Resource Group
+// `resourceGroupName` using the Check Policy Restrictions API, returning an error when the resource would be denied.
+//
+// This is only performed when `check_policy_restrictions` is enabled within the `preflight_validation` feature.
+func CheckPolicyRestrictions(ctx context.Context, client *clients.Client, resourceGroupName string, resource Resource) error {
+	if !client.Features.PreflightValidation.CheckPolicyRestrictions || resourceGroupName == "" {
+		return nil
+	}
+
+	id := commonids.NewResourceGroupID(client.Account.SubscriptionId, resourceGroupName)
+	payload := checkpolicyrestrictions.CheckRestrictionsRequest{
+		ResourceDetails: checkpolicyrestrictions.CheckRestrictionsResourceDetails{
+			ApiVersion:      pointer.To(resource.ApiVersion),
+			ResourceContent: resource.content(),
+		},
+	}
+
+	resp, err := client.Policy.CheckPolicyRestrictionsClient.PolicyRestrictionsCheckAtResourceGroupScope(ctx, id, payload)
+	if err != nil {
+		// the Resource Group may be created as a part of this plan, or the principal may not have permission to
+		// evaluate the Policies assigned to it
+		log.Printf("[DEBUG] Skipping the Policy Restrictions check for %s %q: %+v", resource.Type, resource.Name, err)
+		//nolint:nilerr // preflight validation is best-effort, so we skip the check rather than failing the plan
+		return nil
+	}
+
+	if resp.Model == nil {
+		return nil
+	}
+
+	violations := policyViolationsFromRestrictions(*resp.Model)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(violations))
+	for _, v := range violations {
+		descriptions = append(descriptions, v.String())
+	}
+
+	return fmt.Errorf("%s %q would be denied by Azure Policy:\n\n%s", resource.Type, resource.Name, strings.Join(descriptions, "\n\n"))
+}
+
+// policyViolationsFromRestrictions returns the non-compliant Policy Evaluations which would deny the request
+func policyViolationsFromRestrictions(input checkpolicyrestrictions.CheckRestrictionsResult) []azure.PolicyViolation {
+	output := make([]azure.PolicyViolation, 0)
+	if input.ContentEvaluationResult == nil || input.ContentEvaluationResult.PolicyEvaluations == nil {
+		return output
+	}
+
+	for _, evaluation := range *input.ContentEvaluationResult.PolicyEvaluations {
+		if !strings.EqualFold(pointer.From(evaluation.EvaluationResult), "NonCompliant") {
+			continue
+		}
+
+		effect := ""
+		if evaluation.EffectDetails != nil {
+			effect = pointer.From(evaluation.EffectDetails.PolicyEffect)
+		}
+		if !strings.EqualFold(effect, "Deny") {
+			continue
+		}
+
+		violation := azure.PolicyViolation{
+			Effect: effect,
+			Fields: make([]azure.PolicyViolationField, 0),
+		}
+		if info := evaluation.PolicyInfo; info != nil {
+			violation.PolicyAssignmentId = pointer.From(info.PolicyAssignmentId)
+			violation.PolicyDefinitionId = pointer.From(info.PolicyDefinitionId)
+			violation.PolicySetDefinitionId = pointer.From(info.PolicySetDefinitionId)
+		}
+
+		if details := evaluation.EvaluationDetails; details != nil && details.EvaluatedExpressions != nil {
+			for _, expression := range *details.EvaluatedExpressions {
+				if !strings.EqualFold(pointer.From(expression.ExpressionKind), "Field") {
+					continue
+				}
+
+				field := azure.PolicyViolationField{
+					Path:     pointer.From(expression.Path),
+					Alias:    pointer.From(expression.Expression),
+					Operator: pointer.From(expression.Operator),
+				}
+				if expression.ExpressionValue != nil {
+					field.Value = *expression.ExpressionValue
+				}
+				if expression.TargetValue != nil {
+					field.TargetValue = *expression.TargetValue
+				}
+				violation.Fields = append(violation.Fields, field)
+			}
+		}
+
+		output = append(output, violation)
+	}
+
+	return output
+}
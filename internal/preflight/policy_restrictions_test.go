@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/policyinsights/2024-10-01/checkpolicyrestrictions"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+func TestPolicyViolationsFromRestrictions(t *testing.T) {
+	var targetValue interface{} = "Enabled"
+	var expressionValue interface{} = "Disabled"
+
+	evaluation := func(result, effect string) checkpolicyrestrictions.PolicyEvaluationResult {
+		return checkpolicyrestrictions.PolicyEvaluationResult{
+			EvaluationResult: pointer.To(result),
+			EffectDetails: &checkpolicyrestrictions.PolicyEffectDetails{
+				PolicyEffect: pointer.To(effect),
+			},
+			PolicyInfo: &checkpolicyrestrictions.PolicyReference{
+				PolicyAssignmentId: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/assignment1"),
+				PolicyDefinitionId: pointer.To("/providers/Microsoft.Authorization/policyDefinitions/definition1"),
+			},
+			EvaluationDetails: &checkpolicyrestrictions.CheckRestrictionEvaluationDetails{
+				EvaluatedExpressions: &[]checkpolicyrestrictions.ExpressionEvaluationDetails{
+					{
+						Expression:     pointer.To("type"),
+						ExpressionKind: pointer.To("Field"),
+						Operator:       pointer.To("Equals"),
+						Path:           pointer.To("type"),
+					},
+					{
+						Expression:      pointer.To("Microsoft.Storage/storageAccounts/publicNetworkAccess"),
+						ExpressionKind:  pointer.To("Field"),
+						ExpressionValue: &expressionValue,
+						Operator:        pointer.To("NotEquals"),
+						Path:            pointer.To("properties.publicNetworkAccess"),
+						TargetValue:     &targetValue,
+					},
+					{
+						Expression:     pointer.To("[parameters('effect')]"),
+						ExpressionKind: pointer.To("Value"),
+					},
+				},
+			},
+		}
+	}
+
+	testData := []struct {
+		name     string
+		input    checkpolicyrestrictions.CheckRestrictionsResult
+		expected []azure.PolicyViolation
+	}{
+		{
+			name:     "no content evaluation result",
+			input:    checkpolicyrestrictions.CheckRestrictionsResult{},
+			expected: []azure.PolicyViolation{},
+		},
+		{
+			name: "compliant and non-deny evaluations are ignored",
+			input: checkpolicyrestrictions.CheckRestrictionsResult{
+				ContentEvaluationResult: &checkpolicyrestrictions.CheckRestrictionsResultContentEvaluationResult{
+					PolicyEvaluations: &[]checkpolicyrestrictions.PolicyEvaluationResult{
+						evaluation("Compliant", "Deny"),
+						evaluation("NonCompliant", "Audit"),
+					},
+				},
+			},
+			expected: []azure.PolicyViolation{},
+		},
+		{
+			name: "non-compliant deny evaluation",
+			input: checkpolicyrestrictions.CheckRestrictionsResult{
+				ContentEvaluationResult: &checkpolicyrestrictions.CheckRestrictionsResultContentEvaluationResult{
+					PolicyEvaluations: &[]checkpolicyrestrictions.PolicyEvaluationResult{
+						evaluation("NonCompliant", "deny"),
+					},
+				},
+			},
+			expected: []azure.PolicyViolation{
+				{
+					PolicyAssignmentId: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/assignment1",
+					PolicyDefinitionId: "/providers/Microsoft.Authorization/policyDefinitions/definition1",
+					Effect:             "deny",
+					Fields: []azure.PolicyViolationField{
+						{
+							Path:     "type",
+							Alias:    "type",
+							Operator: "Equals",
+						},
+						{
+							Path:        "properties.publicNetworkAccess",
+							Alias:       "Microsoft.Storage/storageAccounts/publicNetworkAccess",
+							Operator:    "NotEquals",
+							Value:       "Disabled",
+							TargetValue: "Enabled",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := policyViolationsFromRestrictions(v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package preflight contains opt-in, plan-time validation which calls out to the Azure Resource Manager APIs
+// to surface errors which would otherwise only be returned at apply time (for example a Virtual Machine Size
+// which isn't available in a given Region, or a request which is denied by Azure Policy).
+//
+// This functionality is best-effort: if the information required to perform a check isn't known during the plan,
+// or the API used to perform a check returns an unexpected error, the check is skipped and the existing behaviour
+// (surfacing the error at apply time) applies.
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// timeout is the maximum amount of time the preflight validation for a single resource can take, since this
+// runs during the plan we'd rather skip the check than block the plan for a long period of time.
+const timeout = 2 * time.Minute
+
+// ValidateFunc performs the preflight validation for a resource, returning an error when the resource would
+// fail to be provisioned.
+type ValidateFunc func(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client) error
+
+// CustomizeDiff returns a CustomizeDiffFunc which runs `validate` when the `preflight_validation` feature is
+// enabled and either the resource is being created, or one of the fields specified in `keys` has changed.
+func CustomizeDiff(validate ValidateFunc, keys ...string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil || !client.Features.PreflightValidation.Enabled {
+			return nil
+		}
+
+		if d.Id() != "" && !d.HasChanges(keys...) {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		if err := validate(ctx, d, client); err != nil {
+			return fmt.Errorf("preflight validation failed: %+v\n\nPreflight validation can be disabled by setting `enabled` to `false` within the `preflight_validation` block of the Provider `features` block", err)
+		}
+
+		return nil
+	}
+}
+
+// ValuesKnown returns whether the values for all of the specified `keys` are known during the plan - preflight
+// validation is skipped when they're not, since the check would otherwise be performed against incomplete data.
+func ValuesKnown(d *pluginsdk.ResourceDiff, keys ...string) bool {
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			log.Printf("[DEBUG] Skipping Preflight Validation since the value for %q isn't known during the plan", key)
+			return false
+		}
+	}
+
+	return true
+}
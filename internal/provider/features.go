@@ -4,8 +4,10 @@
 package provider
 
 import (
+	"fmt"
 	"os"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -427,6 +429,50 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"preflight_validation": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Description: "When enabled, supported resources are validated against the Azure Resource Manager APIs during plan, to surface errors such as unavailable SKUs, exceeded quotas or policy denials before any changes are applied.",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"check_policy_restrictions": {
+						Description: "When enabled, preflight validation additionally evaluates supported resources against the Azure Policies assigned to their scope using the Check Policy Restrictions API.",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+
+		"firewall_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"rule_analysis_enabled": {
+						Description: "When enabled, the rules within a Firewall Policy Rule Collection Group are analysed during plan to detect shadowed, duplicated and overly broad rules.",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"fail_on_rule_analysis_findings": {
+						Description: "When enabled, any findings from the Firewall Policy rule analysis are returned as errors during plan, rather than being logged as warnings. Defaults to `true`, and can only be set to `true` when `rule_analysis_enabled` is enabled.",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+				},
+			},
+		},
 	}
 
 	if !features.FivePointOh() {
@@ -722,5 +768,66 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["preflight_validation"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			preflightRaw := items[0].(map[string]interface{})
+			if v, ok := preflightRaw["enabled"]; ok {
+				featuresMap.PreflightValidation.Enabled = v.(bool)
+			}
+			if v, ok := preflightRaw["check_policy_restrictions"]; ok {
+				featuresMap.PreflightValidation.CheckPolicyRestrictions = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["firewall_policy"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			firewallPolicyRaw := items[0].(map[string]interface{})
+			if v, ok := firewallPolicyRaw["rule_analysis_enabled"]; ok {
+				featuresMap.FirewallPolicy.RuleAnalysisEnabled = v.(bool)
+			}
+			if v, ok := firewallPolicyRaw["fail_on_rule_analysis_findings"]; ok {
+				featuresMap.FirewallPolicy.FailOnRuleAnalysisFindings = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
+
+// validateFeatures validates the combination of values explicitly defined within the `features` block of the raw
+// provider configuration `config` - this can't be determined from the expanded features, which include the defaults
+func validateFeatures(config cty.Value) error {
+	firewallPolicy := firstNestedBlock(firstNestedBlock(config, "features"), "firewall_policy")
+	if firewallPolicy.IsNull() {
+		return nil
+	}
+
+	failOnFindings := firewallPolicy.GetAttr("fail_on_rule_analysis_findings")
+	ruleAnalysisEnabled := firewallPolicy.GetAttr("rule_analysis_enabled")
+	if isTrue(failOnFindings) && !isTrue(ruleAnalysisEnabled) {
+		return fmt.Errorf("`fail_on_rule_analysis_findings` can only be set to `true` within the `firewall_policy` feature when `rule_analysis_enabled` is also set to `true`")
+	}
+
+	return nil
+}
+
+// firstNestedBlock returns the first instance of the nested block `name` within `input`, or a null value when it's not defined
+func firstNestedBlock(input cty.Value, name string) cty.Value {
+	if input.IsNull() || !input.IsKnown() || !input.Type().IsObjectType() || !input.Type().HasAttribute(name) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	blocks := input.GetAttr(name)
+	if blocks.IsNull() || !blocks.IsKnown() || !(blocks.Type().IsListType() || blocks.Type().IsTupleType()) || blocks.LengthInt() == 0 {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	return blocks.Index(cty.NumberIntVal(0))
+}
+
+func isTrue(input cty.Value) bool {
+	return input.IsKnown() && !input.IsNull() && input.Type() == cty.Bool && input.True()
+}
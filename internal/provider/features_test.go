@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -98,6 +99,14 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: false,
 				},
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled:                 false,
+					CheckPolicyRestrictions: false,
+				},
+				FirewallPolicy: features.FirewallPolicyFeatures{
+					RuleAnalysisEnabled:        false,
+					FailOnRuleAnalysisFindings: true,
+				},
 			},
 		},
 		{
@@ -219,6 +228,18 @@ func TestExpandFeatures(t *testing.T) {
 							"force_delete": true,
 						},
 					},
+					"preflight_validation": []interface{}{
+						map[string]interface{}{
+							"enabled":                   true,
+							"check_policy_restrictions": true,
+						},
+					},
+					"firewall_policy": []interface{}{
+						map[string]interface{}{
+							"rule_analysis_enabled":          true,
+							"fail_on_rule_analysis_findings": true,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -299,6 +320,14 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: true,
 				},
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled:                 true,
+					CheckPolicyRestrictions: true,
+				},
+				FirewallPolicy: features.FirewallPolicyFeatures{
+					RuleAnalysisEnabled:        true,
+					FailOnRuleAnalysisFindings: true,
+				},
 			},
 		},
 		{
@@ -420,6 +449,18 @@ func TestExpandFeatures(t *testing.T) {
 							"force_delete": false,
 						},
 					},
+					"preflight_validation": []interface{}{
+						map[string]interface{}{
+							"enabled":                   false,
+							"check_policy_restrictions": false,
+						},
+					},
+					"firewall_policy": []interface{}{
+						map[string]interface{}{
+							"rule_analysis_enabled":          false,
+							"fail_on_rule_analysis_findings": false,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -500,6 +541,14 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: false,
 				},
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled:                 false,
+					CheckPolicyRestrictions: false,
+				},
+				FirewallPolicy: features.FirewallPolicyFeatures{
+					RuleAnalysisEnabled:        false,
+					FailOnRuleAnalysisFindings: false,
+				},
 			},
 		},
 	}
@@ -1936,3 +1985,217 @@ func TestExpandFeaturesDatabricksWorkspace(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesPreflightValidation(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"preflight_validation": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled:                 false,
+					CheckPolicyRestrictions: false,
+				},
+			},
+		},
+		{
+			Name: "Preflight Validation Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"preflight_validation": []interface{}{
+						map[string]interface{}{
+							"enabled":                   true,
+							"check_policy_restrictions": true,
+						},
+					},
+					"firewall_policy": []interface{}{
+						map[string]interface{}{
+							"rule_analysis_enabled":          true,
+							"fail_on_rule_analysis_findings": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled:                 true,
+					CheckPolicyRestrictions: true,
+				},
+			},
+		},
+		{
+			Name: "Preflight Validation Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"preflight_validation": []interface{}{
+						map[string]interface{}{
+							"enabled":                   false,
+							"check_policy_restrictions": false,
+						},
+					},
+					"firewall_policy": []interface{}{
+						map[string]interface{}{
+							"rule_analysis_enabled":          false,
+							"fail_on_rule_analysis_findings": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled:                 false,
+					CheckPolicyRestrictions: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.PreflightValidation, testCase.Expected.PreflightValidation) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected.PreflightValidation, result.PreflightValidation)
+		}
+	}
+}
+
+func TestExpandFeaturesFirewallPolicy(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"firewall_policy": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				FirewallPolicy: features.FirewallPolicyFeatures{
+					RuleAnalysisEnabled:        false,
+					FailOnRuleAnalysisFindings: true,
+				},
+			},
+		},
+		{
+			Name: "Rule Analysis Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"firewall_policy": []interface{}{
+						map[string]interface{}{
+							"rule_analysis_enabled":          true,
+							"fail_on_rule_analysis_findings": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				FirewallPolicy: features.FirewallPolicyFeatures{
+					RuleAnalysisEnabled:        true,
+					FailOnRuleAnalysisFindings: false,
+				},
+			},
+		},
+		{
+			Name: "Rule Analysis Enabled and Fail On Findings",
+			Input: []interface{}{
+				map[string]interface{}{
+					"firewall_policy": []interface{}{
+						map[string]interface{}{
+							"rule_analysis_enabled":          true,
+							"fail_on_rule_analysis_findings": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				FirewallPolicy: features.FirewallPolicyFeatures{
+					RuleAnalysisEnabled:        true,
+					FailOnRuleAnalysisFindings: true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.FirewallPolicy, testCase.Expected.FirewallPolicy) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected.FirewallPolicy, result.FirewallPolicy)
+		}
+	}
+}
+
+func TestValidateFeaturesFirewallPolicy(t *testing.T) {
+	firewallPolicy := func(ruleAnalysisEnabled, failOnFindings cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"features": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"firewall_policy": cty.ListVal([]cty.Value{
+						cty.ObjectVal(map[string]cty.Value{
+							"rule_analysis_enabled":          ruleAnalysisEnabled,
+							"fail_on_rule_analysis_findings": failOnFindings,
+						}),
+					}),
+				}),
+			}),
+		})
+	}
+
+	testData := []struct {
+		Name          string
+		Input         cty.Value
+		ExpectedError bool
+	}{
+		{
+			Name:  "No Features Block",
+			Input: cty.NullVal(cty.DynamicPseudoType),
+		},
+		{
+			Name:  "Defaults",
+			Input: firewallPolicy(cty.NullVal(cty.Bool), cty.NullVal(cty.Bool)),
+		},
+		{
+			Name:  "Rule Analysis Enabled",
+			Input: firewallPolicy(cty.True, cty.NullVal(cty.Bool)),
+		},
+		{
+			Name:  "Rule Analysis Enabled and Fail On Findings",
+			Input: firewallPolicy(cty.True, cty.True),
+		},
+		{
+			Name:  "Rule Analysis Disabled and Not Failing On Findings",
+			Input: firewallPolicy(cty.False, cty.False),
+		},
+		{
+			Name:          "Fail On Findings without Rule Analysis",
+			Input:         firewallPolicy(cty.NullVal(cty.Bool), cty.True),
+			ExpectedError: true,
+		},
+		{
+			Name:          "Fail On Findings with Rule Analysis Disabled",
+			Input:         firewallPolicy(cty.False, cty.True),
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		err := validateFeatures(testCase.Input)
+		if testCase.ExpectedError != (err != nil) {
+			t.Fatalf("Expected an error to be %t but got %+v", testCase.ExpectedError, err)
+		}
+	}
+}
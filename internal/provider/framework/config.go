@@ -16,6 +16,7 @@ import (
 	providerfeatures "github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type ProviderConfig struct {
@@ -518,6 +519,55 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 		} else {
 			f.DatabricksWorkspace.ForceDelete = false
 		}
+
+		if !features.PreflightValidation.IsNull() && !features.PreflightValidation.IsUnknown() {
+			var feature []PreflightValidation
+			d := features.PreflightValidation.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.PreflightValidation.Enabled = false
+			if !feature[0].Enabled.IsNull() && !feature[0].Enabled.IsUnknown() {
+				f.PreflightValidation.Enabled = feature[0].Enabled.ValueBool()
+			}
+
+			f.PreflightValidation.CheckPolicyRestrictions = false
+			if !feature[0].CheckPolicyRestrictions.IsNull() && !feature[0].CheckPolicyRestrictions.IsUnknown() {
+				f.PreflightValidation.CheckPolicyRestrictions = feature[0].CheckPolicyRestrictions.ValueBool()
+			}
+		} else {
+			f.PreflightValidation.Enabled = false
+			f.PreflightValidation.CheckPolicyRestrictions = false
+		}
+
+		if !features.FirewallPolicy.IsNull() && !features.FirewallPolicy.IsUnknown() {
+			var feature []FirewallPolicy
+			d := features.FirewallPolicy.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.FirewallPolicy.RuleAnalysisEnabled = false
+			if !feature[0].RuleAnalysisEnabled.IsNull() && !feature[0].RuleAnalysisEnabled.IsUnknown() {
+				f.FirewallPolicy.RuleAnalysisEnabled = feature[0].RuleAnalysisEnabled.ValueBool()
+			}
+
+			f.FirewallPolicy.FailOnRuleAnalysisFindings = true
+			if !feature[0].FailOnRuleAnalysisFindings.IsNull() && !feature[0].FailOnRuleAnalysisFindings.IsUnknown() {
+				f.FirewallPolicy.FailOnRuleAnalysisFindings = feature[0].FailOnRuleAnalysisFindings.ValueBool()
+
+				if f.FirewallPolicy.FailOnRuleAnalysisFindings && !f.FirewallPolicy.RuleAnalysisEnabled {
+					diags.AddError("invalid `firewall_policy` feature", "`fail_on_rule_analysis_findings` can only be set to `true` when `rule_analysis_enabled` is also set to `true`")
+					return
+				}
+			}
+		} else {
+			f.FirewallPolicy.RuleAnalysisEnabled = false
+			f.FirewallPolicy.FailOnRuleAnalysisFindings = true
+		}
 	}
 
 	p.clientBuilder.Features = f
@@ -537,6 +587,19 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 
 	client.StopContext = ctx
 
+	ignoreTagKeys := make([]string, 0)
+	if !data.IgnoreTagKeys.IsNull() {
+		data.IgnoreTagKeys.ElementsAs(ctx, &ignoreTagKeys, false)
+	}
+	ignoreTagPrefixes := make([]string, 0)
+	if !data.IgnoreTagPrefixes.IsNull() {
+		data.IgnoreTagPrefixes.ElementsAs(ctx, &ignoreTagPrefixes, false)
+	}
+	client.TagIgnoreRules = tags.IgnoreRules{
+		Keys:     ignoreTagKeys,
+		Prefixes: ignoreTagPrefixes,
+	}
+
 	resourceProviderRegistrationSet := getEnvStringOrDefault(data.ResourceProviderRegistrations, "ARM_RESOURCE_PROVIDER_REGISTRATIONS", resourceproviders.ProviderRegistrationsCore)
 	if !providerfeatures.FivePointOh() {
 		resourceProviderRegistrationSet = getEnvStringOrDefault(data.ResourceProviderRegistrations, "ARM_RESOURCE_PROVIDER_REGISTRATIONS", resourceproviders.ProviderRegistrationsLegacy)
@@ -214,6 +214,22 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 	if features.DatabricksWorkspace.ForceDelete {
 		t.Errorf("expected databricks_workspace.ForceDelete to be false")
 	}
+
+	if features.PreflightValidation.Enabled {
+		t.Errorf("expected preflight_validation.Enabled to be false")
+	}
+
+	if features.PreflightValidation.CheckPolicyRestrictions {
+		t.Errorf("expected preflight_validation.CheckPolicyRestrictions to be false")
+	}
+
+	if features.FirewallPolicy.RuleAnalysisEnabled {
+		t.Errorf("expected firewall_policy.RuleAnalysisEnabled to be false")
+	}
+
+	if !features.FirewallPolicy.FailOnRuleAnalysisFindings {
+		t.Errorf("expected firewall_policy.FailOnRuleAnalysisFindings to be true")
+	}
 }
 
 // TODO - helper functions to make setting up test date more easily so we can add more configuration coverage
@@ -333,6 +349,18 @@ func defaultFeaturesList() types.List {
 	})
 	databricksWorkspaceList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(DatabricksWorkspaceAttributes), []attr.Value{databricksWorkspace})
 
+	preflightValidation, _ := basetypes.NewObjectValueFrom(context.Background(), PreflightValidationAttributes, map[string]attr.Value{
+		"enabled":                   basetypes.NewBoolNull(),
+		"check_policy_restrictions": basetypes.NewBoolNull(),
+	})
+	preflightValidationList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(PreflightValidationAttributes), []attr.Value{preflightValidation})
+
+	firewallPolicy, _ := basetypes.NewObjectValueFrom(context.Background(), FirewallPolicyAttributes, map[string]attr.Value{
+		"rule_analysis_enabled":          basetypes.NewBoolNull(),
+		"fail_on_rule_analysis_findings": basetypes.NewBoolNull(),
+	})
+	firewallPolicyList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(FirewallPolicyAttributes), []attr.Value{firewallPolicy})
+
 	fData, d := basetypes.NewObjectValue(FeaturesAttributes, map[string]attr.Value{
 		"api_management":             apiManagementList,
 		"app_configuration":          appConfigurationList,
@@ -353,6 +381,8 @@ func defaultFeaturesList() types.List {
 		"recovery_services_vaults":   recoveryServicesVaultsList,
 		"netapp":                     netappList,
 		"databricks_workspace":       databricksWorkspaceList,
+		"preflight_validation":       preflightValidationList,
+		"firewall_policy":            firewallPolicyList,
 	})
 
 	fmt.Printf("%+v", d)
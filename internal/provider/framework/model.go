@@ -35,6 +35,8 @@ type ProviderModel struct {
 	DisableCorrelationRequestId    types.Bool   `tfsdk:"disable_correlation_request_id"`
 	DisableTerraformPartnerId      types.Bool   `tfsdk:"disable_terraform_partner_id"`
 	StorageUseAzureAD              types.Bool   `tfsdk:"storage_use_azuread"`
+	IgnoreTagKeys                  types.List   `tfsdk:"ignore_tag_keys"`
+	IgnoreTagPrefixes              types.List   `tfsdk:"ignore_tag_prefixes"`
 	Features                       types.List   `tfsdk:"features"`
 	SkipProviderRegistration       types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
 	ResourceProviderRegistrations  types.String `tfsdk:"resource_provider_registrations"`
@@ -61,6 +63,8 @@ type Features struct {
 	RecoveryServicesVaults   types.List `tfsdk:"recovery_services_vaults"`
 	NetApp                   types.List `tfsdk:"netapp"`
 	DatabricksWorkspace      types.List `tfsdk:"databricks_workspace"`
+	PreflightValidation      types.List `tfsdk:"preflight_validation"`
+	FirewallPolicy           types.List `tfsdk:"firewall_policy"`
 }
 
 // FeaturesAttributes and the other block attribute vars are required for unit testing on the Load func
@@ -85,6 +89,8 @@ var FeaturesAttributes = map[string]attr.Type{
 	"recovery_services_vaults":   types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes)),
 	"netapp":                     types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(NetAppAttributes)),
 	"databricks_workspace":       types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(DatabricksWorkspaceAttributes)),
+	"preflight_validation":       types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(PreflightValidationAttributes)),
+	"firewall_policy":            types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(FirewallPolicyAttributes)),
 }
 
 type APIManagement struct {
@@ -280,3 +286,23 @@ type DatabricksWorkspace struct {
 var DatabricksWorkspaceAttributes = map[string]attr.Type{
 	"force_delete": types.BoolType,
 }
+
+type PreflightValidation struct {
+	Enabled                 types.Bool `tfsdk:"enabled"`
+	CheckPolicyRestrictions types.Bool `tfsdk:"check_policy_restrictions"`
+}
+
+var PreflightValidationAttributes = map[string]attr.Type{
+	"enabled":                   types.BoolType,
+	"check_policy_restrictions": types.BoolType,
+}
+
+type FirewallPolicy struct {
+	RuleAnalysisEnabled        types.Bool `tfsdk:"rule_analysis_enabled"`
+	FailOnRuleAnalysisFindings types.Bool `tfsdk:"fail_on_rule_analysis_findings"`
+}
+
+var FirewallPolicyAttributes = map[string]attr.Type{
+	"rule_analysis_enabled":          types.BoolType,
+	"fail_on_rule_analysis_findings": types.BoolType,
+}
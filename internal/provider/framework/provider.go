@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -35,10 +36,14 @@ var _ provider.ProviderWithFunctions = &azureRmFrameworkProvider{}
 
 var _ provider.ProviderWithEphemeralResources = &azureRmFrameworkProvider{}
 
+var _ provider.ProviderWithActions = &azureRmFrameworkProvider{}
+
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		providerfunction.NewNormaliseResourceIDFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewCidrAllocateFunction,
+		providerfunction.NewPrivateEndpointDnsZoneNamesFunction,
 	}
 }
 
@@ -207,6 +212,18 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
 
+			"ignore_tag_keys": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "A list of Tag keys which should be ignored when they're added to a resource outside of Terraform. Tag keys are compared case-insensitively.",
+			},
+
+			"ignore_tag_prefixes": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "A list of Tag key prefixes which should be ignored when they're added to a resource outside of Terraform. Tag keys are compared case-insensitively.",
+			},
+
 			"resource_provider_registrations": schema.StringAttribute{
 				Optional:    true,
 				Description: "The set of Resource Providers which should be automatically registered for the subscription.",
@@ -499,6 +516,34 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 								},
 							},
 						},
+						"preflight_validation": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Optional:    true,
+										Description: "When enabled, supported resources are validated against the Azure Resource Manager APIs during plan, to surface errors such as unavailable SKUs, exceeded quotas or policy denials before any changes are applied.",
+									},
+									"check_policy_restrictions": schema.BoolAttribute{
+										Optional:    true,
+										Description: "When enabled, preflight validation additionally evaluates supported resources against the Azure Policies assigned to their scope using the Check Policy Restrictions API.",
+									},
+								},
+							},
+						},
+						"firewall_policy": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"rule_analysis_enabled": schema.BoolAttribute{
+										Optional:    true,
+										Description: "When enabled, the rules within a Firewall Policy Rule Collection Group are analysed during plan to detect shadowed, duplicated and overly broad rules.",
+									},
+									"fail_on_rule_analysis_findings": schema.BoolAttribute{
+										Optional:    true,
+										Description: "When enabled, any findings from the Firewall Policy rule analysis are returned as errors during plan, rather than being logged as warnings. Defaults to `true`, and can only be set to `true` when `rule_analysis_enabled` is enabled.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -527,6 +572,7 @@ func (p *azureRmFrameworkProvider) Configure(ctx context.Context, request provid
 		response.ResourceData = v
 		response.DataSourceData = v
 		response.EphemeralResourceData = v
+		response.ActionData = v
 	} else {
 		p.Load(ctx, &data, request.TerraformVersion, &response.Diagnostics)
 
@@ -564,3 +610,13 @@ func (p *azureRmFrameworkProvider) EphemeralResources(_ context.Context) []func(
 
 	return output
 }
+
+func (p *azureRmFrameworkProvider) Actions(_ context.Context) []func() action.Action {
+	var output []func() action.Action
+
+	for _, service := range pluginsdkprovider.SupportedFrameworkServices() {
+		output = append(output, service.Actions()...)
+	}
+
+	return output
+}
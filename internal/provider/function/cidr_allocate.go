@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/cidr"
+)
+
+type CidrAllocateFunction struct{}
+
+var _ function.Function = CidrAllocateFunction{}
+
+func NewCidrAllocateFunction() function.Function {
+	return &CidrAllocateFunction{}
+}
+
+func (c CidrAllocateFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "cidr_allocate"
+}
+
+func (c CidrAllocateFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "cidr_allocate",
+		Description:         "Returns the first available prefix of each of the requested prefix lengths within the address spaces which doesn't overlap the used prefixes",
+		MarkdownDescription: "Returns the first available prefix of each of the requested prefix lengths within the address spaces which doesn't overlap the used prefixes",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "address_spaces",
+				ElementType:         types.StringType,
+				Description:         "The CIDRs to allocate prefixes from",
+				MarkdownDescription: "The CIDRs to allocate prefixes from",
+			},
+			function.ListParameter{
+				Name:                "used_prefixes",
+				ElementType:         types.StringType,
+				Description:         "The CIDRs which are already in use within the address spaces",
+				MarkdownDescription: "The CIDRs which are already in use within the address spaces",
+			},
+			function.ListParameter{
+				Name:                "prefix_lengths",
+				ElementType:         types.Int64Type,
+				Description:         "The prefix lengths to allocate, in order",
+				MarkdownDescription: "The prefix lengths to allocate, in order",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (c CidrAllocateFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var addressSpaces, usedPrefixes []string
+	var prefixLengths []int64
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &addressSpaces, &usedPrefixes, &prefixLengths))
+
+	if response.Error != nil {
+		return
+	}
+
+	spaces, err := cidr.ParseAll(addressSpaces)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("parsing `address_spaces`: %+v", err))
+		return
+	}
+
+	used, err := cidr.ParseAll(usedPrefixes)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(1, fmt.Sprintf("parsing `used_prefixes`: %+v", err))
+		return
+	}
+
+	lengths := make([]int, 0, len(prefixLengths))
+	for _, v := range prefixLengths {
+		if v < 0 || v > 128 {
+			response.Error = function.NewArgumentFuncError(2, fmt.Sprintf("`prefix_lengths` must be between 0 and 128, got %d", v))
+			return
+		}
+		lengths = append(lengths, int(v))
+	}
+
+	prefixes, err := cidr.Allocate(spaces, used, lengths)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result := make([]string, 0, len(prefixes))
+	for _, v := range prefixes {
+		result = append(result, v.String())
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
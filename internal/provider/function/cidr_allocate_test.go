@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionCidrAllocate_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testCidrAllocateOutput(`["10.0.0.0/16"]`, `["10.0.0.0/24", "10.0.1.0/28"]`, `[24, 28, 26]`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("first", "10.0.2.0/24"),
+					acceptance.TestCheckOutput("second", "10.0.1.16/28"),
+					acceptance.TestCheckOutput("third", "10.0.1.64/26"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionCidrAllocate_exhausted(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testCidrAllocateOutput(`["10.0.0.0/24"]`, `["10.0.0.0/25"]`, `[25, 25, 25]`),
+				ExpectError: regexp.MustCompile("no /25 prefix is available"),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionCidrAllocate_hostBitsSet(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testCidrAllocateOutput(`["10.0.0.0/16"]`, `["10.0.1.5/24"]`, `[24, 24, 24]`),
+				ExpectError: regexp.MustCompile("has host bits set"),
+			},
+		},
+	})
+}
+
+func testCidrAllocateOutput(addressSpaces, usedPrefixes, prefixLengths string) string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  prefixes = provider::azurerm::cidr_allocate(` + addressSpaces + `, ` + usedPrefixes + `, ` + prefixLengths + `)
+}
+
+output "first" {
+  value = local.prefixes[0]
+}
+
+output "second" {
+  value = local.prefixes[1]
+}
+
+output "third" {
+  value = local.prefixes[2]
+}
+`
+}
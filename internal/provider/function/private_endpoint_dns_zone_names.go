@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/privatelink"
+)
+
+type PrivateEndpointDnsZoneNamesFunction struct{}
+
+var _ function.Function = PrivateEndpointDnsZoneNamesFunction{}
+
+func NewPrivateEndpointDnsZoneNamesFunction() function.Function {
+	return &PrivateEndpointDnsZoneNamesFunction{}
+}
+
+func (p PrivateEndpointDnsZoneNamesFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "private_endpoint_dns_zone_names"
+}
+
+func (p PrivateEndpointDnsZoneNamesFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "private_endpoint_dns_zone_names",
+		Description:         "Returns the names of the Private DNS Zones used by a Private Endpoint connected to the subresources of a resource type, within the specified environment",
+		MarkdownDescription: "Returns the names of the Private DNS Zones used by a Private Endpoint connected to the subresources of a resource type, within the specified environment",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "The resource type the Private Endpoint is connected to, e.g. `Microsoft.Storage/storageAccounts`",
+				MarkdownDescription: "The resource type the Private Endpoint is connected to, e.g. `Microsoft.Storage/storageAccounts`",
+			},
+			function.ListParameter{
+				Name:                "subresource_names",
+				ElementType:         types.StringType,
+				Description:         "The subresources the Private Endpoint is connected to, as specified in `subresource_names`",
+				MarkdownDescription: "The subresources the Private Endpoint is connected to, as specified in `subresource_names`",
+			},
+			function.StringParameter{
+				Name:                "environment",
+				Description:         "The Cloud Environment, possible values are `public`, `usgovernment` and `china`",
+				MarkdownDescription: "The Cloud Environment, possible values are `public`, `usgovernment` and `china`",
+			},
+			function.StringParameter{
+				Name:                "location",
+				AllowNullValue:      true,
+				Description:         "The location of the resource, which is required for services using regional Private DNS Zones",
+				MarkdownDescription: "The location of the resource, which is required for services using regional Private DNS Zones",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (p PrivateEndpointDnsZoneNamesFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType, environment string
+	var subresourceNames []string
+	var location types.String
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &subresourceNames, &environment, &location))
+
+	if response.Error != nil {
+		return
+	}
+
+	cloud, err := privatelink.CloudForEnvironment(environment)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	result, err := privatelink.DnsZoneNames(cloud, resourceType, subresourceNames, location.ValueString())
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionPrivateEndpointDnsZoneNames_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testPrivateEndpointDnsZoneNamesOutput("Microsoft.Storage/storageAccounts", `["blob", "dfs"]`, "usgovernment", "null"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("first", "privatelink.blob.core.usgovcloudapi.net"),
+					acceptance.TestCheckOutput("second", "privatelink.dfs.core.usgovcloudapi.net"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionPrivateEndpointDnsZoneNames_regional(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testPrivateEndpointDnsZoneNamesOutput("Microsoft.ContainerRegistry/registries", `["registry"]`, "public", `"West Europe"`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("first", "privatelink.azurecr.io"),
+					acceptance.TestCheckOutput("second", "westeurope.data.privatelink.azurecr.io"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionPrivateEndpointDnsZoneNames_unknownSubresource(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testPrivateEndpointDnsZoneNamesOutput("Microsoft.Storage/storageAccounts", `["bucket", "blob"]`, "public", "null"),
+				ExpectError: regexp.MustCompile("the Private DNS Zones for the subresource \"bucket\""),
+			},
+		},
+	})
+}
+
+func testPrivateEndpointDnsZoneNamesOutput(resourceType, subresourceNames, environment, location string) string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  zones = provider::azurerm::private_endpoint_dns_zone_names("` + resourceType + `", ` + subresourceNames + `, "` + environment + `", ` + location + `)
+}
+
+output "first" {
+  value = local.zones[0]
+}
+
+output "second" {
+  value = local.zones[1]
+}
+`
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+// withPolicyViolationDetails wraps the Create and Update functions of the specified Resource so that, when a request
+// is denied by Azure Policy, the error describes the Policy Assignment(s) and Definition(s) responsible for the denial
+// rather than only containing the raw error returned from the API.
+func withPolicyViolationDetails(resource *schema.Resource) {
+	if create := resource.Create; create != nil { //nolint:staticcheck
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return azure.WithPolicyViolationDetails(create(d, meta))
+		}
+	}
+	if update := resource.Update; update != nil { //nolint:staticcheck
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			return azure.WithPolicyViolationDetails(update(d, meta))
+		}
+	}
+
+	// Typed Resources are exposed using the Context variants
+	if create := resource.CreateContext; create != nil {
+		resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return policyViolationDiagnostics(create(ctx, d, meta))
+		}
+	}
+	if update := resource.UpdateContext; update != nil {
+		resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return policyViolationDiagnostics(update(ctx, d, meta))
+		}
+	}
+}
+
+func policyViolationDiagnostics(input diag.Diagnostics) diag.Diagnostics {
+	for i, d := range input {
+		if d.Severity != diag.Error || d.Detail == "" {
+			continue
+		}
+
+		if err := azure.WithPolicyViolationDetails(errors.New(d.Detail)); err.Error() != d.Detail {
+			input[i].Detail = err.Error()
+		}
+	}
+
+	return input
+}
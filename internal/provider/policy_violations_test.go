@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+const policyViolationError = `unexpected status 403 (403 Forbidden) with error: RequestDisallowedByPolicy: Resource 'example' was disallowed by policy. Policy identifiers: '[{"policyAssignment":{"name":"Allowed locations","id":"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/locations"},"policyDefinition":{"name":"Allowed locations","id":"/providers/Microsoft.Authorization/policyDefinitions/e56962a6"}}]'.`
+
+func TestWithPolicyViolationDetails(t *testing.T) {
+	resource := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return errors.New(policyViolationError)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  policyViolationError,
+					Detail:   policyViolationError,
+				},
+			}
+		},
+	}
+	withPolicyViolationDetails(resource)
+
+	err := resource.Create(nil, nil) //nolint:staticcheck
+	var violations *azure.PolicyViolationsError
+	if !errors.As(err, &violations) {
+		t.Fatalf("expected the Create error to be a PolicyViolationsError but got %T", err)
+	}
+
+	diags := resource.UpdateContext(context.Background(), nil, nil)
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic but got %d", len(diags))
+	}
+	if diags[0].Summary != policyViolationError {
+		t.Fatalf("expected the Summary to be unchanged but got %q", diags[0].Summary)
+	}
+	if !strings.Contains(diags[0].Detail, "The request was denied by Azure Policy") {
+		t.Fatalf("expected the Detail to describe the Policy Violation but got %q", diags[0].Detail)
+	}
+}
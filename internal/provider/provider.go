@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -138,7 +139,16 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
-	p := &schema.Provider{
+	// the Tag ignore rules are configured per provider block, as such these are retrieved from this provider's meta
+	var p *schema.Provider
+	for _, resource := range resources {
+		withPolicyViolationDetails(resource)
+		withTagIgnoreRules(resource, func() interface{} {
+			return p.Meta()
+		})
+	}
+
+	p = &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
 				Type:        schema.TypeString,
@@ -368,6 +378,26 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
+
+			"ignore_tag_keys": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A list of Tag keys which should be ignored when they're added to a resource outside of Terraform. Tag keys are compared case-insensitively.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"ignore_tag_prefixes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A list of Tag key prefixes which should be ignored when they're added to a resource outside of Terraform. Tag keys are compared case-insensitively.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
 		},
 
 		DataSourcesMap: dataSources,
@@ -512,6 +542,10 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 	}
 	requiredResourceProviders.Merge(additionalProvidersToRegister)
 
+	if err := validateFeatures(d.GetRawConfig()); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
@@ -542,6 +576,11 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 
 	client.StopContext = stopCtx
 
+	client.TagIgnoreRules = tags.IgnoreRules{
+		Keys:     *utils.ExpandStringSlice(d.Get("ignore_tag_keys").([]interface{})),
+		Prefixes: *utils.ExpandStringSlice(d.Get("ignore_tag_prefixes").([]interface{})),
+	}
+
 	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
 
 	ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
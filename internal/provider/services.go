@@ -344,7 +344,14 @@ func SupportedFrameworkServices() []sdk.FrameworkTypedServiceRegistration {
 		// Services with Framework Resources, Data Sources, or Ephemeral Resources to be listed here
 		// e.g.
 		// resource.Registration{}
+		appservice.Registration{},
+		cdn.Registration{},
+		compute.Registration{},
+		containerapps.Registration{},
+		containers.Registration{},
+		datafactory.Registration{},
 		keyvault.Registration{},
+		storage.Registration{},
 	}
 
 	return services
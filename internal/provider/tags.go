@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	tagsSdk "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// withTagIgnoreRules applies the `ignore_tag_keys`/`ignore_tag_prefixes` provider properties to the top-level `tags`
+// field of the specified Resource (where present), so that this is handled consistently for every taggable resource:
+//
+// * differences caused by ignored Tags, or by a change in the casing of a Tag key, are suppressed
+// * ignored Tags are only kept in the state when they're defined in the configuration or state
+// * ignored Tags added outside of Terraform are retained when the Tags are updated
+//
+// Since the rules are configured per provider block, `meta` returns the meta for the provider block being used.
+func withTagIgnoreRules(resource *schema.Resource, meta func() interface{}) {
+	s, ok := resource.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap || !s.Optional || s.DiffSuppressFunc != nil {
+		return
+	}
+
+	s.DiffSuppressFunc = tags.DiffSuppressWithIgnoreRules(func() tags.IgnoreRules {
+		return tagIgnoreRules(meta())
+	})
+
+	if create := resource.Create; create != nil { //nolint:staticcheck
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			tracked := trackedTags(d)
+			if err := create(d, meta); err != nil {
+				return err
+			}
+			return removeIgnoredTags(d, meta, tracked)
+		}
+	}
+	if read := resource.Read; read != nil { //nolint:staticcheck
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			tracked := trackedTags(d)
+			if err := read(d, meta); err != nil {
+				return err
+			}
+			return removeIgnoredTags(d, meta, tracked)
+		}
+	}
+	if update := resource.Update; update != nil { //nolint:staticcheck
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+			tracked := trackedTags(d)
+			retained := ignoredTagsToRetain(d, meta)
+			if err := update(d, meta); err != nil {
+				return err
+			}
+			if err := restoreIgnoredTags(d, meta, retained); err != nil {
+				return err
+			}
+			return removeIgnoredTags(d, meta, tracked)
+		}
+	}
+
+	// Typed Resources are exposed using the Context variants
+	if create := resource.CreateContext; create != nil {
+		resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			tracked := trackedTags(d)
+			if diags := create(ctx, d, meta); diags.HasError() {
+				return diags
+			}
+			return diag.FromErr(removeIgnoredTags(d, meta, tracked))
+		}
+	}
+	if read := resource.ReadContext; read != nil {
+		resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			tracked := trackedTags(d)
+			if diags := read(ctx, d, meta); diags.HasError() {
+				return diags
+			}
+			return diag.FromErr(removeIgnoredTags(d, meta, tracked))
+		}
+	}
+	if update := resource.UpdateContext; update != nil {
+		resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			tracked := trackedTags(d)
+			retained := ignoredTagsToRetain(d, meta)
+			if diags := update(ctx, d, meta); diags.HasError() {
+				return diags
+			}
+			if err := restoreIgnoredTags(d, meta, retained); err != nil {
+				return diag.FromErr(err)
+			}
+			return diag.FromErr(removeIgnoredTags(d, meta, tracked))
+		}
+	}
+}
+
+func tagIgnoreRules(meta interface{}) tags.IgnoreRules {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.TagIgnoreRules
+	}
+
+	return tags.IgnoreRules{}
+}
+
+// trackedTags returns the Tags within the configuration (when creating or updating) or state (when refreshing)
+func trackedTags(d *schema.ResourceData) map[string]interface{} {
+	tracked, _ := d.Get("tags").(map[string]interface{})
+	return tracked
+}
+
+// removeIgnoredTags removes any ignored Tags which aren't within `tracked` from the state
+func removeIgnoredTags(d *schema.ResourceData, meta interface{}, tracked map[string]interface{}) error {
+	rules := tagIgnoreRules(meta)
+	if rules.IsEmpty() || d.Id() == "" {
+		return nil
+	}
+
+	existing, _ := d.Get("tags").(map[string]interface{})
+	filtered := tags.RemoveIgnored(existing, tracked, rules)
+	if len(filtered) == len(existing) {
+		return nil
+	}
+
+	if err := d.Set("tags", filtered); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+// ignoredTagsToRetain retrieves the ignored Tags which have been added to the resource outside of Terraform prior to
+// the Tags being updated, since updating the resource can replace all of the Tags
+func ignoredTagsToRetain(d *schema.ResourceData, meta interface{}) map[string]string {
+	rules := tagIgnoreRules(meta)
+	if rules.IsEmpty() || !d.HasChange("tags") || !strings.HasPrefix(d.Id(), "/subscriptions/") {
+		return nil
+	}
+
+	client := meta.(*clients.Client)
+	ctx, cancel := timeouts.ForUpdate(client.StopContext, d)
+	defer cancel()
+
+	scopeId := commonids.NewScopeID(d.Id())
+	resp, err := client.Resource.TagsClient.GetAtScope(ctx, scopeId)
+	if err != nil {
+		// not all resources support the Tags API, in which case the Tags are updated as defined
+		log.Printf("[WARN] retrieving the Tags for %s, ignored Tags won't be retained: %+v", scopeId, err)
+		return nil
+	}
+
+	existing := make(map[string]string)
+	if model := resp.Model; model != nil {
+		existing = pointer.From(model.Properties.Tags)
+	}
+
+	return tags.RetainIgnored(d.Get("tags").(map[string]interface{}), existing, rules)
+}
+
+// restoreIgnoredTags merges the ignored Tags `retained` back into the Tags of the resource once it's been updated
+func restoreIgnoredTags(d *schema.ResourceData, meta interface{}, retained map[string]string) error {
+	if len(retained) == 0 {
+		return nil
+	}
+
+	client := meta.(*clients.Client)
+	ctx, cancel := timeouts.ForUpdate(client.StopContext, d)
+	defer cancel()
+
+	scopeId := commonids.NewScopeID(d.Id())
+	payload := tagsSdk.TagsPatchResource{
+		Operation: pointer.To(tagsSdk.TagsPatchOperationMerge),
+		Properties: &tagsSdk.Tags{
+			Tags: pointer.To(retained),
+		},
+	}
+	if err := client.Resource.TagsClient.UpdateAtScopeThenPoll(ctx, scopeId, payload); err != nil {
+		return fmt.Errorf("retaining the ignored Tags for %s: %+v", scopeId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+func TestWithTagIgnoreRules(t *testing.T) {
+	remote := map[string]interface{}{
+		"environment":                  "prod",
+		"createdby":                    "someone",
+		"hidden-link:/subscriptions/0": "Resource",
+	}
+
+	testData := []struct {
+		name     string
+		rules    tags.IgnoreRules
+		tracked  map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "no ignore rules",
+			tracked:  map[string]interface{}{"environment": "prod"},
+			expected: remote,
+		},
+		{
+			name: "ignored tags added outside of terraform",
+			rules: tags.IgnoreRules{
+				Keys:     []string{"CreatedBy"},
+				Prefixes: []string{"hidden-link:"},
+			},
+			tracked: map[string]interface{}{"environment": "prod"},
+			expected: map[string]interface{}{
+				"environment": "prod",
+			},
+		},
+		{
+			name: "ignored tag defined in the configuration",
+			rules: tags.IgnoreRules{
+				Keys:     []string{"CreatedBy"},
+				Prefixes: []string{"hidden-link:"},
+			},
+			tracked: map[string]interface{}{"environment": "prod", "CreatedBy": "someone"},
+			expected: map[string]interface{}{
+				"environment": "prod",
+				"createdby":   "someone",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		meta := &clients.Client{
+			TagIgnoreRules: v.rules,
+		}
+		resource := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": tags.Schema(),
+			},
+			Read: func(d *schema.ResourceData, meta interface{}) error { //nolint:staticcheck
+				return d.Set("tags", remote)
+			},
+		}
+		withTagIgnoreRules(resource, func() interface{} {
+			return meta
+		})
+
+		if resource.Schema["tags"].DiffSuppressFunc == nil {
+			t.Fatalf("expected a DiffSuppressFunc to be configured for `tags`")
+		}
+
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+			"tags": v.tracked,
+		})
+		d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")
+
+		if err := resource.Read(d, meta); err != nil { //nolint:staticcheck
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		actual := d.Get("tags").(map[string]interface{})
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
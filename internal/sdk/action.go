@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+// Action wraps the Framework implementation in an opinionated presentation for the AzureRM provider, where we
+// always want a Configure to be present to be able to collect the appropriate metadata from the Provider via the Defaults()
+// helper, and optionally override / add settings as needed.
+type Action interface {
+	action.ActionWithConfigure
+}
+
+type ActionWithConfigurationValidation interface {
+	Action
+
+	action.ActionWithConfigValidators
+}
+
+type ActionMetadata struct {
+	Client *clients.Client
+
+	SubscriptionId string
+
+	Features features.UserFeatures
+}
+
+// Defaults configures the Action Metadata for client access, Provider Features, and subscriptionId.
+func (a *ActionMetadata) Defaults(req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Client Provider Data Error", fmt.Sprintf("invalid provider data supplied, got %+v", req.ProviderData))
+		return
+	}
+
+	a.Client = c
+	a.SubscriptionId = c.Account.SubscriptionId
+	a.Features = c.Features
+}
+
+// DecodeInvoke performs a Get on the InvokeRequest config and attempts to load it into the interface cfg. cfg *must* be a pointer to the struct.
+// returns true if successful, false if there is an error diagnostic raised. Any error diags are written directly to the response
+func (a *ActionMetadata) DecodeInvoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse, cfg interface{}) bool {
+	resp.Diagnostics.Append(req.Config.Get(ctx, cfg)...)
+
+	return !resp.Diagnostics.HasError()
+}
+
+// SendProgress reports the progress of a long-running Action back to Terraform, which is output to the user.
+func (a *ActionMetadata) SendProgress(resp *action.InvokeResponse, message string) {
+	if resp.SendProgress == nil {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: message,
+	})
+}
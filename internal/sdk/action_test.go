@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func TestActionMetadataDefaults(t *testing.T) {
+	testData := []struct {
+		name          string
+		providerData  interface{}
+		expectClient  bool
+		expectedError bool
+	}{
+		{
+			name:         "no provider data",
+			providerData: nil,
+		},
+		{
+			name:          "invalid provider data",
+			providerData:  "hello",
+			expectedError: true,
+		},
+		{
+			name: "valid provider data",
+			providerData: &clients.Client{
+				Account: &clients.ResourceManagerAccount{
+					SubscriptionId: "00000000-0000-0000-0000-000000000000",
+				},
+			},
+			expectClient: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		a := ActionMetadata{}
+		resp := action.ConfigureResponse{}
+		a.Defaults(action.ConfigureRequest{ProviderData: v.providerData}, &resp)
+
+		if resp.Diagnostics.HasError() != v.expectedError {
+			t.Fatalf("expected error to be %t but got diagnostics %+v", v.expectedError, resp.Diagnostics)
+		}
+		if (a.Client != nil) != v.expectClient {
+			t.Fatalf("expected client to be set to be %t but got %+v", v.expectClient, a.Client)
+		}
+		if v.expectClient && a.SubscriptionId != "00000000-0000-0000-0000-000000000000" {
+			t.Fatalf("expected subscription ID to be set from the provider data but got %q", a.SubscriptionId)
+		}
+	}
+}
+
+func TestActionMetadataDecodeInvoke(t *testing.T) {
+	type model struct {
+		Name types.String `tfsdk:"name"`
+	}
+
+	type invalidModel struct {
+		Name types.Bool `tfsdk:"name"`
+	}
+
+	ctx := context.TODO()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+	req := action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: s,
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello"),
+			}),
+		},
+	}
+
+	a := ActionMetadata{}
+
+	var data model
+	resp := action.InvokeResponse{}
+	if ok := a.DecodeInvoke(ctx, req, &resp, &data); !ok {
+		t.Fatalf("expected the config to decode but got diagnostics %+v", resp.Diagnostics)
+	}
+	if data.Name.ValueString() != "hello" {
+		t.Fatalf("expected `name` to be %q but got %q", "hello", data.Name.ValueString())
+	}
+
+	var invalid invalidModel
+	resp = action.InvokeResponse{}
+	if ok := a.DecodeInvoke(ctx, req, &resp, &invalid); ok {
+		t.Fatalf("expected decoding into a mismatched model to fail")
+	}
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error diagnostic to be written to the response")
+	}
+}
+
+func TestActionMetadataSendProgress(t *testing.T) {
+	a := ActionMetadata{}
+
+	// a nil SendProgress must not panic
+	a.SendProgress(&action.InvokeResponse{}, "hello")
+
+	messages := make([]string, 0)
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+	a.SendProgress(&resp, "hello")
+	a.SendProgress(&resp, "world")
+
+	if len(messages) != 2 || messages[0] != "hello" || messages[1] != "world" {
+		t.Fatalf("expected the progress messages to be sent in order but got %+v", messages)
+	}
+}
+
+func TestSetResponseErrorDiagnosticInvokeResponse(t *testing.T) {
+	resp := action.InvokeResponse{}
+	SetResponseErrorDiagnostic(&resp, "parsing `id`", "invalid ID")
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error diagnostic to be written to the InvokeResponse")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "parsing `id`" {
+		t.Fatalf("expected the summary to be %q but got %q", "parsing `id`", summary)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -146,6 +147,8 @@ func SetResponseErrorDiagnostic(resp interface{}, summary string, detail interfa
 		v.Diagnostics.AddError(summary, errorMsg)
 	case *ephemeral.CloseResponse:
 		v.Diagnostics.AddError(summary, errorMsg)
+	case *action.InvokeResponse:
+		v.Diagnostics.AddError(summary, errorMsg)
 	}
 }
 
@@ -4,6 +4,7 @@
 package sdk
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,4 +18,6 @@ type FrameworkTypedServiceRegistration interface {
 	FrameworkDataSources() []func() datasource.DataSource
 
 	EphemeralResources() []func() ephemeral.EphemeralResource
+
+	Actions() []func() action.Action
 }
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+var _ sdk.Action = &FunctionAppSyncTriggersAction{}
+
+func NewFunctionAppSyncTriggersAction() action.Action {
+	return &FunctionAppSyncTriggersAction{}
+}
+
+type FunctionAppSyncTriggersAction struct {
+	sdk.ActionMetadata
+}
+
+type FunctionAppSyncTriggersActionModel struct {
+	FunctionAppId types.String `tfsdk:"function_app_id"`
+}
+
+func (a *FunctionAppSyncTriggersAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "azurerm_function_app_sync_triggers"
+}
+
+func (a *FunctionAppSyncTriggersAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.Defaults(req, resp)
+}
+
+func (a *FunctionAppSyncTriggersAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Synchronises the triggers of a Function App, for example after the function code has been deployed outside of Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"function_app_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Linux or Windows Function App.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateFunctionAppID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *FunctionAppSyncTriggersAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	client := a.Client.AppService.WebAppsClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data FunctionAppSyncTriggersActionModel
+	if ok := a.DecodeInvoke(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseFunctionAppID(data.FunctionAppId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing `function_app_id`", err)
+		return
+	}
+
+	if _, err := client.SyncFunctionTriggers(ctx, *id); err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("syncing the triggers for %s", id), err)
+		return
+	}
+
+	a.SendProgress(resp, fmt.Sprintf("synced the triggers for %s", id))
+}
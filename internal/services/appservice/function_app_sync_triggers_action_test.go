@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/client"
+)
+
+type FunctionAppSyncTriggersAction struct{}
+
+func TestAccFunctionAppSyncTriggersAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_sync_triggers", "test")
+	a := FunctionAppSyncTriggersAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestFunctionAppSyncTriggersAction_validation(t *testing.T) {
+	testData := []struct {
+		attribute string
+		value     string
+		valid     bool
+	}{
+		{
+			attribute: "function_app_id",
+			value:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1",
+			valid:     true,
+		},
+		{
+			attribute: "function_app_id",
+			value:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/serverFarms/farm1",
+			valid:     false,
+		},
+	}
+
+	s := FunctionAppSyncTriggersAction{}.schema()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q = %q", v.attribute, v.value)
+
+		resp := validator.StringResponse{}
+		for _, f := range s.Attributes[v.attribute].(schema.StringAttribute).Validators {
+			f.ValidateString(context.TODO(), validator.StringRequest{
+				Path:        path.Root(v.attribute),
+				ConfigValue: types.StringValue(v.value),
+			}, &resp)
+		}
+
+		if resp.Diagnostics.HasError() == v.valid {
+			t.Fatalf("expected valid to be %t but got diagnostics %+v", v.valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestFunctionAppSyncTriggersAction_invokeInvalidId(t *testing.T) {
+	ctx := context.TODO()
+	s := FunctionAppSyncTriggersAction{}.schema()
+	req := action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: s,
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"function_app_id": tftypes.NewValue(tftypes.String, "site1"),
+			}),
+		},
+	}
+
+	progress := make([]string, 0)
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+
+	a := appservice.FunctionAppSyncTriggersAction{
+		ActionMetadata: sdk.ActionMetadata{
+			Client: &clients.Client{
+				AppService: &client.Client{},
+			},
+		},
+	}
+	a.Invoke(ctx, req, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error diagnostic for an invalid `function_app_id`")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "parsing `function_app_id`" {
+		t.Fatalf("expected the summary to be %q but got %q", "parsing `function_app_id`", summary)
+	}
+	if len(progress) != 0 {
+		t.Fatalf("expected no progress to be reported but got %+v", progress)
+	}
+}
+
+func (FunctionAppSyncTriggersAction) schema() schema.Schema {
+	resp := action.SchemaResponse{}
+	appservice.NewFunctionAppSyncTriggersAction().Schema(context.TODO(), action.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func (FunctionAppSyncTriggersAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_function_app_sync_triggers" "test" {
+  config {
+    function_app_id = azurerm_linux_function_app.test.id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_linux_function_app.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_function_app_sync_triggers.test]
+    }
+  }
+}
+`, LinuxFunctionAppResource{}.basic(data, SkuBasicPlan))
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+)
+
+var _ sdk.Action = &CdnFrontDoorEndpointPurgeAction{}
+
+func NewCdnFrontDoorEndpointPurgeAction() action.Action {
+	return &CdnFrontDoorEndpointPurgeAction{}
+}
+
+type CdnFrontDoorEndpointPurgeAction struct {
+	sdk.ActionMetadata
+}
+
+type CdnFrontDoorEndpointPurgeActionModel struct {
+	CdnFrontDoorEndpointId types.String `tfsdk:"cdn_frontdoor_endpoint_id"`
+	ContentPaths           types.List   `tfsdk:"content_paths"`
+	Domains                types.List   `tfsdk:"domains"`
+}
+
+func (a *CdnFrontDoorEndpointPurgeAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "azurerm_cdn_frontdoor_endpoint_purge"
+}
+
+func (a *CdnFrontDoorEndpointPurgeAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.Defaults(req, resp)
+}
+
+func (a *CdnFrontDoorEndpointPurgeAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Purges cached content from a Front Door (standard/premium) Endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"cdn_frontdoor_endpoint_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Front Door Endpoint.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.FrontDoorEndpointID,
+					},
+				},
+			},
+
+			"content_paths": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The paths of the content to purge, for example `/pictures/city.png` or `/*` to purge all content.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+
+			"domains": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The domains whose content should be purged. Defaults to all domains associated with the Endpoint.",
+			},
+		},
+	}
+}
+
+func (a *CdnFrontDoorEndpointPurgeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	client := a.Client.Cdn.FrontDoorEndpointsClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*30)
+	defer cancel()
+
+	var data CdnFrontDoorEndpointPurgeActionModel
+	if ok := a.DecodeInvoke(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := parse.FrontDoorEndpointID(data.CdnFrontDoorEndpointId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing `cdn_frontdoor_endpoint_id`", err)
+		return
+	}
+
+	contentPaths := make([]string, 0)
+	resp.Diagnostics.Append(data.ContentPaths.ElementsAs(ctx, &contentPaths, false)...)
+	domains := make([]string, 0)
+	if !data.Domains.IsNull() {
+		resp.Diagnostics.Append(data.Domains.ElementsAs(ctx, &domains, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := cdn.AfdPurgeParameters{
+		ContentPaths: pointer.To(contentPaths),
+	}
+	if len(domains) > 0 {
+		payload.Domains = pointer.To(domains)
+	}
+
+	a.SendProgress(resp, fmt.Sprintf("purging content from %s..", id))
+
+	future, err := client.PurgeContent(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, payload)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("purging content from %s", id), err)
+		return
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("waiting for content to be purged from %s", id), err)
+		return
+	}
+
+	a.SendProgress(resp, fmt.Sprintf("purged content from %s", id))
+}
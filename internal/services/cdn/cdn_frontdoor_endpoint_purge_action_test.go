@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/client"
+)
+
+type CdnFrontDoorEndpointPurgeAction struct{}
+
+func TestAccCdnFrontDoorEndpointPurgeAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_endpoint_purge", "test")
+	a := CdnFrontDoorEndpointPurgeAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+			},
+		},
+	})
+}
+
+func TestCdnFrontDoorEndpointPurgeAction_validation(t *testing.T) {
+	testData := []struct {
+		attribute string
+		value     string
+		valid     bool
+	}{
+		{
+			attribute: "cdn_frontdoor_endpoint_id",
+			value:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/afdEndpoints/endpoint1",
+			valid:     true,
+		},
+		{
+			attribute: "cdn_frontdoor_endpoint_id",
+			value:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1",
+			valid:     false,
+		},
+	}
+
+	s := CdnFrontDoorEndpointPurgeAction{}.schema()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q = %q", v.attribute, v.value)
+
+		resp := validator.StringResponse{}
+		for _, f := range s.Attributes[v.attribute].(schema.StringAttribute).Validators {
+			f.ValidateString(context.TODO(), validator.StringRequest{
+				Path:        path.Root(v.attribute),
+				ConfigValue: types.StringValue(v.value),
+			}, &resp)
+		}
+
+		if resp.Diagnostics.HasError() == v.valid {
+			t.Fatalf("expected valid to be %t but got diagnostics %+v", v.valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestCdnFrontDoorEndpointPurgeAction_contentPathsValidation(t *testing.T) {
+	testData := []struct {
+		paths []string
+		valid bool
+	}{
+		{
+			paths: []string{},
+			valid: false,
+		},
+		{
+			paths: []string{"/*"},
+			valid: true,
+		},
+		{
+			paths: []string{"/pictures/city.png", "/pictures/country.png"},
+			valid: true,
+		},
+	}
+
+	s := CdnFrontDoorEndpointPurgeAction{}.schema()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.paths)
+
+		paths, diags := types.ListValueFrom(context.TODO(), types.StringType, v.paths)
+		if diags.HasError() {
+			t.Fatalf("building `content_paths`: %+v", diags)
+		}
+
+		resp := validator.ListResponse{}
+		for _, f := range s.Attributes["content_paths"].(schema.ListAttribute).Validators {
+			f.ValidateList(context.TODO(), validator.ListRequest{
+				Path:        path.Root("content_paths"),
+				ConfigValue: paths,
+			}, &resp)
+		}
+
+		if resp.Diagnostics.HasError() == v.valid {
+			t.Fatalf("expected valid to be %t but got diagnostics %+v", v.valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestCdnFrontDoorEndpointPurgeAction_invokeInvalidId(t *testing.T) {
+	ctx := context.TODO()
+	s := CdnFrontDoorEndpointPurgeAction{}.schema()
+	req := action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: s,
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"cdn_frontdoor_endpoint_id": tftypes.NewValue(tftypes.String, "endpoint1"),
+				"content_paths": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "/*"),
+				}),
+				"domains": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+		},
+	}
+
+	progress := make([]string, 0)
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+
+	a := cdn.CdnFrontDoorEndpointPurgeAction{
+		ActionMetadata: sdk.ActionMetadata{
+			Client: &clients.Client{
+				Cdn: &client.Client{},
+			},
+		},
+	}
+	a.Invoke(ctx, req, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error diagnostic for an invalid `cdn_frontdoor_endpoint_id`")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "parsing `cdn_frontdoor_endpoint_id`" {
+		t.Fatalf("expected the summary to be %q but got %q", "parsing `cdn_frontdoor_endpoint_id`", summary)
+	}
+	if len(progress) != 0 {
+		t.Fatalf("expected no progress to be reported but got %+v", progress)
+	}
+}
+
+func (CdnFrontDoorEndpointPurgeAction) schema() schema.Schema {
+	resp := action.SchemaResponse{}
+	cdn.NewCdnFrontDoorEndpointPurgeAction().Schema(context.TODO(), action.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func (CdnFrontDoorEndpointPurgeAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_cdn_frontdoor_endpoint_purge" "test" {
+  config {
+    cdn_frontdoor_endpoint_id = azurerm_cdn_frontdoor_endpoint.test.id
+    content_paths             = ["/*"]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_cdn_frontdoor_endpoint.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_cdn_frontdoor_endpoint_purge.test]
+    }
+  }
+}
+`, CdnFrontDoorEndpointResource{}.basic(data))
+}
@@ -96,23 +96,8 @@ func (r ChaosStudioCapabilityResource) Create() sdk.ResourceFunc {
 			targetTypeId := capabilitytypes.NewTargetTypeID(subscriptionId, *existingTarget.Model.Location, targetId.TargetName)
 
 			// validate capability since valid values are dependent on the target type
-			capabilityTypes := make([]string, 0)
-			resp, err := capabilityTypesClient.ListComplete(ctx, targetTypeId, capabilitytypes.DefaultListOperationOptions())
-			if err != nil {
-				return fmt.Errorf("retrieving list of chaos capability types: %+v", err)
-			}
-			typeIsValid := false
-			for _, item := range resp.Items {
-				if name := item.Name; name != nil {
-					if strings.EqualFold(config.CapabilityType, *item.Name) {
-						typeIsValid = true
-					}
-					capabilityTypes = append(capabilityTypes, pointer.From(item.Name))
-				}
-			}
-
-			if !typeIsValid {
-				return fmt.Errorf("%q is not a valid `capability_type` for the target type %q, must be one of %+v", config.CapabilityType, targetId.TargetName, capabilityTypes)
+			if err := validateChaosStudioCapabilityType(ctx, capabilityTypesClient, targetTypeId, config.CapabilityType); err != nil {
+				return err
 			}
 
 			id := commonids.NewChaosStudioCapabilityID(targetId.Scope, targetId.TargetName, config.CapabilityType)
@@ -200,3 +185,27 @@ func (r ChaosStudioCapabilityResource) Delete() sdk.ResourceFunc {
 		Timeout: 30 * time.Minute,
 	}
 }
+
+// validateChaosStudioCapabilityType validates that the Capability Type is available for the Target Type
+func validateChaosStudioCapabilityType(ctx context.Context, client *capabilitytypes.CapabilityTypesClient, targetTypeId capabilitytypes.TargetTypeId, capabilityType string) error {
+	capabilityTypes := make([]string, 0)
+	resp, err := client.ListComplete(ctx, targetTypeId, capabilitytypes.DefaultListOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving list of chaos capability types: %+v", err)
+	}
+	typeIsValid := false
+	for _, item := range resp.Items {
+		if name := item.Name; name != nil {
+			if strings.EqualFold(capabilityType, *item.Name) {
+				typeIsValid = true
+			}
+			capabilityTypes = append(capabilityTypes, pointer.From(item.Name))
+		}
+	}
+
+	if !typeIsValid {
+		return fmt.Errorf("%q is not a valid `capability_type` for the target type %q, must be one of %+v", capabilityType, targetTypeId.TargetTypeName, capabilityTypes)
+	}
+
+	return nil
+}
@@ -23,8 +23,9 @@ import (
 )
 
 var (
-	_ sdk.Resource           = ChaosStudioExperimentResource{}
-	_ sdk.ResourceWithUpdate = ChaosStudioExperimentResource{}
+	_ sdk.Resource                  = ChaosStudioExperimentResource{}
+	_ sdk.ResourceWithUpdate        = ChaosStudioExperimentResource{}
+	_ sdk.ResourceWithCustomizeDiff = ChaosStudioExperimentResource{}
 )
 
 const (
@@ -40,12 +41,13 @@ func (r ChaosStudioExperimentResource) ModelObject() interface{} {
 }
 
 type ChaosStudioExperimentResourceSchema struct {
-	Identity          []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
-	Location          string                                     `tfschema:"location"`
-	Name              string                                     `tfschema:"name"`
-	ResourceGroupName string                                     `tfschema:"resource_group_name"`
-	Selectors         []SelectorSchema                           `tfschema:"selectors"`
-	Steps             []StepSchema                               `tfschema:"steps"`
+	EnableFaultTargets bool                                       `tfschema:"enable_fault_targets"`
+	Identity           []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Location           string                                     `tfschema:"location"`
+	Name               string                                     `tfschema:"name"`
+	ResourceGroupName  string                                     `tfschema:"resource_group_name"`
+	Selectors          []SelectorSchema                           `tfschema:"selectors"`
+	Steps              []StepSchema                               `tfschema:"steps"`
 	// tags are not fully supported yet, you can send them to the API, but they won't be returned
 	// Tags              map[string]interface{}                     `tfschema:"tags"`
 }
@@ -71,6 +73,7 @@ type ActionSchema struct {
 	Duration     string            `tfschema:"duration"`
 	Urn          string            `tfschema:"urn"`
 	Parameters   map[string]string `tfschema:"parameters"`
+	Fault        []FaultSchema     `tfschema:"fault"`
 }
 
 func (r ChaosStudioExperimentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
@@ -170,6 +173,7 @@ func (r ChaosStudioExperimentResource) Arguments() map[string]*pluginsdk.Schema
 													Type: pluginsdk.TypeString,
 												},
 											},
+											"fault": chaosStudioFaultSchema(),
 										},
 									},
 								},
@@ -180,6 +184,11 @@ func (r ChaosStudioExperimentResource) Arguments() map[string]*pluginsdk.Schema
 			},
 		},
 		"identity": commonschema.SystemOrUserAssignedIdentityOptional(),
+		"enable_fault_targets": {
+			Optional: true,
+			Type:     pluginsdk.TypeBool,
+			Default:  false,
+		},
 	}
 }
 
@@ -238,6 +247,14 @@ func (r ChaosStudioExperimentResource) Create() sdk.ResourceFunc {
 
 			payload.Properties = experimentProperties
 
+			if config.EnableFaultTargets {
+				if err := enableChaosStudioFaults(ctx, metadata.Client, config); err != nil {
+					return fmt.Errorf("enabling the faults for %s: %+v", id, err)
+				}
+			} else if err := validateChaosStudioFaultsEnabled(ctx, metadata.Client, config); err != nil {
+				return fmt.Errorf("validating the faults for %s: %+v", id, err)
+			}
+
 			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
@@ -260,6 +277,13 @@ func (r ChaosStudioExperimentResource) Read() sdk.ResourceFunc {
 				return err
 			}
 
+			// the API only returns the URN and parameters of each action, so the state is used to determine which
+			// actions were configured using a `fault` block
+			var state ChaosStudioExperimentResourceSchema
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
 			resp, err := client.Get(ctx, *id)
 			if err != nil {
 				if response.WasNotFound(resp.HttpResponse) {
@@ -270,6 +294,7 @@ func (r ChaosStudioExperimentResource) Read() sdk.ResourceFunc {
 
 			if model := resp.Model; model != nil {
 				schema.Name = id.ExperimentName
+				schema.EnableFaultTargets = state.EnableFaultTargets
 				schema.ResourceGroupName = id.ResourceGroupName
 				schema.Location = location.Normalize(model.Location)
 
@@ -285,7 +310,7 @@ func (r ChaosStudioExperimentResource) Read() sdk.ResourceFunc {
 				if err != nil {
 					return fmt.Errorf("flattening `steps`: %+v", err)
 				}
-				schema.Steps = pointer.From(steps)
+				schema.Steps = flattenStepsFaults(pointer.From(steps), state.Steps)
 
 				flattenedIdentity, err := identity.FlattenSystemOrUserAssignedMapToModel(model.Identity)
 				if err != nil {
@@ -369,6 +394,16 @@ func (r ChaosStudioExperimentResource) Update() sdk.ResourceFunc {
 				payload.Properties.Steps = *steps
 			}
 
+			if metadata.ResourceData.HasChanges("enable_fault_targets", "selectors", "steps") {
+				if config.EnableFaultTargets {
+					if err := enableChaosStudioFaults(ctx, metadata.Client, config); err != nil {
+						return fmt.Errorf("enabling the faults for %s: %+v", *id, err)
+					}
+				} else if err := validateChaosStudioFaultsEnabled(ctx, metadata.Client, config); err != nil {
+					return fmt.Errorf("validating the faults for %s: %+v", *id, err)
+				}
+			}
+
 			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
@@ -386,6 +421,20 @@ func (r ChaosStudioExperimentResource) Update() sdk.ResourceFunc {
 	}
 }
 
+func (r ChaosStudioExperimentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ChaosStudioExperimentResourceSchema
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return validateChaosStudioExperimentFaults(config)
+		},
+	}
+}
+
 func expandSelectors(input []SelectorSchema) (*[]experiments.Selector, error) {
 	output := make([]experiments.Selector, 0)
 
@@ -438,9 +487,24 @@ func expandActions(input []ActionSchema) (*[]experiments.Action, error) {
 	output := make([]experiments.Action, 0)
 
 	for _, action := range input {
+		urn := action.Urn
+		parametersInput := action.Parameters
+		if len(action.Fault) > 0 {
+			if action.Urn != "" || len(action.Parameters) > 0 {
+				return nil, fmt.Errorf("`urn` and `parameters` cannot be specified when a `fault` block is specified")
+			}
+
+			faultUrn, faultParameters, err := expandChaosStudioFault(action.Fault[0])
+			if err != nil {
+				return nil, fmt.Errorf("expanding `fault`: %+v", err)
+			}
+			urn = faultUrn
+			parametersInput = faultParameters
+		}
+
 		parameters := make([]experiments.KeyValuePair, 0)
-		if len(action.Parameters) > 0 {
-			for k, v := range action.Parameters {
+		if len(parametersInput) > 0 {
+			for k, v := range parametersInput {
 				parameters = append(parameters, experiments.KeyValuePair{
 					Key:   k,
 					Value: v,
@@ -450,14 +514,14 @@ func expandActions(input []ActionSchema) (*[]experiments.Action, error) {
 
 		switch action.ActionType {
 		case continuousActionType:
-			if action.Duration == "" || action.SelectorName == "" || action.Urn == "" {
-				return nil, fmt.Errorf("`duration`, `selector_name` and `urn` must be set for actions with `action_type` of `continuous`")
+			if action.Duration == "" || action.SelectorName == "" || urn == "" {
+				return nil, fmt.Errorf("`duration`, `selector_name` and either `urn` or `fault` must be set for actions with `action_type` of `continuous`")
 			}
 			output = append(output, experiments.ContinuousAction{
 				Duration:   action.Duration,
 				Parameters: parameters,
 				SelectorId: action.SelectorName,
-				Name:       action.Urn,
+				Name:       urn,
 			})
 		case delayActionType:
 			if action.Duration == "" {
@@ -468,13 +532,13 @@ func expandActions(input []ActionSchema) (*[]experiments.Action, error) {
 				Name:     "urn:csci:microsoft:chaosStudio:timedDelay/1.0",
 			})
 		case discreteActionType:
-			if action.SelectorName == "" || action.Urn == "" {
-				return nil, fmt.Errorf("`selector_name` and `urn` must be set for actions with `action_type` of `discrete`")
+			if action.SelectorName == "" || urn == "" {
+				return nil, fmt.Errorf("`selector_name` and either `urn` or `fault` must be set for actions with `action_type` of `discrete`")
 			}
 			output = append(output, experiments.DiscreteAction{
 				Parameters: parameters,
 				SelectorId: action.SelectorName,
-				Name:       action.Urn,
+				Name:       urn,
 			})
 		}
 	}
@@ -577,3 +641,34 @@ func flattenActions(input []experiments.Action) (*[]ActionSchema, error) {
 
 	return &output, nil
 }
+
+// flattenStepsFaults replaces the URN and parameters of the actions which were configured using a `fault` block in
+// the state with the equivalent `fault` block
+func flattenStepsFaults(input []StepSchema, state []StepSchema) []StepSchema {
+	for i := range input {
+		if i >= len(state) {
+			break
+		}
+		for j := range input[i].Branch {
+			if j >= len(state[i].Branch) {
+				break
+			}
+			for k, action := range input[i].Branch[j].Actions {
+				if k >= len(state[i].Branch[j].Actions) || len(state[i].Branch[j].Actions[k].Fault) == 0 {
+					continue
+				}
+
+				fault, ok := flattenChaosStudioFault(action.Urn, action.Parameters)
+				if !ok {
+					continue
+				}
+
+				input[i].Branch[j].Actions[k].Fault = []FaultSchema{*fault}
+				input[i].Branch[j].Actions[k].Urn = ""
+				input[i].Branch[j].Actions[k].Parameters = nil
+			}
+		}
+	}
+
+	return input
+}
@@ -136,6 +136,51 @@ func TestAccChaosStudioExperiment_multipleSelectors(t *testing.T) {
 	})
 }
 
+func TestAccChaosStudioExperiment_fault(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fault(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("steps.0.branch.0.actions.0.fault.0.type").HasValue("VirtualMachineShutdown"),
+			),
+		},
+		data.ImportStep("steps.0.branch.0.actions.0.fault", "steps.0.branch.0.actions.0.urn", "steps.0.branch.0.actions.0.parameters"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.fault(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("steps.0.branch.0.actions.0.fault", "steps.0.branch.0.actions.0.urn", "steps.0.branch.0.actions.0.parameters"),
+	})
+}
+
+func TestAccChaosStudioExperiment_faultEnablement(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.faultEnablement(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("enable_fault_targets", "steps.0.branch.0.actions.0.fault", "steps.0.branch.0.actions.0.urn", "steps.0.branch.0.actions.0.parameters"),
+	})
+}
+
 func (r ChaosStudioExperimentTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := experiments.ParseExperimentID(state.ID)
 	if err != nil {
@@ -382,6 +427,105 @@ resource "azurerm_chaos_studio_experiment" "test" {
 `, r.templateVM(data), r.templateAKS())
 }
 
+func (r ChaosStudioExperimentTestResource) fault(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  location            = azurerm_resource_group.test.location
+  name                = "acctestcse-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  selectors {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  steps {
+    name = "acctestcse-${var.random_string}"
+    branch {
+      name = "acctestcse-${var.random_string}"
+      actions {
+        selector_name = "Selector1"
+        action_type   = "continuous"
+        duration      = "PT10M"
+
+        fault {
+          type = "VirtualMachineShutdown"
+
+          virtual_machine_shutdown {
+            abrupt_shutdown_enabled = true
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [azurerm_chaos_studio_capability.test]
+}
+`, r.templateVM(data))
+}
+
+func (r ChaosStudioExperimentTestResource) faultEnablement(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv${var.random_string}"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  location             = azurerm_resource_group.test.location
+  name                 = "acctestcse-${var.random_string}"
+  resource_group_name  = azurerm_resource_group.test.name
+  enable_fault_targets = true
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  selectors {
+    name                    = "Selector1"
+    chaos_studio_target_ids = ["${azurerm_key_vault.test.id}/providers/Microsoft.Chaos/targets/Microsoft-KeyVault"]
+  }
+
+  steps {
+    name = "acctestcse-${var.random_string}"
+    branch {
+      name = "acctestcse-${var.random_string}"
+      actions {
+        selector_name = "Selector1"
+        action_type   = "continuous"
+        duration      = "PT5M"
+
+        fault {
+          type = "KeyVaultDenyAccess"
+        }
+      }
+    }
+  }
+}
+`, r.templateBase(data))
+}
+
 func (r ChaosStudioExperimentTestResource) templateVM(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
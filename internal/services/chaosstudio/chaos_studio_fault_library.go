@@ -0,0 +1,1201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chaosstudio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/capabilities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/capabilitytypes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	faultTypeAksNetworkChaos            = "AksNetworkChaos"
+	faultTypeAksPodChaos                = "AksPodChaos"
+	faultTypeCosmosDbFailover           = "CosmosDbFailover"
+	faultTypeKeyVaultDenyAccess         = "KeyVaultDenyAccess"
+	faultTypeNetworkSecurityGroupRule   = "NetworkSecurityGroupRule"
+	faultTypeServiceBusChangeQueueState = "ServiceBusChangeQueueState"
+	faultTypeVirtualMachineShutdown     = "VirtualMachineShutdown"
+)
+
+// chaosStudioFault describes a fault from the Chaos Studio fault library, more information can be found in
+// https://learn.microsoft.com/azure/chaos-studio/chaos-studio-fault-library
+type chaosStudioFault struct {
+	Urn            string
+	ActionType     string
+	TargetType     string
+	CapabilityType string
+
+	// ParametersBlock is the name of the block within `fault` which configures this fault, this must be specified
+	// when set, faults without any parameters leave this empty
+	ParametersBlock string
+
+	// ResourceApiVersion is the API Version used to look up the location of the targeted resource,
+	// which is needed to enable the Chaos Studio Target for it
+	ResourceApiVersion string
+}
+
+var chaosStudioFaults = map[string]chaosStudioFault{
+	faultTypeAksNetworkChaos: {
+		Urn:                "urn:csci:microsoft:azureKubernetesServiceChaosMesh:networkChaos/2.1",
+		ActionType:         continuousActionType,
+		TargetType:         "Microsoft-AzureKubernetesServiceChaosMesh",
+		CapabilityType:     "NetworkChaos-2.1",
+		ParametersBlock:    "aks_network_chaos",
+		ResourceApiVersion: "2024-05-01",
+	},
+	faultTypeAksPodChaos: {
+		Urn:                "urn:csci:microsoft:azureKubernetesServiceChaosMesh:podChaos/2.1",
+		ActionType:         continuousActionType,
+		TargetType:         "Microsoft-AzureKubernetesServiceChaosMesh",
+		CapabilityType:     "PodChaos-2.1",
+		ParametersBlock:    "aks_pod_chaos",
+		ResourceApiVersion: "2024-05-01",
+	},
+	faultTypeCosmosDbFailover: {
+		Urn:                "urn:csci:microsoft:cosmosDB:failover/1.0",
+		ActionType:         continuousActionType,
+		TargetType:         "Microsoft-CosmosDB",
+		CapabilityType:     "Failover-1.0",
+		ParametersBlock:    "cosmos_db_failover",
+		ResourceApiVersion: "2024-08-15",
+	},
+	faultTypeKeyVaultDenyAccess: {
+		Urn:                "urn:csci:microsoft:keyVault:denyAccess/1.0",
+		ActionType:         continuousActionType,
+		TargetType:         "Microsoft-KeyVault",
+		CapabilityType:     "DenyAccess-1.0",
+		ResourceApiVersion: "2023-07-01",
+	},
+	faultTypeNetworkSecurityGroupRule: {
+		Urn:                "urn:csci:microsoft:networkSecurityGroup:securityRule/1.1",
+		ActionType:         continuousActionType,
+		TargetType:         "Microsoft-NetworkSecurityGroup",
+		CapabilityType:     "SecurityRule-1.1",
+		ParametersBlock:    "network_security_group_rule",
+		ResourceApiVersion: "2024-05-01",
+	},
+	faultTypeServiceBusChangeQueueState: {
+		Urn:                "urn:csci:microsoft:serviceBus:changeQueueState/1.0",
+		ActionType:         discreteActionType,
+		TargetType:         "Microsoft-ServiceBus",
+		CapabilityType:     "ChangeQueueState-1.0",
+		ParametersBlock:    "service_bus_change_queue_state",
+		ResourceApiVersion: "2021-11-01",
+	},
+	faultTypeVirtualMachineShutdown: {
+		Urn:                "urn:csci:microsoft:virtualMachine:shutdown/1.0",
+		ActionType:         continuousActionType,
+		TargetType:         "Microsoft-VirtualMachine",
+		CapabilityType:     "Shutdown-1.0",
+		ParametersBlock:    "virtual_machine_shutdown",
+		ResourceApiVersion: "2024-03-01",
+	},
+}
+
+type FaultSchema struct {
+	Type                       string                                  `tfschema:"type"`
+	AksNetworkChaos            []AksNetworkChaosFaultSchema            `tfschema:"aks_network_chaos"`
+	AksPodChaos                []AksPodChaosFaultSchema                `tfschema:"aks_pod_chaos"`
+	CosmosDbFailover           []CosmosDbFailoverFaultSchema           `tfschema:"cosmos_db_failover"`
+	NetworkSecurityGroupRule   []NetworkSecurityGroupRuleFaultSchema   `tfschema:"network_security_group_rule"`
+	ServiceBusChangeQueueState []ServiceBusChangeQueueStateFaultSchema `tfschema:"service_bus_change_queue_state"`
+	VirtualMachineShutdown     []VirtualMachineShutdownFaultSchema     `tfschema:"virtual_machine_shutdown"`
+}
+
+type AksNetworkChaosFaultSchema struct {
+	Action          string            `tfschema:"action"`
+	Correlation     string            `tfschema:"correlation"`
+	Direction       string            `tfschema:"direction"`
+	ExternalTargets []string          `tfschema:"external_targets"`
+	Jitter          string            `tfschema:"jitter"`
+	LabelSelectors  map[string]string `tfschema:"label_selectors"`
+	Latency         string            `tfschema:"latency"`
+	Mode            string            `tfschema:"mode"`
+	Namespaces      []string          `tfschema:"namespaces"`
+	Percentage      string            `tfschema:"percentage"`
+	Value           string            `tfschema:"value"`
+}
+
+type AksPodChaosFaultSchema struct {
+	Action         string            `tfschema:"action"`
+	ContainerNames []string          `tfschema:"container_names"`
+	LabelSelectors map[string]string `tfschema:"label_selectors"`
+	Mode           string            `tfschema:"mode"`
+	Namespaces     []string          `tfschema:"namespaces"`
+	Value          string            `tfschema:"value"`
+}
+
+type CosmosDbFailoverFaultSchema struct {
+	ReadRegion string `tfschema:"read_region"`
+}
+
+type NetworkSecurityGroupRuleFaultSchema struct {
+	Action                 string   `tfschema:"action"`
+	DestinationAddresses   []string `tfschema:"destination_addresses"`
+	DestinationPortRanges  []string `tfschema:"destination_port_ranges"`
+	Direction              string   `tfschema:"direction"`
+	FlushConnectionEnabled bool     `tfschema:"flush_connection_enabled"`
+	Name                   string   `tfschema:"name"`
+	Priority               int64    `tfschema:"priority"`
+	Protocol               string   `tfschema:"protocol"`
+	SourceAddresses        []string `tfschema:"source_addresses"`
+	SourcePortRanges       []string `tfschema:"source_port_ranges"`
+}
+
+type ServiceBusChangeQueueStateFaultSchema struct {
+	DesiredState string   `tfschema:"desired_state"`
+	Queues       []string `tfschema:"queues"`
+}
+
+type VirtualMachineShutdownFaultSchema struct {
+	AbruptShutdownEnabled bool `tfschema:"abrupt_shutdown_enabled"`
+}
+
+// chaosMeshSpec is the subset of the Chaos Mesh PodChaos and NetworkChaos specifications which can be configured
+// using the `aks_pod_chaos` and `aks_network_chaos` blocks, which is sent to the API as the `jsonSpec` parameter
+type chaosMeshSpec struct {
+	Action          string              `json:"action"`
+	Mode            string              `json:"mode"`
+	Value           string              `json:"value,omitempty"`
+	Selector        chaosMeshSelector   `json:"selector"`
+	ContainerNames  []string            `json:"containerNames,omitempty"`
+	Direction       string              `json:"direction,omitempty"`
+	ExternalTargets []string            `json:"externalTargets,omitempty"`
+	Delay           *chaosMeshDelay     `json:"delay,omitempty"`
+	Loss            *chaosMeshLoss      `json:"loss,omitempty"`
+	Duplicate       *chaosMeshDuplicate `json:"duplicate,omitempty"`
+	Corrupt         *chaosMeshCorrupt   `json:"corrupt,omitempty"`
+}
+
+type chaosMeshSelector struct {
+	Namespaces     []string          `json:"namespaces"`
+	LabelSelectors map[string]string `json:"labelSelectors,omitempty"`
+}
+
+type chaosMeshDelay struct {
+	Latency     string `json:"latency"`
+	Jitter      string `json:"jitter,omitempty"`
+	Correlation string `json:"correlation,omitempty"`
+}
+
+type chaosMeshLoss struct {
+	Loss        string `json:"loss"`
+	Correlation string `json:"correlation,omitempty"`
+}
+
+type chaosMeshDuplicate struct {
+	Duplicate   string `json:"duplicate"`
+	Correlation string `json:"correlation,omitempty"`
+}
+
+type chaosMeshCorrupt struct {
+	Corrupt     string `json:"corrupt"`
+	Correlation string `json:"correlation,omitempty"`
+}
+
+func chaosStudioFaultSchema() *pluginsdk.Schema {
+	chaosMeshModes := []string{
+		"all",
+		"fixed",
+		"fixed-percent",
+		"one",
+		"random-max-percent",
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						faultTypeAksNetworkChaos,
+						faultTypeAksPodChaos,
+						faultTypeCosmosDbFailover,
+						faultTypeKeyVaultDenyAccess,
+						faultTypeNetworkSecurityGroupRule,
+						faultTypeServiceBusChangeQueueState,
+						faultTypeVirtualMachineShutdown,
+					}, false),
+				},
+
+				"aks_network_chaos": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"action": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"corrupt",
+									"delay",
+									"duplicate",
+									"loss",
+									"partition",
+								}, false),
+							},
+
+							"namespaces": {
+								Type:     pluginsdk.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+
+							"correlation": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"direction": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"both",
+									"from",
+									"to",
+								}, false),
+							},
+
+							"external_targets": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+
+							"jitter": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"label_selectors": {
+								Type:     pluginsdk.TypeMap,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
+
+							"latency": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"mode": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Default:      "all",
+								ValidateFunc: validation.StringInSlice(chaosMeshModes, false),
+							},
+
+							"percentage": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"value": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+
+				"aks_pod_chaos": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"action": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"container-kill",
+									"pod-failure",
+									"pod-kill",
+								}, false),
+							},
+
+							"namespaces": {
+								Type:     pluginsdk.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+
+							"container_names": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+
+							"label_selectors": {
+								Type:     pluginsdk.TypeMap,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
+
+							"mode": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Default:      "all",
+								ValidateFunc: validation.StringInSlice(chaosMeshModes, false),
+							},
+
+							"value": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+
+				"cosmos_db_failover": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"read_region": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+
+				"network_security_group_rule": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"action": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Allow",
+									"Deny",
+								}, false),
+							},
+
+							"destination_addresses": {
+								Type:     pluginsdk.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+
+							"destination_port_ranges": {
+								Type:     pluginsdk.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+
+							"direction": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Inbound",
+									"Outbound",
+								}, false),
+							},
+
+							"priority": {
+								Type:         pluginsdk.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(100, 4096),
+							},
+
+							"source_addresses": {
+								Type:     pluginsdk.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+
+							"source_port_ranges": {
+								Type:     pluginsdk.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+
+							"flush_connection_enabled": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"protocol": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								Default:  "Any",
+								ValidateFunc: validation.StringInSlice([]string{
+									"Any",
+									"Tcp",
+									"Udp",
+								}, false),
+							},
+						},
+					},
+				},
+
+				"service_bus_change_queue_state": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"desired_state": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Active",
+									"Disabled",
+									"ReceiveDisabled",
+									"SendDisabled",
+								}, false),
+							},
+
+							"queues": {
+								Type:     pluginsdk.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+
+				"virtual_machine_shutdown": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"abrupt_shutdown_enabled": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateChaosStudioFaultBlocks validates that only the block configuring the specified fault type is specified
+func validateChaosStudioFaultBlocks(input FaultSchema, fault chaosStudioFault) error {
+	blocks := map[string]bool{
+		"aks_network_chaos":              len(input.AksNetworkChaos) > 0,
+		"aks_pod_chaos":                  len(input.AksPodChaos) > 0,
+		"cosmos_db_failover":             len(input.CosmosDbFailover) > 0,
+		"network_security_group_rule":    len(input.NetworkSecurityGroupRule) > 0,
+		"service_bus_change_queue_state": len(input.ServiceBusChangeQueueState) > 0,
+		"virtual_machine_shutdown":       len(input.VirtualMachineShutdown) > 0,
+	}
+
+	names := make([]string, 0)
+	for name := range blocks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if blocks[name] && name != fault.ParametersBlock {
+			return fmt.Errorf("the `%s` block cannot be specified when `type` is `%s`", name, input.Type)
+		}
+	}
+
+	if fault.ParametersBlock != "" && !blocks[fault.ParametersBlock] {
+		return fmt.Errorf("the `%s` block must be specified when `type` is `%s`", fault.ParametersBlock, input.Type)
+	}
+
+	return nil
+}
+
+// expandChaosStudioFault returns the URN and parameters of the action configured by a `fault` block
+func expandChaosStudioFault(input FaultSchema) (string, map[string]string, error) {
+	fault, ok := chaosStudioFaults[input.Type]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported fault type %q", input.Type)
+	}
+
+	if err := validateChaosStudioFaultBlocks(input, fault); err != nil {
+		return "", nil, err
+	}
+
+	parameters := make(map[string]string)
+
+	switch input.Type {
+	case faultTypeAksNetworkChaos:
+		spec, err := expandChaosMeshNetworkChaosSpec(input.AksNetworkChaos[0])
+		if err != nil {
+			return "", nil, fmt.Errorf("expanding `aks_network_chaos`: %+v", err)
+		}
+		parameters["jsonSpec"] = *spec
+
+	case faultTypeAksPodChaos:
+		spec, err := expandChaosMeshPodChaosSpec(input.AksPodChaos[0])
+		if err != nil {
+			return "", nil, fmt.Errorf("expanding `aks_pod_chaos`: %+v", err)
+		}
+		parameters["jsonSpec"] = *spec
+
+	case faultTypeCosmosDbFailover:
+		parameters["readRegion"] = input.CosmosDbFailover[0].ReadRegion
+
+	case faultTypeNetworkSecurityGroupRule:
+		rule := input.NetworkSecurityGroupRule[0]
+		parameters["name"] = rule.Name
+		parameters["action"] = rule.Action
+		parameters["direction"] = rule.Direction
+		parameters["priority"] = strconv.FormatInt(rule.Priority, 10)
+		parameters["protocol"] = rule.Protocol
+		parameters["flushConnection"] = strconv.FormatBool(rule.FlushConnectionEnabled)
+
+		lists := map[string][]string{
+			"destinationAddresses":  rule.DestinationAddresses,
+			"destinationPortRanges": rule.DestinationPortRanges,
+			"sourceAddresses":       rule.SourceAddresses,
+			"sourcePortRanges":      rule.SourcePortRanges,
+		}
+		for key, value := range lists {
+			v, err := json.Marshal(value)
+			if err != nil {
+				return "", nil, fmt.Errorf("marshalling %q: %+v", key, err)
+			}
+			parameters[key] = string(v)
+		}
+
+	case faultTypeServiceBusChangeQueueState:
+		state := input.ServiceBusChangeQueueState[0]
+		queues, err := json.Marshal(state.Queues)
+		if err != nil {
+			return "", nil, fmt.Errorf("marshalling `queues`: %+v", err)
+		}
+		parameters["desiredState"] = state.DesiredState
+		parameters["queues"] = string(queues)
+
+	case faultTypeVirtualMachineShutdown:
+		parameters["abruptShutdown"] = strconv.FormatBool(input.VirtualMachineShutdown[0].AbruptShutdownEnabled)
+	}
+
+	return fault.Urn, parameters, nil
+}
+
+func expandChaosMeshPodChaosSpec(input AksPodChaosFaultSchema) (*string, error) {
+	if err := validateChaosMeshMode(input.Mode, input.Value); err != nil {
+		return nil, err
+	}
+
+	if input.Action == "container-kill" && len(input.ContainerNames) == 0 {
+		return nil, fmt.Errorf("`container_names` must be specified when `action` is `container-kill`")
+	}
+	if input.Action != "container-kill" && len(input.ContainerNames) > 0 {
+		return nil, fmt.Errorf("`container_names` can only be specified when `action` is `container-kill`")
+	}
+
+	spec := chaosMeshSpec{
+		Action: input.Action,
+		Mode:   input.Mode,
+		Value:  input.Value,
+		Selector: chaosMeshSelector{
+			Namespaces:     input.Namespaces,
+			LabelSelectors: input.LabelSelectors,
+		},
+		ContainerNames: input.ContainerNames,
+	}
+
+	return marshalChaosMeshSpec(spec)
+}
+
+func expandChaosMeshNetworkChaosSpec(input AksNetworkChaosFaultSchema) (*string, error) {
+	if err := validateChaosMeshMode(input.Mode, input.Value); err != nil {
+		return nil, err
+	}
+
+	if input.Action != "delay" && (input.Latency != "" || input.Jitter != "") {
+		return nil, fmt.Errorf("`latency` and `jitter` can only be specified when `action` is `delay`")
+	}
+
+	spec := chaosMeshSpec{
+		Action: input.Action,
+		Mode:   input.Mode,
+		Value:  input.Value,
+		Selector: chaosMeshSelector{
+			Namespaces:     input.Namespaces,
+			LabelSelectors: input.LabelSelectors,
+		},
+		Direction:       input.Direction,
+		ExternalTargets: input.ExternalTargets,
+	}
+
+	switch input.Action {
+	case "delay":
+		if input.Latency == "" {
+			return nil, fmt.Errorf("`latency` must be specified when `action` is `delay`")
+		}
+		if input.Percentage != "" {
+			return nil, fmt.Errorf("`percentage` cannot be specified when `action` is `delay`")
+		}
+		spec.Delay = &chaosMeshDelay{
+			Latency:     input.Latency,
+			Jitter:      input.Jitter,
+			Correlation: input.Correlation,
+		}
+
+	case "corrupt", "duplicate", "loss":
+		if input.Percentage == "" {
+			return nil, fmt.Errorf("`percentage` must be specified when `action` is `%s`", input.Action)
+		}
+		switch input.Action {
+		case "corrupt":
+			spec.Corrupt = &chaosMeshCorrupt{
+				Corrupt:     input.Percentage,
+				Correlation: input.Correlation,
+			}
+		case "duplicate":
+			spec.Duplicate = &chaosMeshDuplicate{
+				Duplicate:   input.Percentage,
+				Correlation: input.Correlation,
+			}
+		case "loss":
+			spec.Loss = &chaosMeshLoss{
+				Loss:        input.Percentage,
+				Correlation: input.Correlation,
+			}
+		}
+
+	case "partition":
+		if input.Percentage != "" || input.Correlation != "" {
+			return nil, fmt.Errorf("`percentage` and `correlation` cannot be specified when `action` is `partition`")
+		}
+	}
+
+	return marshalChaosMeshSpec(spec)
+}
+
+func validateChaosMeshMode(mode, value string) error {
+	switch mode {
+	case "all", "one":
+		if value != "" {
+			return fmt.Errorf("`value` cannot be specified when `mode` is `%s`", mode)
+		}
+	default:
+		if value == "" {
+			return fmt.Errorf("`value` must be specified when `mode` is `%s`", mode)
+		}
+	}
+
+	return nil
+}
+
+func marshalChaosMeshSpec(input chaosMeshSpec) (*string, error) {
+	spec, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling `jsonSpec`: %+v", err)
+	}
+
+	return pointer.To(string(spec)), nil
+}
+
+// flattenChaosStudioFault returns the `fault` block which is equivalent to the URN and parameters of an action, the
+// bool returned is false when these can't be represented using the fault library.
+func flattenChaosStudioFault(urn string, parameters map[string]string) (*FaultSchema, bool) {
+	for faultType, fault := range chaosStudioFaults {
+		if !strings.EqualFold(fault.Urn, urn) {
+			continue
+		}
+
+		output := FaultSchema{
+			Type: faultType,
+		}
+
+		switch faultType {
+		case faultTypeAksNetworkChaos:
+			if !chaosStudioFaultParametersAre(parameters, "jsonSpec") {
+				return nil, false
+			}
+			spec, ok := unmarshalChaosMeshSpec(parameters["jsonSpec"])
+			if !ok {
+				return nil, false
+			}
+			block := AksNetworkChaosFaultSchema{
+				Action:          spec.Action,
+				Direction:       spec.Direction,
+				ExternalTargets: spec.ExternalTargets,
+				LabelSelectors:  spec.Selector.LabelSelectors,
+				Mode:            spec.Mode,
+				Namespaces:      spec.Selector.Namespaces,
+				Value:           spec.Value,
+			}
+			if v := spec.Delay; v != nil {
+				block.Latency = v.Latency
+				block.Jitter = v.Jitter
+				block.Correlation = v.Correlation
+			}
+			if v := spec.Corrupt; v != nil {
+				block.Percentage = v.Corrupt
+				block.Correlation = v.Correlation
+			}
+			if v := spec.Duplicate; v != nil {
+				block.Percentage = v.Duplicate
+				block.Correlation = v.Correlation
+			}
+			if v := spec.Loss; v != nil {
+				block.Percentage = v.Loss
+				block.Correlation = v.Correlation
+			}
+			output.AksNetworkChaos = []AksNetworkChaosFaultSchema{block}
+
+		case faultTypeAksPodChaos:
+			if !chaosStudioFaultParametersAre(parameters, "jsonSpec") {
+				return nil, false
+			}
+			spec, ok := unmarshalChaosMeshSpec(parameters["jsonSpec"])
+			if !ok || spec.Direction != "" || len(spec.ExternalTargets) > 0 || spec.Delay != nil || spec.Corrupt != nil || spec.Duplicate != nil || spec.Loss != nil {
+				return nil, false
+			}
+			output.AksPodChaos = []AksPodChaosFaultSchema{
+				{
+					Action:         spec.Action,
+					ContainerNames: spec.ContainerNames,
+					LabelSelectors: spec.Selector.LabelSelectors,
+					Mode:           spec.Mode,
+					Namespaces:     spec.Selector.Namespaces,
+					Value:          spec.Value,
+				},
+			}
+
+		case faultTypeCosmosDbFailover:
+			if !chaosStudioFaultParametersAre(parameters, "readRegion") {
+				return nil, false
+			}
+			output.CosmosDbFailover = []CosmosDbFailoverFaultSchema{
+				{
+					ReadRegion: parameters["readRegion"],
+				},
+			}
+
+		case faultTypeKeyVaultDenyAccess:
+			if !chaosStudioFaultParametersAre(parameters) {
+				return nil, false
+			}
+
+		case faultTypeNetworkSecurityGroupRule:
+			if !chaosStudioFaultParametersAre(parameters, "name", "action", "direction", "priority", "protocol", "flushConnection", "destinationAddresses", "destinationPortRanges", "sourceAddresses", "sourcePortRanges") {
+				return nil, false
+			}
+			priority, err := strconv.ParseInt(parameters["priority"], 10, 64)
+			if err != nil {
+				return nil, false
+			}
+			flushConnection, err := strconv.ParseBool(parameters["flushConnection"])
+			if err != nil {
+				return nil, false
+			}
+			rule := NetworkSecurityGroupRuleFaultSchema{
+				Action:                 parameters["action"],
+				Direction:              parameters["direction"],
+				FlushConnectionEnabled: flushConnection,
+				Name:                   parameters["name"],
+				Priority:               priority,
+				Protocol:               parameters["protocol"],
+			}
+			lists := map[string]*[]string{
+				"destinationAddresses":  &rule.DestinationAddresses,
+				"destinationPortRanges": &rule.DestinationPortRanges,
+				"sourceAddresses":       &rule.SourceAddresses,
+				"sourcePortRanges":      &rule.SourcePortRanges,
+			}
+			for key, value := range lists {
+				if err := json.Unmarshal([]byte(parameters[key]), value); err != nil {
+					return nil, false
+				}
+			}
+			output.NetworkSecurityGroupRule = []NetworkSecurityGroupRuleFaultSchema{rule}
+
+		case faultTypeServiceBusChangeQueueState:
+			if !chaosStudioFaultParametersAre(parameters, "desiredState", "queues") {
+				return nil, false
+			}
+			queues := make([]string, 0)
+			if err := json.Unmarshal([]byte(parameters["queues"]), &queues); err != nil {
+				return nil, false
+			}
+			output.ServiceBusChangeQueueState = []ServiceBusChangeQueueStateFaultSchema{
+				{
+					DesiredState: parameters["desiredState"],
+					Queues:       queues,
+				},
+			}
+
+		case faultTypeVirtualMachineShutdown:
+			if !chaosStudioFaultParametersAre(parameters, "abruptShutdown") {
+				return nil, false
+			}
+			abruptShutdown, err := strconv.ParseBool(parameters["abruptShutdown"])
+			if err != nil {
+				return nil, false
+			}
+			output.VirtualMachineShutdown = []VirtualMachineShutdownFaultSchema{
+				{
+					AbruptShutdownEnabled: abruptShutdown,
+				},
+			}
+		}
+
+		return &output, true
+	}
+
+	return nil, false
+}
+
+// chaosStudioFaultParametersAre returns whether the parameters contain exactly the specified keys
+func chaosStudioFaultParametersAre(parameters map[string]string, keys ...string) bool {
+	if len(parameters) != len(keys) {
+		return false
+	}
+
+	for _, key := range keys {
+		if _, ok := parameters[key]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// unmarshalChaosMeshSpec parses the `jsonSpec` parameter, the bool returned is false when the specification contains
+// fields which can't be configured using the `aks_pod_chaos` and `aks_network_chaos` blocks
+func unmarshalChaosMeshSpec(input string) (*chaosMeshSpec, bool) {
+	decoder := json.NewDecoder(bytes.NewBufferString(input))
+	decoder.DisallowUnknownFields()
+
+	var spec chaosMeshSpec
+	if err := decoder.Decode(&spec); err != nil {
+		return nil, false
+	}
+
+	return &spec, true
+}
+
+// validateChaosStudioExperimentFaults validates the actions configured using a `fault` block, so that any issues are
+// surfaced during the plan rather than when the experiment is run. Values which aren't known yet are skipped.
+func validateChaosStudioExperimentFaults(input ChaosStudioExperimentResourceSchema) error {
+	selectorTargetTypes := make(map[string][]string)
+	for _, selector := range input.Selectors {
+		targetTypes := make([]string, 0)
+		for _, v := range selector.TargetIds {
+			targetId, err := commonids.ParseChaosStudioTargetID(v)
+			if err != nil {
+				continue
+			}
+			targetTypes = append(targetTypes, targetId.TargetName)
+		}
+		selectorTargetTypes[selector.Name] = targetTypes
+	}
+
+	for _, step := range input.Steps {
+		for _, branch := range step.Branch {
+			for i, action := range branch.Actions {
+				if len(action.Fault) == 0 {
+					continue
+				}
+
+				fault, ok := chaosStudioFaults[action.Fault[0].Type]
+				if !ok {
+					continue
+				}
+
+				if err := validateChaosStudioFaultAction(action, fault, selectorTargetTypes); err != nil {
+					return fmt.Errorf("action %d of the branch %q in the step %q: %+v", i, branch.Name, step.Name, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateChaosStudioFaultAction(action ActionSchema, fault chaosStudioFault, selectorTargetTypes map[string][]string) error {
+	faultType := action.Fault[0].Type
+
+	if action.Urn != "" || len(action.Parameters) > 0 {
+		return fmt.Errorf("`urn` and `parameters` cannot be specified when a `fault` block is specified")
+	}
+
+	if action.ActionType != "" && action.ActionType != fault.ActionType {
+		return fmt.Errorf("`action_type` must be `%s` for faults of type `%s`", fault.ActionType, faultType)
+	}
+
+	if err := validateChaosStudioFaultBlocks(action.Fault[0], fault); err != nil {
+		return err
+	}
+
+	if action.SelectorName == "" {
+		return nil
+	}
+
+	targetTypes, ok := selectorTargetTypes[action.SelectorName]
+	if !ok {
+		// the name of a selector may not be known yet
+		if _, unknown := selectorTargetTypes[""]; !unknown {
+			return fmt.Errorf("no selector named %q was found", action.SelectorName)
+		}
+		return nil
+	}
+
+	for _, targetType := range targetTypes {
+		if !strings.EqualFold(targetType, fault.TargetType) {
+			return fmt.Errorf("faults of type `%s` require targets of type %q but the selector %q contains a target of type %q", faultType, fault.TargetType, action.SelectorName, targetType)
+		}
+	}
+
+	return nil
+}
+
+// enableChaosStudioFaults enables the Chaos Studio Targets and Capabilities required by the actions configured using a
+// `fault` block, for each of the resources referenced by the selector of the action.
+type chaosStudioFaultCapability struct {
+	TargetId commonids.ChaosStudioTargetId
+	Fault    chaosStudioFault
+}
+
+// requiredChaosStudioFaultCapabilities returns the Targets and Capabilities which need to be enabled on the
+// resources referenced by the `selectors` for the actions configured using a `fault` block to be run
+func requiredChaosStudioFaultCapabilities(input ChaosStudioExperimentResourceSchema) ([]chaosStudioFaultCapability, error) {
+	selectorTargetIds := make(map[string][]string)
+	for _, selector := range input.Selectors {
+		selectorTargetIds[selector.Name] = selector.TargetIds
+	}
+
+	result := make([]chaosStudioFaultCapability, 0)
+	seen := make(map[string]bool)
+	for _, step := range input.Steps {
+		for _, branch := range step.Branch {
+			for _, action := range branch.Actions {
+				if len(action.Fault) == 0 {
+					continue
+				}
+
+				fault, ok := chaosStudioFaults[action.Fault[0].Type]
+				if !ok {
+					return nil, fmt.Errorf("unsupported fault type %q", action.Fault[0].Type)
+				}
+
+				for _, v := range selectorTargetIds[action.SelectorName] {
+					targetId, err := commonids.ParseChaosStudioTargetID(v)
+					if err != nil {
+						return nil, err
+					}
+
+					capabilityId := commonids.NewChaosStudioCapabilityID(targetId.Scope, targetId.TargetName, fault.CapabilityType)
+					if seen[capabilityId.ID()] {
+						continue
+					}
+					seen[capabilityId.ID()] = true
+
+					result = append(result, chaosStudioFaultCapability{
+						TargetId: *targetId,
+						Fault:    fault,
+					})
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func enableChaosStudioFaults(ctx context.Context, client *clients.Client, input ChaosStudioExperimentResourceSchema) error {
+	required, err := requiredChaosStudioFaultCapabilities(input)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range required {
+		if err := enableChaosStudioFault(ctx, client, v.TargetId, v.Fault); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateChaosStudioFaultsEnabled checks that the Targets and Capabilities required by the actions configured
+// using a `fault` block exist, so that a missing one is reported when applying rather than when the experiment is run
+func validateChaosStudioFaultsEnabled(ctx context.Context, client *clients.Client, input ChaosStudioExperimentResourceSchema) error {
+	targetsClient := client.ChaosStudio.V20231101.Targets
+	capabilitiesClient := client.ChaosStudio.V20231101.Capabilities
+
+	required, err := requiredChaosStudioFaultCapabilities(input)
+	if err != nil {
+		return err
+	}
+
+	missing := make([]string, 0)
+	missingTargets := make(map[string]bool)
+	for _, v := range required {
+		if missingTargets[v.TargetId.ID()] {
+			continue
+		}
+
+		existingTarget, err := targetsClient.Get(ctx, v.TargetId)
+		if err != nil {
+			if !response.WasNotFound(existingTarget.HttpResponse) {
+				return fmt.Errorf("retrieving %s: %+v", v.TargetId, err)
+			}
+			missingTargets[v.TargetId.ID()] = true
+			missing = append(missing, v.TargetId.String())
+			continue
+		}
+
+		capabilityId := commonids.NewChaosStudioCapabilityID(v.TargetId.Scope, v.TargetId.TargetName, v.Fault.CapabilityType)
+		existingCapability, err := capabilitiesClient.Get(ctx, capabilityId)
+		if err != nil {
+			if !response.WasNotFound(existingCapability.HttpResponse) {
+				return fmt.Errorf("retrieving %s: %+v", capabilityId, err)
+			}
+			missing = append(missing, capabilityId.String())
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("the following are required by the actions configured using a `fault` block but do not exist:\n\n* %s\n\nthese can be managed using the `azurerm_chaos_studio_target` and `azurerm_chaos_studio_capability` resources, or enabled outside of Terraform by setting `enable_fault_targets` to `true`", strings.Join(missing, "\n* "))
+	}
+
+	return nil
+}
+
+func enableChaosStudioFault(ctx context.Context, client *clients.Client, targetId commonids.ChaosStudioTargetId, fault chaosStudioFault) error {
+	targetsClient := client.ChaosStudio.V20231101.Targets
+	capabilitiesClient := client.ChaosStudio.V20231101.Capabilities
+
+	targetLocation := ""
+	existingTarget, err := targetsClient.Get(ctx, targetId)
+	if err != nil {
+		if !response.WasNotFound(existingTarget.HttpResponse) {
+			return fmt.Errorf("retrieving %s: %+v", targetId, err)
+		}
+
+		// the Target has to be created in the same location as the resource
+		resource, err := client.Resource.LegacyResourcesClient.GetByID(ctx, targetId.Scope, fault.ResourceApiVersion)
+		if err != nil {
+			return fmt.Errorf("retrieving the location of the resource %q: %+v", targetId.Scope, err)
+		}
+		targetLocation = location.Normalize(pointer.From(resource.Location))
+		if targetLocation == "" {
+			return fmt.Errorf("retrieving the location of the resource %q: `location` was nil", targetId.Scope)
+		}
+
+		payload := targets.Target{
+			Location: pointer.To(targetLocation),
+			// The API only accepts requests with an empty body for Properties
+			Properties: pointer.To(struct{}{}),
+		}
+		if _, err := targetsClient.CreateOrUpdate(ctx, targetId, payload); err != nil {
+			return fmt.Errorf("enabling %s: %+v", targetId, err)
+		}
+	} else if model := existingTarget.Model; model != nil {
+		targetLocation = location.Normalize(pointer.From(model.Location))
+	}
+
+	if targetLocation == "" {
+		return fmt.Errorf("location for %s was nil", targetId)
+	}
+
+	capabilityId := commonids.NewChaosStudioCapabilityID(targetId.Scope, targetId.TargetName, fault.CapabilityType)
+	existingCapability, err := capabilitiesClient.Get(ctx, capabilityId)
+	if err == nil {
+		return nil
+	}
+	if !response.WasNotFound(existingCapability.HttpResponse) {
+		return fmt.Errorf("retrieving %s: %+v", capabilityId, err)
+	}
+
+	targetTypeId := capabilitytypes.NewTargetTypeID(client.Account.SubscriptionId, targetLocation, targetId.TargetName)
+	if err := validateChaosStudioCapabilityType(ctx, client.ChaosStudio.V20231101.CapabilityTypes, targetTypeId, fault.CapabilityType); err != nil {
+		return err
+	}
+
+	payload := capabilities.Capability{
+		// The API only accepts requests with an empty body for Properties
+		Properties: pointer.To(capabilities.CapabilityProperties{}),
+	}
+	if _, err := capabilitiesClient.CreateOrUpdate(ctx, capabilityId, payload); err != nil {
+		return fmt.Errorf("enabling %s: %+v", capabilityId, err)
+	}
+
+	return nil
+}
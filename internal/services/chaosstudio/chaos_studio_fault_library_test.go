@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chaosstudio
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestChaosStudioFaultRoundTrip(t *testing.T) {
+	cases := []FaultSchema{
+		{
+			Type: faultTypeAksNetworkChaos,
+			AksNetworkChaos: []AksNetworkChaosFaultSchema{
+				{
+					Action:          "delay",
+					Correlation:     "100",
+					Direction:       "to",
+					ExternalTargets: []string{"www.example.com"},
+					Jitter:          "10ms",
+					LabelSelectors:  map[string]string{"app": "web"},
+					Latency:         "200ms",
+					Mode:            "fixed-percent",
+					Namespaces:      []string{"default"},
+					Value:           "50",
+				},
+			},
+		},
+		{
+			Type: faultTypeAksNetworkChaos,
+			AksNetworkChaos: []AksNetworkChaosFaultSchema{
+				{
+					Action:     "loss",
+					Mode:       "all",
+					Namespaces: []string{"default"},
+					Percentage: "25",
+				},
+			},
+		},
+		{
+			Type: faultTypeAksPodChaos,
+			AksPodChaos: []AksPodChaosFaultSchema{
+				{
+					Action:         "container-kill",
+					ContainerNames: []string{"web"},
+					Mode:           "one",
+					Namespaces:     []string{"default", "other"},
+				},
+			},
+		},
+		{
+			Type: faultTypeCosmosDbFailover,
+			CosmosDbFailover: []CosmosDbFailoverFaultSchema{
+				{
+					ReadRegion: "West Europe",
+				},
+			},
+		},
+		{
+			Type: faultTypeKeyVaultDenyAccess,
+		},
+		{
+			Type: faultTypeNetworkSecurityGroupRule,
+			NetworkSecurityGroupRule: []NetworkSecurityGroupRuleFaultSchema{
+				{
+					Action:                 "Deny",
+					DestinationAddresses:   []string{"*"},
+					DestinationPortRanges:  []string{"80", "443"},
+					Direction:              "Outbound",
+					FlushConnectionEnabled: true,
+					Name:                   "deny-web",
+					Priority:               100,
+					Protocol:               "Tcp",
+					SourceAddresses:        []string{"10.0.0.0/16"},
+					SourcePortRanges:       []string{"*"},
+				},
+			},
+		},
+		{
+			Type: faultTypeServiceBusChangeQueueState,
+			ServiceBusChangeQueueState: []ServiceBusChangeQueueStateFaultSchema{
+				{
+					DesiredState: "Disabled",
+					Queues:       []string{"orders"},
+				},
+			},
+		},
+		{
+			Type: faultTypeVirtualMachineShutdown,
+			VirtualMachineShutdown: []VirtualMachineShutdownFaultSchema{
+				{
+					AbruptShutdownEnabled: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Type)
+
+		urn, parameters, err := expandChaosStudioFault(tc)
+		if err != nil {
+			t.Fatalf("expanding: %+v", err)
+		}
+		if urn != chaosStudioFaults[tc.Type].Urn {
+			t.Fatalf("expected the URN %q but got %q", chaosStudioFaults[tc.Type].Urn, urn)
+		}
+
+		actual, ok := flattenChaosStudioFault(urn, parameters)
+		if !ok {
+			t.Fatalf("expected the parameters %+v to be flattened", parameters)
+		}
+		if !reflect.DeepEqual(*actual, tc) {
+			t.Fatalf("expected %+v but got %+v", tc, *actual)
+		}
+	}
+}
+
+func TestExpandChaosStudioFaultInvalid(t *testing.T) {
+	cases := map[string]FaultSchema{
+		"the `aks_pod_chaos` block must be specified": {
+			Type: faultTypeAksPodChaos,
+		},
+		"the `virtual_machine_shutdown` block cannot be specified": {
+			Type: faultTypeKeyVaultDenyAccess,
+			VirtualMachineShutdown: []VirtualMachineShutdownFaultSchema{
+				{},
+			},
+		},
+		"`latency` must be specified": {
+			Type: faultTypeAksNetworkChaos,
+			AksNetworkChaos: []AksNetworkChaosFaultSchema{
+				{
+					Action:     "delay",
+					Mode:       "all",
+					Namespaces: []string{"default"},
+				},
+			},
+		},
+		"`percentage` must be specified": {
+			Type: faultTypeAksNetworkChaos,
+			AksNetworkChaos: []AksNetworkChaosFaultSchema{
+				{
+					Action:     "corrupt",
+					Mode:       "all",
+					Namespaces: []string{"default"},
+				},
+			},
+		},
+		"`value` must be specified": {
+			Type: faultTypeAksPodChaos,
+			AksPodChaos: []AksPodChaosFaultSchema{
+				{
+					Action:     "pod-kill",
+					Mode:       "fixed",
+					Namespaces: []string{"default"},
+				},
+			},
+		},
+		"`container_names` must be specified": {
+			Type: faultTypeAksPodChaos,
+			AksPodChaos: []AksPodChaosFaultSchema{
+				{
+					Action:     "container-kill",
+					Mode:       "all",
+					Namespaces: []string{"default"},
+				},
+			},
+		},
+	}
+
+	for expected, input := range cases {
+		t.Logf("[DEBUG] Testing %q", expected)
+
+		_, _, err := expandChaosStudioFault(input)
+		if err == nil {
+			t.Fatalf("expected an error containing %q", expected)
+		}
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected an error containing %q but got %q", expected, err.Error())
+		}
+	}
+}
+
+func TestFlattenChaosStudioFaultUnsupported(t *testing.T) {
+	cases := []struct {
+		Urn        string
+		Parameters map[string]string
+	}{
+		{
+			Urn: "urn:csci:microsoft:virtualMachine:redeploy/1.0",
+		},
+		{
+			Urn: chaosStudioFaults[faultTypeVirtualMachineShutdown].Urn,
+			Parameters: map[string]string{
+				"abruptShutdown": "false",
+				"other":          "value",
+			},
+		},
+		{
+			Urn: chaosStudioFaults[faultTypeAksPodChaos].Urn,
+			Parameters: map[string]string{
+				"jsonSpec": `{"action":"pod-kill","mode":"all","selector":{"namespaces":["default"]},"gracePeriod":0}`,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Urn)
+
+		if v, ok := flattenChaosStudioFault(tc.Urn, tc.Parameters); ok {
+			t.Fatalf("expected the URN and parameters not to be flattened but got %+v", *v)
+		}
+	}
+}
+
+func TestValidateChaosStudioExperimentFaults(t *testing.T) {
+	vmTargetId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Chaos/targets/Microsoft-VirtualMachine"
+	shutdown := []FaultSchema{
+		{
+			Type: faultTypeVirtualMachineShutdown,
+			VirtualMachineShutdown: []VirtualMachineShutdownFaultSchema{
+				{},
+			},
+		},
+	}
+
+	cases := []struct {
+		Action        ActionSchema
+		Selectors     []SelectorSchema
+		ExpectedError string
+	}{
+		{
+			Action: ActionSchema{
+				ActionType:   continuousActionType,
+				SelectorName: "Selector1",
+				Duration:     "PT10M",
+				Fault:        shutdown,
+			},
+		},
+		{
+			// the selector name isn't known yet
+			Action: ActionSchema{
+				ActionType:   continuousActionType,
+				SelectorName: "Selector2",
+				Fault:        shutdown,
+			},
+			Selectors: []SelectorSchema{
+				{
+					Name: "",
+				},
+			},
+		},
+		{
+			Action: ActionSchema{
+				ActionType:   discreteActionType,
+				SelectorName: "Selector1",
+				Fault:        shutdown,
+			},
+			ExpectedError: "`action_type` must be `continuous`",
+		},
+		{
+			Action: ActionSchema{
+				ActionType:   continuousActionType,
+				SelectorName: "Selector1",
+				Urn:          "urn:csci:microsoft:virtualMachine:shutdown/1.0",
+				Fault:        shutdown,
+			},
+			ExpectedError: "`urn` and `parameters` cannot be specified",
+		},
+		{
+			Action: ActionSchema{
+				ActionType:   continuousActionType,
+				SelectorName: "Selector2",
+				Fault:        shutdown,
+			},
+			ExpectedError: "no selector named \"Selector2\" was found",
+		},
+		{
+			Action: ActionSchema{
+				ActionType:   continuousActionType,
+				SelectorName: "Selector1",
+				Fault: []FaultSchema{
+					{
+						Type: faultTypeKeyVaultDenyAccess,
+					},
+				},
+			},
+			ExpectedError: "require targets of type \"Microsoft-KeyVault\"",
+		},
+	}
+
+	for _, tc := range cases {
+		selectors := append([]SelectorSchema{
+			{
+				Name:      "Selector1",
+				TargetIds: []string{vmTargetId},
+			},
+		}, tc.Selectors...)
+
+		input := ChaosStudioExperimentResourceSchema{
+			Selectors: selectors,
+			Steps: []StepSchema{
+				{
+					Name: "step1",
+					Branch: []BranchSchema{
+						{
+							Name:    "branch1",
+							Actions: []ActionSchema{tc.Action},
+						},
+					},
+				},
+			},
+		}
+
+		err := validateChaosStudioExperimentFaults(input)
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error containing %q", tc.ExpectedError)
+		}
+		if !strings.Contains(err.Error(), tc.ExpectedError) {
+			t.Fatalf("expected an error containing %q but got %q", tc.ExpectedError, err.Error())
+		}
+	}
+}
+
+func TestRequiredChaosStudioFaultCapabilities(t *testing.T) {
+	vm1TargetId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Chaos/targets/Microsoft-VirtualMachine"
+	vm2TargetId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm2/providers/Microsoft.Chaos/targets/Microsoft-VirtualMachine"
+	shutdown := ActionSchema{
+		ActionType:   continuousActionType,
+		SelectorName: "Selector1",
+		Duration:     "PT10M",
+		Fault: []FaultSchema{
+			{
+				Type: faultTypeVirtualMachineShutdown,
+				VirtualMachineShutdown: []VirtualMachineShutdownFaultSchema{
+					{},
+				},
+			},
+		},
+	}
+
+	input := ChaosStudioExperimentResourceSchema{
+		Selectors: []SelectorSchema{
+			{
+				Name:      "Selector1",
+				TargetIds: []string{vm1TargetId, vm2TargetId},
+			},
+		},
+		Steps: []StepSchema{
+			{
+				Name: "step1",
+				Branch: []BranchSchema{
+					{
+						Name: "branch1",
+						Actions: []ActionSchema{
+							shutdown,
+							{
+								ActionType:   delayActionType,
+								SelectorName: "Selector1",
+								Duration:     "PT1M",
+							},
+							// the same fault against the same targets should only be required once
+							shutdown,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := requiredChaosStudioFaultCapabilities(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	expected := []string{vm1TargetId, vm2TargetId}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d capabilities but got %d", len(expected), len(actual))
+	}
+	for i, v := range actual {
+		if !strings.EqualFold(v.TargetId.ID(), expected[i]) {
+			t.Fatalf("expected target %q but got %q", expected[i], v.TargetId.ID())
+		}
+		if v.Fault.CapabilityType != chaosStudioFaults[faultTypeVirtualMachineShutdown].CapabilityType {
+			t.Fatalf("expected capability type %q but got %q", chaosStudioFaults[faultTypeVirtualMachineShutdown].CapabilityType, v.Fault.CapabilityType)
+		}
+	}
+}
@@ -0,0 +1,505 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ComputeSkusDataSource struct{}
+
+var _ sdk.DataSource = ComputeSkusDataSource{}
+
+type ComputeSkusDataSourceModel struct {
+	Location                       string            `tfschema:"location"`
+	ResourceType                   string            `tfschema:"resource_type"`
+	MinimumVCPUs                   int64             `tfschema:"min_vcpus"`
+	MaximumVCPUs                   int64             `tfschema:"max_vcpus"`
+	MinimumMemoryInGB              float64           `tfschema:"min_memory_in_gb"`
+	MaximumMemoryInGB              float64           `tfschema:"max_memory_in_gb"`
+	AcceleratedNetworkingSupported bool              `tfschema:"accelerated_networking_supported"`
+	PremiumIOSupported             bool              `tfschema:"premium_io_supported"`
+	Zones                          []string          `tfschema:"zones"`
+	IncludeRestricted              bool              `tfschema:"include_restricted"`
+	Skus                           []ComputeSkuModel `tfschema:"skus"`
+}
+
+type ComputeSkuModel struct {
+	Name                           string                  `tfschema:"name"`
+	ResourceType                   string                  `tfschema:"resource_type"`
+	Tier                           string                  `tfschema:"tier"`
+	Size                           string                  `tfschema:"size"`
+	Family                         string                  `tfschema:"family"`
+	VCPUs                          int64                   `tfschema:"vcpus"`
+	VCPUsAvailable                 int64                   `tfschema:"vcpus_available"`
+	MemoryInGB                     float64                 `tfschema:"memory_in_gb"`
+	GPUs                           int64                   `tfschema:"gpus"`
+	MaxDataDiskCount               int64                   `tfschema:"max_data_disk_count"`
+	MaxNetworkInterfaces           int64                   `tfschema:"max_network_interfaces"`
+	AcceleratedNetworkingSupported bool                    `tfschema:"accelerated_networking_supported"`
+	PremiumIOSupported             bool                    `tfschema:"premium_io_supported"`
+	EncryptionAtHostSupported      bool                    `tfschema:"encryption_at_host_supported"`
+	EphemeralOSDiskSupported       bool                    `tfschema:"ephemeral_os_disk_supported"`
+	LowPriorityCapable             bool                    `tfschema:"low_priority_capable"`
+	CPUArchitectureType            string                  `tfschema:"cpu_architecture_type"`
+	HyperVGenerations              []string                `tfschema:"hyper_v_generations"`
+	Zones                          []string                `tfschema:"zones"`
+	AvailableZones                 []string                `tfschema:"available_zones"`
+	Restricted                     bool                    `tfschema:"restricted"`
+	Restrictions                   []ComputeSkuRestriction `tfschema:"restrictions"`
+	Capabilities                   map[string]string       `tfschema:"capabilities"`
+}
+
+type ComputeSkuRestriction struct {
+	Type       string   `tfschema:"type"`
+	ReasonCode string   `tfschema:"reason_code"`
+	Zones      []string `tfschema:"zones"`
+}
+
+func (ComputeSkusDataSource) ModelObject() interface{} {
+	return &ComputeSkusDataSourceModel{}
+}
+
+func (ComputeSkusDataSource) ResourceType() string {
+	return "azurerm_compute_skus"
+}
+
+func (ComputeSkusDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"resource_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "virtualMachines",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"min_vcpus": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"max_vcpus": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"min_memory_in_gb": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+
+		"max_memory_in_gb": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0),
+		},
+
+		"accelerated_networking_supported": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"premium_io_supported": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"zones": commonschema.ZonesMultipleOptional(),
+
+		"include_restricted": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (ComputeSkusDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"skus": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"resource_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tier": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"size": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"family": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"vcpus": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"vcpus_available": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"memory_in_gb": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"gpus": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"max_data_disk_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"max_network_interfaces": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"accelerated_networking_supported": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"premium_io_supported": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"encryption_at_host_supported": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"ephemeral_os_disk_supported": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"low_priority_capable": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"cpu_architecture_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"hyper_v_generations": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"zones": commonschema.ZonesMultipleComputed(),
+
+					"available_zones": commonschema.ZonesMultipleComputed(),
+
+					"restricted": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"restrictions": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"reason_code": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"zones": commonschema.ZonesMultipleComputed(),
+							},
+						},
+					},
+
+					"capabilities": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ComputeSkusDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.SkusClient
+			subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+
+			var state ComputeSkusDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locationName := location.Normalize(state.Location)
+
+			opts := skus.DefaultResourceSkusListOperationOptions()
+			// by default this API returns every SKU in every Location, so we filter to the Location being requested
+			opts.Filter = pointer.To(fmt.Sprintf("location eq '%s'", locationName))
+			resp, err := client.ResourceSkusListComplete(ctx, subscriptionId, opts)
+			if err != nil {
+				return fmt.Errorf("listing Resource SKUs in %q for %s: %+v", locationName, subscriptionId, err)
+			}
+
+			state.Location = locationName
+			state.Skus = filterComputeSkus(resp.Items, state)
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/providers/Microsoft.Compute/locations/%s/resourceTypes/%s/skus", subscriptionId.ID(), locationName, state.ResourceType))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// filterComputeSkus flattens the Resource SKUs of the requested Resource Type and returns those matching the
+// filters specified in `filter`, sorted by name.
+func filterComputeSkus(input []skus.ResourceSku, filter ComputeSkusDataSourceModel) []ComputeSkuModel {
+	output := make([]ComputeSkuModel, 0)
+
+	for _, item := range input {
+		if !strings.EqualFold(pointer.From(item.ResourceType), filter.ResourceType) {
+			continue
+		}
+
+		sku := flattenComputeSku(item, filter.Location)
+
+		if filter.MinimumVCPUs > 0 && sku.VCPUs < filter.MinimumVCPUs {
+			continue
+		}
+		if filter.MaximumVCPUs > 0 && sku.VCPUs > filter.MaximumVCPUs {
+			continue
+		}
+		if filter.MinimumMemoryInGB > 0 && sku.MemoryInGB < filter.MinimumMemoryInGB {
+			continue
+		}
+		if filter.MaximumMemoryInGB > 0 && sku.MemoryInGB > filter.MaximumMemoryInGB {
+			continue
+		}
+		if filter.AcceleratedNetworkingSupported && !sku.AcceleratedNetworkingSupported {
+			continue
+		}
+		if filter.PremiumIOSupported && !sku.PremiumIOSupported {
+			continue
+		}
+		if !containsAllComputeSkuZones(sku.Zones, filter.Zones) {
+			continue
+		}
+
+		if !filter.IncludeRestricted && (sku.Restricted || !containsAllComputeSkuZones(sku.AvailableZones, filter.Zones)) {
+			continue
+		}
+
+		output = append(output, sku)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].Name < output[j].Name
+	})
+
+	return output
+}
+
+func flattenComputeSku(input skus.ResourceSku, locationName string) ComputeSkuModel {
+	output := ComputeSkuModel{
+		Name:              pointer.From(input.Name),
+		ResourceType:      pointer.From(input.ResourceType),
+		Tier:              pointer.From(input.Tier),
+		Size:              pointer.From(input.Size),
+		Family:            pointer.From(input.Family),
+		HyperVGenerations: make([]string, 0),
+		Zones:             make([]string, 0),
+		AvailableZones:    make([]string, 0),
+		Restrictions:      make([]ComputeSkuRestriction, 0),
+		Capabilities:      make(map[string]string),
+	}
+
+	if input.Capabilities != nil {
+		for _, capability := range *input.Capabilities {
+			name := pointer.From(capability.Name)
+			value := pointer.From(capability.Value)
+			if name == "" {
+				continue
+			}
+			output.Capabilities[name] = value
+
+			switch strings.ToLower(name) {
+			case "vcpus":
+				output.VCPUs = parseComputeSkuCapabilityInt(value)
+			case "vcpusavailable":
+				output.VCPUsAvailable = parseComputeSkuCapabilityInt(value)
+			case "memorygb":
+				output.MemoryInGB = parseComputeSkuCapabilityFloat(value)
+			case "gpus":
+				output.GPUs = parseComputeSkuCapabilityInt(value)
+			case "maxdatadiskcount":
+				output.MaxDataDiskCount = parseComputeSkuCapabilityInt(value)
+			case "maxnetworkinterfaces":
+				output.MaxNetworkInterfaces = parseComputeSkuCapabilityInt(value)
+			case "acceleratednetworkingenabled":
+				output.AcceleratedNetworkingSupported = strings.EqualFold(value, "True")
+			case "premiumio":
+				output.PremiumIOSupported = strings.EqualFold(value, "True")
+			case "encryptionathostsupported":
+				output.EncryptionAtHostSupported = strings.EqualFold(value, "True")
+			case "ephemeralosdisksupported":
+				output.EphemeralOSDiskSupported = strings.EqualFold(value, "True")
+			case "lowprioritycapable":
+				output.LowPriorityCapable = strings.EqualFold(value, "True")
+			case "cpuarchitecturetype":
+				output.CPUArchitectureType = value
+			case "hypervgenerations":
+				for _, generation := range strings.Split(value, ",") {
+					if generation = strings.TrimSpace(generation); generation != "" {
+						output.HyperVGenerations = append(output.HyperVGenerations, generation)
+					}
+				}
+			}
+		}
+	}
+
+	if input.LocationInfo != nil {
+		for _, info := range *input.LocationInfo {
+			if location.Normalize(pointer.From(info.Location)) == locationName && info.Zones != nil {
+				output.Zones = append(output.Zones, *info.Zones...)
+			}
+		}
+	}
+	sort.Strings(output.Zones)
+
+	if input.Restrictions != nil {
+		for _, restriction := range *input.Restrictions {
+			zones := make([]string, 0)
+			if restriction.RestrictionInfo != nil && restriction.RestrictionInfo.Zones != nil {
+				zones = append(zones, *restriction.RestrictionInfo.Zones...)
+			}
+			sort.Strings(zones)
+
+			restrictionType := pointer.From(restriction.Type)
+			if restrictionType == skus.ResourceSkuRestrictionsTypeLocation {
+				output.Restricted = true
+			}
+
+			output.Restrictions = append(output.Restrictions, ComputeSkuRestriction{
+				Type:       string(restrictionType),
+				ReasonCode: string(pointer.From(restriction.ReasonCode)),
+				Zones:      zones,
+			})
+		}
+	}
+
+	// the zones which can actually be used are those supported in this Location which aren't restricted for
+	// this Subscription - when the SKU is restricted within the Location as a whole, none of them can be used
+	if !output.Restricted {
+		restrictedZones := make([]string, 0)
+		for _, restriction := range output.Restrictions {
+			if strings.EqualFold(restriction.Type, string(skus.ResourceSkuRestrictionsTypeZone)) {
+				restrictedZones = append(restrictedZones, restriction.Zones...)
+			}
+		}
+
+		for _, zone := range output.Zones {
+			if !containsAnyComputeSkuZone(restrictedZones, []string{zone}) {
+				output.AvailableZones = append(output.AvailableZones, zone)
+			}
+		}
+	}
+
+	return output
+}
+
+func parseComputeSkuCapabilityInt(input string) int64 {
+	v, err := strconv.ParseInt(input, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+func parseComputeSkuCapabilityFloat(input string) float64 {
+	v, err := strconv.ParseFloat(input, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// containsAllComputeSkuZones returns whether every zone in `required` is present within `available`.
+func containsAllComputeSkuZones(available []string, required []string) bool {
+	for _, zone := range required {
+		if !containsAnyComputeSkuZone(available, []string{zone}) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsAnyComputeSkuZone returns whether any zone in `zones` is present within `input`.
+func containsAnyComputeSkuZone(input []string, zones []string) bool {
+	for _, zone := range zones {
+		for _, v := range input {
+			if strings.EqualFold(v, zone) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ComputeSkusDataSource struct{}
+
+func TestAccDataSourceComputeSkus_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_compute_skus", "test")
+	r := ComputeSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.#").Exists(),
+				check.That(data.ResourceName).Key("skus.0.name").Exists(),
+				check.That(data.ResourceName).Key("skus.0.resource_type").HasValue("virtualMachines"),
+				check.That(data.ResourceName).Key("skus.0.restricted").HasValue("false"),
+				check.That(data.ResourceName).Key("skus.0.available_zones.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceComputeSkus_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_compute_skus", "test")
+	r := ComputeSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.0.vcpus").HasValue("2"),
+				check.That(data.ResourceName).Key("skus.0.accelerated_networking_supported").HasValue("true"),
+				check.That(data.ResourceName).Key("skus.0.premium_io_supported").HasValue("true"),
+				check.That(data.ResourceName).Key("skus.0.memory_in_gb").Exists(),
+				check.That(data.ResourceName).Key("skus.0.capabilities.vCPUs").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (ComputeSkusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_compute_skus" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
+
+func (ComputeSkusDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_compute_skus" "test" {
+  location                         = "%s"
+  min_vcpus                        = 2
+  max_vcpus                        = 2
+  min_memory_in_gb                 = 4
+  accelerated_networking_supported = true
+  premium_io_supported             = true
+}
+`, data.Locations.Primary)
+}
@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+)
+
+func TestFilterComputeSkus(t *testing.T) {
+	available := []skus.ResourceSku{
+		{
+			Name:         pointer.To("Standard_F2s_v2"),
+			ResourceType: pointer.To("virtualMachines"),
+			Capabilities: &[]skus.ResourceSkuCapabilities{
+				{Name: pointer.To("vCPUs"), Value: pointer.To("2")},
+				{Name: pointer.To("MemoryGB"), Value: pointer.To("4")},
+				{Name: pointer.To("PremiumIO"), Value: pointer.To("True")},
+				{Name: pointer.To("AcceleratedNetworkingEnabled"), Value: pointer.To("True")},
+				{Name: pointer.To("HyperVGenerations"), Value: pointer.To("V1,V2")},
+			},
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("WestEurope"),
+					Zones:    pointer.To(zones.Schema{"3", "1", "2"}),
+				},
+			},
+		},
+		{
+			Name:         pointer.To("Standard_A1_v2"),
+			ResourceType: pointer.To("virtualMachines"),
+			Capabilities: &[]skus.ResourceSkuCapabilities{
+				{Name: pointer.To("vCPUs"), Value: pointer.To("1")},
+				{Name: pointer.To("MemoryGB"), Value: pointer.To("2")},
+				{Name: pointer.To("PremiumIO"), Value: pointer.To("False")},
+				{Name: pointer.To("AcceleratedNetworkingEnabled"), Value: pointer.To("False")},
+			},
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("westeurope"),
+					Zones:    pointer.To(zones.Schema{"1", "2"}),
+				},
+			},
+		},
+		{
+			Name:         pointer.To("Standard_D4s_v3"),
+			ResourceType: pointer.To("virtualMachines"),
+			Capabilities: &[]skus.ResourceSkuCapabilities{
+				{Name: pointer.To("vCPUs"), Value: pointer.To("4")},
+				{Name: pointer.To("MemoryGB"), Value: pointer.To("16")},
+				{Name: pointer.To("PremiumIO"), Value: pointer.To("True")},
+				{Name: pointer.To("AcceleratedNetworkingEnabled"), Value: pointer.To("True")},
+			},
+			LocationInfo: &[]skus.ResourceSkuLocationInfo{
+				{
+					Location: pointer.To("westeurope"),
+					Zones:    pointer.To(zones.Schema{"1", "2", "3"}),
+				},
+			},
+			Restrictions: &[]skus.ResourceSkuRestrictions{
+				{
+					Type:       pointer.To(skus.ResourceSkuRestrictionsTypeZone),
+					ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+					RestrictionInfo: &skus.ResourceSkuRestrictionInfo{
+						Zones: pointer.To(zones.Schema{"3"}),
+					},
+				},
+			},
+		},
+		{
+			Name:         pointer.To("Standard_M416ms_v2"),
+			ResourceType: pointer.To("virtualMachines"),
+			Capabilities: &[]skus.ResourceSkuCapabilities{
+				{Name: pointer.To("vCPUs"), Value: pointer.To("416")},
+				{Name: pointer.To("MemoryGB"), Value: pointer.To("11400")},
+			},
+			Restrictions: &[]skus.ResourceSkuRestrictions{
+				{
+					Type:       pointer.To(skus.ResourceSkuRestrictionsTypeLocation),
+					ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+					RestrictionInfo: &skus.ResourceSkuRestrictionInfo{
+						Locations: pointer.To([]string{"westeurope"}),
+					},
+				},
+			},
+		},
+		{
+			Name:         pointer.To("Premium_LRS"),
+			ResourceType: pointer.To("disks"),
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Filter   ComputeSkusDataSourceModel
+		Expected []string
+	}{
+		{
+			Name: "no filters",
+			Filter: ComputeSkusDataSourceModel{
+				ResourceType: "virtualMachines",
+			},
+			Expected: []string{"Standard_A1_v2", "Standard_D4s_v3", "Standard_F2s_v2"},
+		},
+		{
+			Name: "include restricted",
+			Filter: ComputeSkusDataSourceModel{
+				ResourceType:      "virtualMachines",
+				IncludeRestricted: true,
+			},
+			Expected: []string{"Standard_A1_v2", "Standard_D4s_v3", "Standard_F2s_v2", "Standard_M416ms_v2"},
+		},
+		{
+			Name: "resource type",
+			Filter: ComputeSkusDataSourceModel{
+				ResourceType: "Disks",
+			},
+			Expected: []string{"Premium_LRS"},
+		},
+		{
+			Name: "vcpus",
+			Filter: ComputeSkusDataSourceModel{
+				ResourceType: "virtualMachines",
+				MinimumVCPUs: 2,
+				MaximumVCPUs: 2,
+			},
+			Expected: []string{"Standard_F2s_v2"},
+		},
+		{
+			Name: "memory",
+			Filter: ComputeSkusDataSourceModel{
+				ResourceType:      "virtualMachines",
+				MinimumMemoryInGB: 3.5,
+			},
+			Expected: []string{"Standard_D4s_v3", "Standard_F2s_v2"},
+		},
+		{
+			Name: "capabilities",
+			Filter: ComputeSkusDataSourceModel{
+				ResourceType:                   "virtualMachines",
+				AcceleratedNetworkingSupported: true,
+				PremiumIOSupported:             true,
+				MaximumMemoryInGB:              8,
+			},
+			Expected: []string{"Standard_F2s_v2"},
+		},
+		{
+			Name: "zones",
+			Filter: ComputeSkusDataSourceModel{
+				ResourceType: "virtualMachines",
+				Zones:        []string{"3"},
+			},
+			Expected: []string{"Standard_F2s_v2"},
+		},
+		{
+			Name: "zones including restricted",
+			Filter: ComputeSkusDataSourceModel{
+				ResourceType:      "virtualMachines",
+				Zones:             []string{"2", "3"},
+				IncludeRestricted: true,
+			},
+			Expected: []string{"Standard_D4s_v3", "Standard_F2s_v2"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		tc.Filter.Location = "westeurope"
+		actual := make([]string, 0)
+		for _, sku := range filterComputeSkus(available, tc.Filter) {
+			actual = append(actual, sku.Name)
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenComputeSku_restrictedZones(t *testing.T) {
+	cases := []struct {
+		Name           string
+		Input          skus.ResourceSku
+		Zones          []string
+		AvailableZones []string
+	}{
+		{
+			Name: "zone restriction",
+			Input: skus.ResourceSku{
+				LocationInfo: &[]skus.ResourceSkuLocationInfo{
+					{
+						Location: pointer.To("westeurope"),
+						Zones:    pointer.To(zones.Schema{"1", "2", "3"}),
+					},
+				},
+				Restrictions: &[]skus.ResourceSkuRestrictions{
+					{
+						Type:       pointer.To(skus.ResourceSkuRestrictionsTypeZone),
+						ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+						RestrictionInfo: &skus.ResourceSkuRestrictionInfo{
+							Zones: pointer.To(zones.Schema{"3"}),
+						},
+					},
+				},
+			},
+			Zones:          []string{"1", "2", "3"},
+			AvailableZones: []string{"1", "2"},
+		},
+		{
+			Name: "location restriction",
+			Input: skus.ResourceSku{
+				LocationInfo: &[]skus.ResourceSkuLocationInfo{
+					{
+						Location: pointer.To("westeurope"),
+						Zones:    pointer.To(zones.Schema{"1", "2", "3"}),
+					},
+				},
+				Restrictions: &[]skus.ResourceSkuRestrictions{
+					{
+						Type:       pointer.To(skus.ResourceSkuRestrictionsTypeLocation),
+						ReasonCode: pointer.To(skus.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription),
+						RestrictionInfo: &skus.ResourceSkuRestrictionInfo{
+							Locations: pointer.To([]string{"westeurope"}),
+						},
+					},
+				},
+			},
+			Zones:          []string{"1", "2", "3"},
+			AvailableZones: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual := flattenComputeSku(tc.Input, "westeurope")
+		if !reflect.DeepEqual(actual.Zones, tc.Zones) {
+			t.Fatalf("expected zones %+v but got %+v", tc.Zones, actual.Zones)
+		}
+		if !reflect.DeepEqual(actual.AvailableZones, tc.AvailableZones) {
+			t.Fatalf("expected available zones %+v but got %+v", tc.AvailableZones, actual.AvailableZones)
+		}
+	}
+}
+
+func TestFlattenComputeSku(t *testing.T) {
+	input := skus.ResourceSku{
+		Name:         pointer.To("Standard_F2s_v2"),
+		ResourceType: pointer.To("virtualMachines"),
+		Tier:         pointer.To("Standard"),
+		Size:         pointer.To("F2s_v2"),
+		Family:       pointer.To("standardFSv2Family"),
+		Capabilities: &[]skus.ResourceSkuCapabilities{
+			{Name: pointer.To("vCPUs"), Value: pointer.To("2")},
+			{Name: pointer.To("MemoryGB"), Value: pointer.To("4")},
+			{Name: pointer.To("MaxDataDiskCount"), Value: pointer.To("4")},
+			{Name: pointer.To("PremiumIO"), Value: pointer.To("True")},
+			{Name: pointer.To("HyperVGenerations"), Value: pointer.To("V1,V2")},
+			{Name: pointer.To("CpuArchitectureType"), Value: pointer.To("x64")},
+		},
+		LocationInfo: &[]skus.ResourceSkuLocationInfo{
+			{
+				Location: pointer.To("westeurope"),
+				Zones:    pointer.To(zones.Schema{"2", "1"}),
+			},
+		},
+	}
+
+	expected := ComputeSkuModel{
+		Name:                "Standard_F2s_v2",
+		ResourceType:        "virtualMachines",
+		Tier:                "Standard",
+		Size:                "F2s_v2",
+		Family:              "standardFSv2Family",
+		VCPUs:               2,
+		MemoryInGB:          4,
+		MaxDataDiskCount:    4,
+		PremiumIOSupported:  true,
+		CPUArchitectureType: "x64",
+		HyperVGenerations:   []string{"V1", "V2"},
+		Zones:               []string{"1", "2"},
+		AvailableZones:      []string{"1", "2"},
+		Restrictions:        []ComputeSkuRestriction{},
+		Capabilities: map[string]string{
+			"vCPUs":               "2",
+			"MemoryGB":            "4",
+			"MaxDataDiskCount":    "4",
+			"PremiumIO":           "True",
+			"HyperVGenerations":   "V1,V2",
+			"CpuArchitectureType": "x64",
+		},
+	}
+
+	actual := flattenComputeSku(input, "westeurope")
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
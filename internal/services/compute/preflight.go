@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// virtualMachinePreflightValidation validates the Size, Location and Zone of a Linux/Windows Virtual Machine
+// during the plan, when the `preflight_validation` feature is enabled
+func virtualMachinePreflightValidation(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client) error {
+	if !preflight.ValuesKnown(d, "name", "resource_group_name", "location", "size", "zone") {
+		return nil
+	}
+
+	location := d.Get("location").(string)
+	size := d.Get("size").(string)
+	vmZones := make([]string, 0)
+	if v := d.Get("zone").(string); v != "" {
+		vmZones = append(vmZones, v)
+	}
+
+	if err := preflight.CheckVirtualMachineSize(ctx, client, location, size, vmZones); err != nil {
+		return err
+	}
+
+	resource := preflight.Resource{
+		Type:       "Microsoft.Compute/virtualMachines",
+		ApiVersion: "2024-03-01",
+		Name:       d.Get("name").(string),
+		Location:   location,
+		Zones:      vmZones,
+		Properties: map[string]interface{}{
+			"hardwareProfile": map[string]interface{}{
+				"vmSize": size,
+			},
+		},
+	}
+	if d.NewValueKnown("tags") {
+		resource.Tags = d.Get("tags").(map[string]interface{})
+	}
+
+	return preflight.ValidateResource(ctx, client, d.Get("resource_group_name").(string), resource)
+}
+
+// virtualMachineScaleSetPreflightValidation validates the SKU, Location and Zones of a Linux/Windows Virtual Machine
+// Scale Set during the plan, when the `preflight_validation` feature is enabled
+func virtualMachineScaleSetPreflightValidation(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client) error {
+	if !preflight.ValuesKnown(d, "name", "resource_group_name", "location", "sku", "instances", "zones") {
+		return nil
+	}
+
+	location := d.Get("location").(string)
+	sku := d.Get("sku").(string)
+	vmssZones := zones.ExpandUntyped(d.Get("zones").(*pluginsdk.Set).List())
+
+	if err := preflight.CheckVirtualMachineSize(ctx, client, location, sku, vmssZones); err != nil {
+		return err
+	}
+
+	resource := preflight.Resource{
+		Type:       "Microsoft.Compute/virtualMachineScaleSets",
+		ApiVersion: "2024-11-01",
+		Name:       d.Get("name").(string),
+		Location:   location,
+		Sku: map[string]interface{}{
+			"name":     sku,
+			"capacity": d.Get("instances").(int),
+		},
+		Zones: vmssZones,
+	}
+	if d.NewValueKnown("tags") {
+		resource.Tags = d.Get("tags").(map[string]interface{})
+	}
+
+	return preflight.ValidateResource(ctx, client, d.Get("resource_group_name").(string), resource)
+}
@@ -4,12 +4,18 @@
 package compute
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Registration struct{}
 
+var _ sdk.FrameworkTypedServiceRegistration = Registration{}
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Compute"
@@ -82,6 +88,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		ComputeSkusDataSource{},
 		OrchestratedVirtualMachineScaleSetDataSource{},
 	}
 }
@@ -99,3 +106,22 @@ func (r Registration) Resources() []sdk.Resource {
 		VirtualMachineScaleSetStandbyPoolResource{},
 	}
 }
+
+func (r Registration) FrameworkResources() []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func (r Registration) FrameworkDataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		NewVirtualMachinePowerAction,
+		NewVirtualMachineRunCommandAction,
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+const (
+	virtualMachinePowerActionDeallocate = "deallocate"
+	virtualMachinePowerActionPowerOff   = "power_off"
+	virtualMachinePowerActionRestart    = "restart"
+	virtualMachinePowerActionStart      = "start"
+)
+
+var _ sdk.Action = &VirtualMachinePowerAction{}
+
+func NewVirtualMachinePowerAction() action.Action {
+	return &VirtualMachinePowerAction{}
+}
+
+type VirtualMachinePowerAction struct {
+	sdk.ActionMetadata
+}
+
+type VirtualMachinePowerActionModel struct {
+	VirtualMachineId types.String `tfsdk:"virtual_machine_id"`
+	PowerAction      types.String `tfsdk:"power_action"`
+}
+
+func (a *VirtualMachinePowerAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "azurerm_virtual_machine_power"
+}
+
+func (a *VirtualMachinePowerAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.Defaults(req, resp)
+}
+
+func (a *VirtualMachinePowerAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Changes the power state of a Virtual Machine.",
+		Attributes: map[string]schema.Attribute{
+			"virtual_machine_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Virtual Machine.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: virtualmachines.ValidateVirtualMachineID,
+					},
+				},
+			},
+
+			"power_action": schema.StringAttribute{
+				Required:    true,
+				Description: "The power action to perform on the Virtual Machine. Possible values are `deallocate`, `power_off`, `restart` and `start`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						virtualMachinePowerActionDeallocate,
+						virtualMachinePowerActionPowerOff,
+						virtualMachinePowerActionRestart,
+						virtualMachinePowerActionStart,
+					),
+				},
+			},
+		},
+	}
+}
+
+func (a *VirtualMachinePowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	client := a.Client.Compute.VirtualMachinesClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*30)
+	defer cancel()
+
+	var data VirtualMachinePowerActionModel
+	if ok := a.DecodeInvoke(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := virtualmachines.ParseVirtualMachineID(data.VirtualMachineId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing `virtual_machine_id`", err)
+		return
+	}
+
+	powerAction := data.PowerAction.ValueString()
+	a.SendProgress(resp, fmt.Sprintf("performing %q on %s..", powerAction, id))
+
+	switch powerAction {
+	case virtualMachinePowerActionDeallocate:
+		err = client.DeallocateThenPoll(ctx, *id, virtualmachines.DefaultDeallocateOperationOptions())
+	case virtualMachinePowerActionPowerOff:
+		err = client.PowerOffThenPoll(ctx, *id, virtualmachines.DefaultPowerOffOperationOptions())
+	case virtualMachinePowerActionRestart:
+		err = client.RestartThenPoll(ctx, *id)
+	case virtualMachinePowerActionStart:
+		err = client.StartThenPoll(ctx, *id)
+	}
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("performing %q on %s", powerAction, id), err)
+		return
+	}
+
+	a.SendProgress(resp, fmt.Sprintf("completed %q on %s", powerAction, id))
+}
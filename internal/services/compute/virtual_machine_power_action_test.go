@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
+)
+
+type VirtualMachinePowerAction struct{}
+
+func TestAccVirtualMachinePowerAction_restart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_power", "test")
+	a := VirtualMachinePowerAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: a.restart(data),
+			},
+		},
+	})
+}
+
+func TestAccVirtualMachinePowerAction_deallocate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_power", "test")
+	a := VirtualMachinePowerAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: a.deallocate(data),
+			},
+		},
+	})
+}
+
+func TestVirtualMachinePowerAction_validation(t *testing.T) {
+	testData := []struct {
+		attribute string
+		value     string
+		valid     bool
+	}{
+		{
+			attribute: "virtual_machine_id",
+			value:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1",
+			valid:     true,
+		},
+		{
+			attribute: "virtual_machine_id",
+			value:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			valid:     false,
+		},
+		{
+			attribute: "power_action",
+			value:     "deallocate",
+			valid:     true,
+		},
+		{
+			attribute: "power_action",
+			value:     "power_off",
+			valid:     true,
+		},
+		{
+			attribute: "power_action",
+			value:     "restart",
+			valid:     true,
+		},
+		{
+			attribute: "power_action",
+			value:     "start",
+			valid:     true,
+		},
+		{
+			attribute: "power_action",
+			value:     "Restart",
+			valid:     false,
+		},
+		{
+			attribute: "power_action",
+			value:     "hibernate",
+			valid:     false,
+		},
+	}
+
+	s := VirtualMachinePowerAction{}.schema()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q = %q", v.attribute, v.value)
+
+		resp := validator.StringResponse{}
+		for _, f := range s.Attributes[v.attribute].(schema.StringAttribute).Validators {
+			f.ValidateString(context.TODO(), validator.StringRequest{
+				Path:        path.Root(v.attribute),
+				ConfigValue: types.StringValue(v.value),
+			}, &resp)
+		}
+
+		if resp.Diagnostics.HasError() == v.valid {
+			t.Fatalf("expected valid to be %t but got diagnostics %+v", v.valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestVirtualMachinePowerAction_invokeInvalidId(t *testing.T) {
+	ctx := context.TODO()
+	s := VirtualMachinePowerAction{}.schema()
+	req := action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: s,
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"virtual_machine_id": tftypes.NewValue(tftypes.String, "machine1"),
+				"power_action":       tftypes.NewValue(tftypes.String, "restart"),
+			}),
+		},
+	}
+
+	progress := make([]string, 0)
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+
+	a := compute.VirtualMachinePowerAction{
+		ActionMetadata: sdk.ActionMetadata{
+			Client: &clients.Client{
+				Compute: &client.Client{},
+			},
+		},
+	}
+	a.Invoke(ctx, req, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error diagnostic for an invalid `virtual_machine_id`")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "parsing `virtual_machine_id`" {
+		t.Fatalf("expected the summary to be %q but got %q", "parsing `virtual_machine_id`", summary)
+	}
+	if len(progress) != 0 {
+		t.Fatalf("expected no progress to be reported but got %+v", progress)
+	}
+}
+
+func (VirtualMachinePowerAction) schema() schema.Schema {
+	resp := action.SchemaResponse{}
+	compute.NewVirtualMachinePowerAction().Schema(context.TODO(), action.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func (VirtualMachinePowerAction) restart(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_virtual_machine_power" "test" {
+  config {
+    virtual_machine_id = azurerm_linux_virtual_machine.test.id
+    power_action       = "restart"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_linux_virtual_machine.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_virtual_machine_power.test]
+    }
+  }
+}
+`, LinuxVirtualMachineResource{}.authPassword(data))
+}
+
+func (VirtualMachinePowerAction) deallocate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_virtual_machine_power" "test" {
+  config {
+    virtual_machine_id = azurerm_linux_virtual_machine.test.id
+    power_action       = "deallocate"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_linux_virtual_machine.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_virtual_machine_power.test]
+    }
+  }
+}
+`, LinuxVirtualMachineResource{}.authPassword(data))
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+var _ sdk.Action = &VirtualMachineRunCommandAction{}
+
+func NewVirtualMachineRunCommandAction() action.Action {
+	return &VirtualMachineRunCommandAction{}
+}
+
+type VirtualMachineRunCommandAction struct {
+	sdk.ActionMetadata
+}
+
+type VirtualMachineRunCommandActionModel struct {
+	VirtualMachineId types.String `tfsdk:"virtual_machine_id"`
+	CommandId        types.String `tfsdk:"command_id"`
+	Script           types.List   `tfsdk:"script"`
+	Parameters       types.Map    `tfsdk:"parameters"`
+}
+
+func (a *VirtualMachineRunCommandAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "azurerm_virtual_machine_run_command"
+}
+
+func (a *VirtualMachineRunCommandAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.Defaults(req, resp)
+}
+
+func (a *VirtualMachineRunCommandAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a script once on a Virtual Machine using the Run Command feature.",
+		Attributes: map[string]schema.Attribute{
+			"virtual_machine_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Virtual Machine.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: virtualmachines.ValidateVirtualMachineID,
+					},
+				},
+			},
+
+			"command_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Run Command to use. Possible values are `RunShellScript` and `RunPowerShellScript`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"RunPowerShellScript",
+						"RunShellScript",
+					),
+				},
+			},
+
+			"script": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The lines of the script to run on the Virtual Machine.",
+			},
+
+			"parameters": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "A mapping of parameter names to values which should be passed to the script.",
+			},
+		},
+	}
+}
+
+func (a *VirtualMachineRunCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	client := a.Client.Compute.VirtualMachinesClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*90)
+	defer cancel()
+
+	var data VirtualMachineRunCommandActionModel
+	if ok := a.DecodeInvoke(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := virtualmachines.ParseVirtualMachineID(data.VirtualMachineId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing `virtual_machine_id`", err)
+		return
+	}
+
+	script := make([]string, 0)
+	resp.Diagnostics.Append(data.Script.ElementsAs(ctx, &script, false)...)
+	parameters := make(map[string]string)
+	if !data.Parameters.IsNull() {
+		resp.Diagnostics.Append(data.Parameters.ElementsAs(ctx, &parameters, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := virtualmachines.RunCommandInput{
+		CommandId: data.CommandId.ValueString(),
+		Script:    pointer.To(script),
+	}
+	if len(parameters) > 0 {
+		params := make([]virtualmachines.RunCommandInputParameter, 0)
+		for k, v := range parameters {
+			params = append(params, virtualmachines.RunCommandInputParameter{
+				Name:  k,
+				Value: v,
+			})
+		}
+		input.Parameters = pointer.To(params)
+	}
+
+	a.SendProgress(resp, fmt.Sprintf("running %q on %s..", input.CommandId, id))
+
+	result, err := client.RunCommand(ctx, *id, input)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("running %q on %s", input.CommandId, id), err)
+		return
+	}
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("waiting for %q to complete on %s", input.CommandId, id), err)
+		return
+	}
+
+	// the output of the script is returned within the final Long Running Operation response
+	var output struct {
+		Properties *struct {
+			Output *virtualmachines.RunCommandResult `json:"output"`
+		} `json:"properties"`
+	}
+	if lastResponse := result.Poller.LatestResponse(); lastResponse != nil {
+		if err := lastResponse.Unmarshal(&output); err == nil && output.Properties != nil && output.Properties.Output != nil {
+			for _, status := range pointer.From(output.Properties.Output.Value) {
+				if message := pointer.From(status.Message); message != "" {
+					a.SendProgress(resp, fmt.Sprintf("%s:\n%s", pointer.From(status.Code), message))
+				}
+			}
+		}
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/base64"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -80,6 +81,8 @@ func resourceWindowsVirtualMachineScaleSet() *pluginsdk.Resource {
 
 				return false
 			}),
+
+			preflight.CustomizeDiff(virtualMachineScaleSetPreflightValidation, "location", "sku", "instances", "zones", "tags"),
 		),
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappsauthconfigs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappsrevisions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/daprcomponents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/jobs"
@@ -17,13 +18,14 @@ import (
 )
 
 type Client struct {
-	CertificatesClient         *certificates.CertificatesClient
-	ContainerAppClient         *containerapps.ContainerAppsClient
-	ContainerAppRevisionClient *containerappsrevisions.ContainerAppsRevisionsClient
-	DaprComponentsClient       *daprcomponents.DaprComponentsClient
-	ManagedEnvironmentClient   *managedenvironments.ManagedEnvironmentsClient
-	StorageClient              *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
-	JobClient                  *jobs.JobsClient
+	CertificatesClient           *certificates.CertificatesClient
+	ContainerAppClient           *containerapps.ContainerAppsClient
+	ContainerAppAuthConfigClient *containerappsauthconfigs.ContainerAppsAuthConfigsClient
+	ContainerAppRevisionClient   *containerappsrevisions.ContainerAppsRevisionsClient
+	DaprComponentsClient         *daprcomponents.DaprComponentsClient
+	ManagedEnvironmentClient     *managedenvironments.ManagedEnvironmentsClient
+	StorageClient                *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
+	JobClient                    *jobs.JobsClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -39,6 +41,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(containerAppsClient.Client, o.Authorizers.ResourceManager)
 
+	containerAppsAuthConfigsClient, err := containerappsauthconfigs.NewContainerAppsAuthConfigsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Container Apps Auth Configs client : %+v", err)
+	}
+	o.Configure(containerAppsAuthConfigsClient.Client, o.Authorizers.ResourceManager)
+
 	containerAppsRevisionsClient, err := containerappsrevisions.NewContainerAppsRevisionsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Container Apps Revisions client : %+v", err)
@@ -70,12 +78,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(jobsClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
-		CertificatesClient:         certificatesClient,
-		ContainerAppClient:         containerAppsClient,
-		ContainerAppRevisionClient: containerAppsRevisionsClient,
-		DaprComponentsClient:       daprComponentClient,
-		ManagedEnvironmentClient:   managedEnvironmentClient,
-		StorageClient:              managedEnvironmentStoragesClient,
-		JobClient:                  jobsClient,
+		CertificatesClient:           certificatesClient,
+		ContainerAppClient:           containerAppsClient,
+		ContainerAppAuthConfigClient: containerAppsAuthConfigsClient,
+		ContainerAppRevisionClient:   containerAppsRevisionsClient,
+		DaprComponentsClient:         daprComponentClient,
+		ManagedEnvironmentClient:     managedEnvironmentClient,
+		StorageClient:                managedEnvironmentStoragesClient,
+		JobClient:                    jobsClient,
 	}, nil
 }
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappsauthconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	appserviceHelpers "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// containerAppAuthConfigName is the only name the Container Apps API accepts for an AuthConfig
+const containerAppAuthConfigName = "current"
+
+type ContainerAppAuthConfigResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ContainerAppAuthConfigResource{}
+	_ sdk.ResourceWithCustomizeDiff = ContainerAppAuthConfigResource{}
+)
+
+type ContainerAppAuthConfigModel struct {
+	ContainerAppId       string                             `tfschema:"container_app_id"`
+	AuthV2Settings       []appserviceHelpers.AuthV2Settings `tfschema:"auth_settings_v2"`
+	EncryptionSecretName string                             `tfschema:"encryption_secret_name"`
+	SigningSecretName    string                             `tfschema:"signing_secret_name"`
+}
+
+func (r ContainerAppAuthConfigResource) Arguments() map[string]*pluginsdk.Schema {
+	authSettings := appserviceHelpers.AuthV2SettingsSchema()
+	authSettings.Optional = false
+	authSettings.Required = true
+
+	return map[string]*pluginsdk.Schema{
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerappsauthconfigs.ValidateContainerAppID,
+		},
+
+		"auth_settings_v2": authSettings,
+
+		"encryption_secret_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The name of the Container App secret containing the key used to encrypt the authentication cookies.",
+		},
+
+		"signing_secret_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The name of the Container App secret containing the key used to sign the authentication cookies.",
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppAuthConfigResource) ModelObject() interface{} {
+	return &ContainerAppAuthConfigModel{}
+}
+
+func (r ContainerAppAuthConfigResource) ResourceType() string {
+	return "azurerm_container_app_auth_config"
+}
+
+func (r ContainerAppAuthConfigResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerappsauthconfigs.ValidateAuthConfigID
+}
+
+func (r ContainerAppAuthConfigResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppAuthConfigClient
+
+			var config ContainerAppAuthConfigModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerAppId, err := containerappsauthconfigs.ParseContainerAppID(config.ContainerAppId)
+			if err != nil {
+				return err
+			}
+
+			id := containerappsauthconfigs.NewAuthConfigID(containerAppId.SubscriptionId, containerAppId.ResourceGroupName, containerAppId.ContainerAppName, containerAppAuthConfigName)
+
+			locks.ByID(containerAppId.ID())
+			defer locks.UnlockByID(containerAppId.ID())
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := expandContainerAppAuthConfig(config)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, containerappsauthconfigs.AuthConfig{Properties: props}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppAuthConfigClient
+
+			id, err := containerappsauthconfigs.ParseAuthConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ContainerAppAuthConfigModel{
+				ContainerAppId: containerappsauthconfigs.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName).ID(),
+			}
+
+			if model := existing.Model; model != nil {
+				if props := model.Properties; props != nil {
+					authSettings, err := helpers.FlattenContainerAppAuthConfigProperties(props)
+					if err != nil {
+						return fmt.Errorf("flattening `auth_settings_v2` for %s: %+v", id, err)
+					}
+					state.AuthV2Settings = authSettings
+
+					if encryption := props.EncryptionSettings; encryption != nil {
+						state.EncryptionSecretName = pointer.From(encryption.ContainerAppAuthEncryptionSecretName)
+						state.SigningSecretName = pointer.From(encryption.ContainerAppAuthSigningSecretName)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppAuthConfigClient
+
+			id, err := containerappsauthconfigs.ParseAuthConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerAppAuthConfigModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerAppId := containerappsauthconfigs.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName)
+			locks.ByID(containerAppId.ID())
+			defer locks.UnlockByID(containerAppId.ID())
+
+			// the AuthConfig is always sent in full, since the API doesn't support partial updates
+			props, err := expandContainerAppAuthConfig(config)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, containerappsauthconfigs.AuthConfig{Properties: props}); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppAuthConfigClient
+
+			id, err := containerappsauthconfigs.ParseAuthConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			containerAppId := containerappsauthconfigs.NewContainerAppID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName)
+			locks.ByID(containerAppId.ID())
+			defer locks.UnlockByID(containerAppId.ID())
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppAuthConfigResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff == nil {
+				return nil
+			}
+
+			// the `auth_settings_v2` schema is shared with App Service, some properties of which aren't supported by Container Apps
+			for _, key := range helpers.ContainerAppAuthConfigUnsupportedSettings {
+				if _, ok := metadata.ResourceDiff.GetOk(key); ok {
+					return fmt.Errorf("`%s` is not supported for Container Apps", key)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppAuthConfig(input ContainerAppAuthConfigModel) (*containerappsauthconfigs.AuthConfigProperties, error) {
+	props, err := helpers.ExpandContainerAppAuthConfigProperties(input.AuthV2Settings)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `auth_settings_v2`: %+v", err)
+	}
+
+	if input.EncryptionSecretName != "" || input.SigningSecretName != "" {
+		props.EncryptionSettings = &containerappsauthconfigs.EncryptionSettings{}
+		if input.EncryptionSecretName != "" {
+			props.EncryptionSettings.ContainerAppAuthEncryptionSecretName = pointer.To(input.EncryptionSecretName)
+		}
+		if input.SigningSecretName != "" {
+			props.EncryptionSettings.ContainerAppAuthSigningSecretName = pointer.To(input.SigningSecretName)
+		}
+	}
+
+	return props, nil
+}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappsauthconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppAuthConfigResource struct{}
+
+func TestAccContainerAppAuthConfig_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppAuthConfig_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppAuthConfig_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppAuthConfig_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppAuthConfig_unsupportedSetting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_auth_config", "test")
+	r := ContainerAppAuthConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.unsupportedSetting(data),
+			ExpectError: regexp.MustCompile("`auth_settings_v2.0.config_file_path` is not supported for Container Apps"),
+		},
+	})
+}
+
+func (r ContainerAppAuthConfigResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := containerappsauthconfigs.ParseAuthConfigID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.ContainerAppAuthConfigClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppAuthConfigResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_auth_config" "test" {
+  container_app_id = azurerm_container_app.test.id
+
+  auth_settings_v2 {
+    auth_enabled           = true
+    unauthenticated_action = "RedirectToLoginPage"
+    default_provider       = "azureactivedirectory"
+
+    active_directory_v2 {
+      client_id                  = data.azurerm_client_config.current.client_id
+      client_secret_setting_name = "microsoft-provider-authentication-secret"
+      tenant_auth_endpoint       = "https://sts.windows.net/${data.azurerm_client_config.current.tenant_id}/v2.0"
+    }
+
+    login {}
+  }
+}
+`, r.template(data))
+}
+
+func (r ContainerAppAuthConfigResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_auth_config" "import" {
+  container_app_id = azurerm_container_app_auth_config.test.container_app_id
+
+  auth_settings_v2 {
+    auth_enabled           = true
+    unauthenticated_action = "RedirectToLoginPage"
+    default_provider       = "azureactivedirectory"
+
+    active_directory_v2 {
+      client_id                  = data.azurerm_client_config.current.client_id
+      client_secret_setting_name = "microsoft-provider-authentication-secret"
+      tenant_auth_endpoint       = "https://sts.windows.net/${data.azurerm_client_config.current.tenant_id}/v2.0"
+    }
+
+    login {}
+  }
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppAuthConfigResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_auth_config" "test" {
+  container_app_id       = azurerm_container_app.test.id
+  encryption_secret_name = "auth-encryption-key"
+  signing_secret_name    = "auth-signing-key"
+
+  auth_settings_v2 {
+    auth_enabled           = true
+    runtime_version        = "~1"
+    unauthenticated_action = "Return401"
+    default_provider       = "github"
+    excluded_paths         = ["/health"]
+    require_https          = true
+    http_route_api_prefix  = "/.auth"
+
+    active_directory_v2 {
+      client_id                  = data.azurerm_client_config.current.client_id
+      client_secret_setting_name = "microsoft-provider-authentication-secret"
+      tenant_auth_endpoint       = "https://sts.windows.net/${data.azurerm_client_config.current.tenant_id}/v2.0"
+      allowed_audiences          = ["api://acctest-capp-%[2]d"]
+    }
+
+    github_v2 {
+      client_id                  = "acctest-github-client-id"
+      client_secret_setting_name = "github-provider-authentication-secret"
+      login_scopes               = ["read:user"]
+    }
+
+    login {
+      token_store_enabled               = true
+      token_refresh_extension_time      = 24
+      preserve_url_fragments_for_logins = true
+      allowed_external_redirect_urls    = ["https://example.com"]
+      cookie_expiration_convention      = "FixedTime"
+      cookie_expiration_time            = "08:00:00"
+      validate_nonce                    = true
+      nonce_expiration_time             = "00:05:00"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppAuthConfigResource) unsupportedSetting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_auth_config" "test" {
+  container_app_id = azurerm_container_app.test.id
+
+  auth_settings_v2 {
+    auth_enabled     = true
+    config_file_path = "/auth/config.json"
+
+    active_directory_v2 {
+      client_id                  = data.azurerm_client_config.current.client_id
+      client_secret_setting_name = "microsoft-provider-authentication-secret"
+      tenant_auth_endpoint       = "https://sts.windows.net/${data.azurerm_client_config.current.tenant_id}/v2.0"
+    }
+
+    login {}
+  }
+}
+`, r.template(data))
+}
+
+func (r ContainerAppAuthConfigResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Single"
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+  }
+
+  ingress {
+    external_enabled = true
+    target_port      = 5000
+
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+  }
+
+  secret {
+    name  = "microsoft-provider-authentication-secret"
+    value = "acctest-aad-secret-%[2]d"
+  }
+
+  secret {
+    name  = "github-provider-authentication-secret"
+    value = "acctest-github-secret-%[2]d"
+  }
+
+  secret {
+    name  = "auth-encryption-key"
+    value = "%[3]s"
+  }
+
+  secret {
+    name  = "auth-signing-key"
+    value = "%[4]s"
+  }
+}
+`, ContainerAppResource{}.template(data), data.RandomInteger, acceptance.RandString(32), acceptance.RandString(32))
+}
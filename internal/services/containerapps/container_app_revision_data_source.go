@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappsrevisions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppRevisionDataSource struct{}
+
+type ContainerAppRevisionDataSourceModel struct {
+	ContainerAppId    string `tfschema:"container_app_id"`
+	Name              string `tfschema:"name"`
+	Active            bool   `tfschema:"active"`
+	CreatedTime       string `tfschema:"created_time"`
+	Fqdn              string `tfschema:"fqdn"`
+	HealthState       string `tfschema:"health_state"`
+	LastActiveTime    string `tfschema:"last_active_time"`
+	ProvisioningError string `tfschema:"provisioning_error"`
+	ProvisioningState string `tfschema:"provisioning_state"`
+	Replicas          int64  `tfschema:"replicas"`
+	RunningState      string `tfschema:"running_state"`
+	TrafficWeight     int64  `tfschema:"traffic_weight"`
+}
+
+type ContainerAppRevisionModel struct {
+	Name              string `tfschema:"name"`
+	Active            bool   `tfschema:"active"`
+	CreatedTime       string `tfschema:"created_time"`
+	Fqdn              string `tfschema:"fqdn"`
+	HealthState       string `tfschema:"health_state"`
+	LastActiveTime    string `tfschema:"last_active_time"`
+	ProvisioningError string `tfschema:"provisioning_error"`
+	ProvisioningState string `tfschema:"provisioning_state"`
+	Replicas          int64  `tfschema:"replicas"`
+	RunningState      string `tfschema:"running_state"`
+	TrafficWeight     int64  `tfschema:"traffic_weight"`
+}
+
+var _ sdk.DataSource = ContainerAppRevisionDataSource{}
+
+func (r ContainerAppRevisionDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: containerappsrevisions.ValidateContainerAppID,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ContainerAppRevisionDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return containerAppRevisionSchemaComputed()
+}
+
+func (r ContainerAppRevisionDataSource) ModelObject() interface{} {
+	return &ContainerAppRevisionDataSourceModel{}
+}
+
+func (r ContainerAppRevisionDataSource) ResourceType() string {
+	return "azurerm_container_app_revision"
+}
+
+func (r ContainerAppRevisionDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppRevisionClient
+
+			var state ContainerAppRevisionDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			containerAppId, err := containerappsrevisions.ParseContainerAppID(state.ContainerAppId)
+			if err != nil {
+				return err
+			}
+
+			id := containerappsrevisions.NewRevisionID(containerAppId.SubscriptionId, containerAppId.ResourceGroupName, containerAppId.ContainerAppName, state.Name)
+
+			resp, err := client.GetRevision(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state.ContainerAppId = containerAppId.ID()
+			state.Name = id.RevisionName
+
+			if model := resp.Model; model != nil {
+				revision := flattenContainerAppRevision(*model)
+				state.Active = revision.Active
+				state.CreatedTime = revision.CreatedTime
+				state.Fqdn = revision.Fqdn
+				state.HealthState = revision.HealthState
+				state.LastActiveTime = revision.LastActiveTime
+				state.ProvisioningError = revision.ProvisioningError
+				state.ProvisioningState = revision.ProvisioningState
+				state.Replicas = revision.Replicas
+				state.RunningState = revision.RunningState
+				state.TrafficWeight = revision.TrafficWeight
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func containerAppRevisionSchemaComputed() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"active": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"created_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"health_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_active_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"provisioning_error": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"provisioning_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"replicas": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"running_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"traffic_weight": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func flattenContainerAppRevision(input containerappsrevisions.Revision) ContainerAppRevisionModel {
+	result := ContainerAppRevisionModel{
+		Name: pointer.From(input.Name),
+	}
+
+	if props := input.Properties; props != nil {
+		result.Active = pointer.From(props.Active)
+		result.CreatedTime = pointer.From(props.CreatedTime)
+		result.Fqdn = pointer.From(props.Fqdn)
+		result.HealthState = string(pointer.From(props.HealthState))
+		result.LastActiveTime = pointer.From(props.LastActiveTime)
+		result.ProvisioningError = pointer.From(props.ProvisioningError)
+		result.ProvisioningState = string(pointer.From(props.ProvisioningState))
+		result.Replicas = pointer.From(props.Replicas)
+		result.RunningState = string(pointer.From(props.RunningState))
+		result.TrafficWeight = pointer.From(props.TrafficWeight)
+	}
+
+	return result
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ContainerAppRevisionDataSource struct{}
+
+func TestAccContainerAppRevisionDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_container_app_revision", "test")
+	r := ContainerAppRevisionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("active").HasValue("true"),
+				check.That(data.ResourceName).Key("health_state").Exists(),
+				check.That(data.ResourceName).Key("running_state").Exists(),
+				check.That(data.ResourceName).Key("traffic_weight").HasValue("100"),
+			),
+		},
+	})
+}
+
+func (d ContainerAppRevisionDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_container_app_revision" "test" {
+  container_app_id = azurerm_container_app.test.id
+  name             = azurerm_container_app.test.latest_revision_name
+}
+`, ContainerAppResource{}.basic(data))
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappsrevisions"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+const (
+	containerAppRevisionOperationActivate   = "activate"
+	containerAppRevisionOperationDeactivate = "deactivate"
+	containerAppRevisionOperationRestart    = "restart"
+)
+
+var _ sdk.Action = &ContainerAppRevisionOperationAction{}
+
+func NewContainerAppRevisionOperationAction() action.Action {
+	return &ContainerAppRevisionOperationAction{}
+}
+
+type ContainerAppRevisionOperationAction struct {
+	sdk.ActionMetadata
+}
+
+type ContainerAppRevisionOperationActionModel struct {
+	ContainerAppId types.String `tfsdk:"container_app_id"`
+	RevisionName   types.String `tfsdk:"revision_name"`
+	Operation      types.String `tfsdk:"operation"`
+}
+
+func (a *ContainerAppRevisionOperationAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "azurerm_container_app_revision_operation"
+}
+
+func (a *ContainerAppRevisionOperationAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.Defaults(req, resp)
+}
+
+func (a *ContainerAppRevisionOperationAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Activates, deactivates or restarts a Container App Revision.",
+		Attributes: map[string]schema.Attribute{
+			"container_app_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Container App.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: containerappsrevisions.ValidateContainerAppID,
+					},
+				},
+			},
+
+			"revision_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Container App Revision.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"operation": schema.StringAttribute{
+				Required:    true,
+				Description: "The operation to perform on the Container App Revision. Possible values are `activate`, `deactivate` and `restart`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						containerAppRevisionOperationActivate,
+						containerAppRevisionOperationDeactivate,
+						containerAppRevisionOperationRestart,
+					),
+				},
+			},
+		},
+	}
+}
+
+func (a *ContainerAppRevisionOperationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	client := a.Client.ContainerApps.ContainerAppRevisionClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*10)
+	defer cancel()
+
+	var data ContainerAppRevisionOperationActionModel
+	if ok := a.DecodeInvoke(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	containerAppId, err := containerappsrevisions.ParseContainerAppID(data.ContainerAppId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing `container_app_id`", err)
+		return
+	}
+
+	id := containerappsrevisions.NewRevisionID(containerAppId.SubscriptionId, containerAppId.ResourceGroupName, containerAppId.ContainerAppName, data.RevisionName.ValueString())
+
+	operation := data.Operation.ValueString()
+	a.SendProgress(resp, fmt.Sprintf("performing %q on %s..", operation, id))
+
+	switch operation {
+	case containerAppRevisionOperationActivate:
+		_, err = client.ActivateRevision(ctx, id)
+	case containerAppRevisionOperationDeactivate:
+		_, err = client.DeactivateRevision(ctx, id)
+	case containerAppRevisionOperationRestart:
+		_, err = client.RestartRevision(ctx, id)
+	}
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("performing %q on %s", operation, id), err)
+		return
+	}
+
+	a.SendProgress(resp, fmt.Sprintf("completed %q on %s", operation, id))
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ContainerAppRevisionOperationAction struct{}
+
+func TestAccContainerAppRevisionOperationAction_restart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_revision_operation", "test")
+	a := ContainerAppRevisionOperationAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: a.restart(data),
+			},
+		},
+	})
+}
+
+func (ContainerAppRevisionOperationAction) restart(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_container_app_revision_operation" "test" {
+  config {
+    container_app_id = azurerm_container_app.test.id
+    revision_name    = azurerm_container_app.test.latest_revision_name
+    operation        = "restart"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_container_app.test.latest_revision_name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_container_app_revision_operation.test]
+    }
+  }
+}
+`, ContainerAppTrafficSplitResource{}.template(data))
+}
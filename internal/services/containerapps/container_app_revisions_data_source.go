@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappsrevisions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppRevisionsDataSource struct{}
+
+type ContainerAppRevisionsDataSourceModel struct {
+	ContainerAppId string                      `tfschema:"container_app_id"`
+	ActiveOnly     bool                        `tfschema:"active_only"`
+	Revisions      []ContainerAppRevisionModel `tfschema:"revisions"`
+}
+
+var _ sdk.DataSource = ContainerAppRevisionsDataSource{}
+
+func (r ContainerAppRevisionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: containerappsrevisions.ValidateContainerAppID,
+		},
+
+		"active_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r ContainerAppRevisionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	revisionSchema := containerAppRevisionSchemaComputed()
+	revisionSchema["name"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"revisions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: revisionSchema,
+			},
+		},
+	}
+}
+
+func (r ContainerAppRevisionsDataSource) ModelObject() interface{} {
+	return &ContainerAppRevisionsDataSourceModel{}
+}
+
+func (r ContainerAppRevisionsDataSource) ResourceType() string {
+	return "azurerm_container_app_revisions"
+}
+
+func (r ContainerAppRevisionsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppRevisionClient
+
+			var state ContainerAppRevisionsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			id, err := containerappsrevisions.ParseContainerAppID(state.ContainerAppId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListRevisionsComplete(ctx, *id, containerappsrevisions.DefaultListRevisionsOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing revisions for %s: %+v", id, err)
+			}
+
+			state.ContainerAppId = id.ID()
+			state.Revisions = make([]ContainerAppRevisionModel, 0)
+			for _, item := range resp.Items {
+				revision := flattenContainerAppRevision(item)
+				if state.ActiveOnly && !revision.Active {
+					continue
+				}
+				state.Revisions = append(state.Revisions, revision)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ContainerAppRevisionsDataSource struct{}
+
+func TestAccContainerAppRevisionsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_container_app_revisions", "test")
+	r := ContainerAppRevisionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("revisions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("revisions.0.name").Exists(),
+				check.That(data.ResourceName).Key("revisions.0.active").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (d ContainerAppRevisionsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_container_app_revisions" "test" {
+  container_app_id = azurerm_container_app.test.id
+  active_only      = true
+}
+`, ContainerAppResource{}.basic(data))
+}
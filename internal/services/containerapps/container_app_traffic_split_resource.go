@@ -0,0 +1,358 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappsrevisions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppTrafficSplitResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerAppTrafficSplitResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = ContainerAppTrafficSplitResource{}
+
+type ContainerAppTrafficSplitModel struct {
+	ContainerAppId string                           `tfschema:"container_app_id"`
+	TrafficWeights []ContainerAppTrafficSplitWeight `tfschema:"traffic_weight"`
+}
+
+type ContainerAppTrafficSplitWeight struct {
+	RevisionName   string `tfschema:"revision_name"`
+	LatestRevision bool   `tfschema:"latest_revision"`
+	Label          string `tfschema:"label"`
+	Percentage     int64  `tfschema:"percentage"`
+}
+
+func (r ContainerAppTrafficSplitResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerapps.ValidateContainerAppID,
+		},
+
+		"traffic_weight": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"revision_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The name of the Container App Revision which should receive this traffic.",
+					},
+
+					"latest_revision": {
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "This traffic weight relates to the latest stable Container App Revision.",
+					},
+
+					"label": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The label to apply to the revision as a name prefix for routing traffic.",
+					},
+
+					"percentage": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 100),
+						Description:  "The percentage of traffic to send to this revision.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerAppTrafficSplitResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppTrafficSplitResource) ModelObject() interface{} {
+	return &ContainerAppTrafficSplitModel{}
+}
+
+func (r ContainerAppTrafficSplitResource) ResourceType() string {
+	return "azurerm_container_app_traffic_split"
+}
+
+func (r ContainerAppTrafficSplitResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerapps.ValidateContainerAppID
+}
+
+func (r ContainerAppTrafficSplitResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ContainerAppTrafficSplitModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			id, err := containerapps.ParseContainerAppID(config.ContainerAppId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			// the traffic configuration always exists for a Container App with Ingress, so rather than checking for
+			// an existing resource this takes ownership of it, which is reset to the latest revision on deletion
+			if err := r.applyTrafficWeights(ctx, metadata, *id, config.TrafficWeights); err != nil {
+				return fmt.Errorf("creating traffic split for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppTrafficSplitResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ContainerAppTrafficSplitModel{
+				ContainerAppId: id.ID(),
+			}
+
+			var ingress *containerapps.Ingress
+			if model := existing.Model; model != nil && model.Properties != nil && model.Properties.Configuration != nil {
+				ingress = model.Properties.Configuration.Ingress
+			}
+			if ingress == nil {
+				log.Printf("[DEBUG] %s no longer has an Ingress configuration - removing traffic split from state", id)
+				return metadata.MarkAsGone(id)
+			}
+
+			state.TrafficWeights = flattenContainerAppTrafficSplitWeights(ingress.Traffic)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppTrafficSplitResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerAppTrafficSplitModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			if err := r.applyTrafficWeights(ctx, metadata, *id, config.TrafficWeights); err != nil {
+				return fmt.Errorf("updating traffic split for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppTrafficSplitResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			// there's no way to remove the traffic configuration, so we send all traffic to the latest revision
+			latest := []ContainerAppTrafficSplitWeight{
+				{
+					LatestRevision: true,
+					Percentage:     100,
+				},
+			}
+			if err := r.applyTrafficWeights(ctx, metadata, *id, latest); err != nil {
+				return fmt.Errorf("deleting traffic split for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppTrafficSplitResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff == nil || !metadata.ResourceDiff.NewValueKnown("traffic_weight") {
+				return nil
+			}
+
+			var config ContainerAppTrafficSplitModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return err
+			}
+
+			total := int64(0)
+			labels := make(map[string]struct{})
+			for i, weight := range config.TrafficWeights {
+				// the revision name is commonly interpolated from a new revision, in which case it's not known until apply
+				revisionNameKnown := metadata.ResourceDiff.NewValueKnown(fmt.Sprintf("traffic_weight.%d.revision_name", i))
+				if revisionNameKnown && weight.LatestRevision == (weight.RevisionName != "") {
+					return fmt.Errorf("exactly one of `traffic_weight.%[1]d.revision_name` or `traffic_weight.%[1]d.latest_revision` must be specified", i)
+				}
+
+				if weight.Label != "" {
+					if _, exists := labels[weight.Label]; exists {
+						return fmt.Errorf("the label %q is used by more than one `traffic_weight` block", weight.Label)
+					}
+					labels[weight.Label] = struct{}{}
+				}
+
+				total += weight.Percentage
+			}
+
+			if total != 100 {
+				return fmt.Errorf("the sum of the `traffic_weight` percentages must be 100, got %d", total)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppTrafficSplitResource) applyTrafficWeights(ctx context.Context, metadata sdk.ResourceMetaData, id containerapps.ContainerAppId, weights []ContainerAppTrafficSplitWeight) error {
+	client := metadata.Client.ContainerApps.ContainerAppClient
+	revisionsClient := metadata.Client.ContainerApps.ContainerAppRevisionClient
+
+	// inactive revisions can't receive traffic, so any revision we're routing traffic to needs to be activated first
+	for _, weight := range weights {
+		if weight.RevisionName == "" || weight.Percentage == 0 {
+			continue
+		}
+
+		revisionId := containerappsrevisions.NewRevisionID(id.SubscriptionId, id.ResourceGroupName, id.ContainerAppName, weight.RevisionName)
+		revision, err := revisionsClient.GetRevision(ctx, revisionId)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", revisionId, err)
+		}
+
+		if model := revision.Model; model != nil && model.Properties != nil && !pointer.From(model.Properties.Active) {
+			if _, err := revisionsClient.ActivateRevision(ctx, revisionId); err != nil {
+				return fmt.Errorf("activating %s: %+v", revisionId, err)
+			}
+		}
+	}
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if existing.Model == nil || existing.Model.Properties == nil || existing.Model.Properties.Configuration == nil {
+		return fmt.Errorf("retrieving %s: `properties.configuration` was nil", id)
+	}
+
+	model := existing.Model
+	if model.Properties.Configuration.Ingress == nil {
+		return fmt.Errorf("%s has no Ingress configuration to split traffic for", id)
+	}
+
+	// Delta-updates need the secrets back from the list API, or we'll end up removing them or erroring out.
+	secretsResp, err := client.ListSecrets(ctx, id)
+	if err != nil || secretsResp.Model == nil {
+		if !response.WasStatusCode(secretsResp.HttpResponse, http.StatusNoContent) {
+			return fmt.Errorf("retrieving secrets for update for %s: %+v", id, err)
+		}
+	}
+	model.Properties.Configuration.Secrets = helpers.UnpackContainerSecretsCollection(secretsResp.Model)
+
+	model.Properties.Configuration.Ingress.Traffic = expandContainerAppTrafficSplitWeights(weights)
+
+	return client.CreateOrUpdateThenPoll(ctx, id, *model)
+}
+
+func expandContainerAppTrafficSplitWeights(input []ContainerAppTrafficSplitWeight) *[]containerapps.TrafficWeight {
+	result := make([]containerapps.TrafficWeight, 0)
+	for _, v := range input {
+		weight := containerapps.TrafficWeight{
+			LatestRevision: pointer.To(v.LatestRevision),
+			Weight:         pointer.To(v.Percentage),
+		}
+
+		if v.RevisionName != "" {
+			weight.RevisionName = pointer.To(v.RevisionName)
+		}
+
+		if v.Label != "" {
+			weight.Label = pointer.To(v.Label)
+		}
+
+		result = append(result, weight)
+	}
+
+	return &result
+}
+
+func flattenContainerAppTrafficSplitWeights(input *[]containerapps.TrafficWeight) []ContainerAppTrafficSplitWeight {
+	result := make([]ContainerAppTrafficSplitWeight, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, ContainerAppTrafficSplitWeight{
+			RevisionName:   pointer.From(v.RevisionName),
+			LatestRevision: pointer.From(v.LatestRevision),
+			Label:          pointer.From(v.Label),
+			Percentage:     pointer.From(v.Weight),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppTrafficSplitResource struct{}
+
+func TestAccContainerAppTrafficSplit_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_traffic_split", "test")
+	r := ContainerAppTrafficSplitResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppTrafficSplit_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_traffic_split", "test")
+	r := ContainerAppTrafficSplitResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.namedRevisions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_weight.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppTrafficSplit_invalidPercentage(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_traffic_split", "test")
+	r := ContainerAppTrafficSplitResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidPercentage(data),
+			ExpectError: regexp.MustCompile("the sum of the `traffic_weight` percentages must be 100"),
+		},
+	})
+}
+
+func (r ContainerAppTrafficSplitResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := containerapps.ParseContainerAppID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.ContainerAppClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Configuration != nil && model.Properties.Configuration.Ingress != nil {
+		return pointer.To(model.Properties.Configuration.Ingress.Traffic != nil), nil
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r ContainerAppTrafficSplitResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_traffic_split" "test" {
+  container_app_id = azurerm_container_app.test.id
+
+  traffic_weight {
+    latest_revision = true
+    label           = "latest"
+    percentage      = 100
+  }
+}
+`, r.template(data))
+}
+
+func (r ContainerAppTrafficSplitResource) namedRevisions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_traffic_split" "test" {
+  container_app_id = azurerm_container_app.test.id
+
+  traffic_weight {
+    revision_name = azurerm_container_app.test.latest_revision_name
+    label         = "blue"
+    percentage    = 80
+  }
+
+  traffic_weight {
+    latest_revision = true
+    label           = "green"
+    percentage      = 20
+  }
+}
+`, r.template(data))
+}
+
+func (r ContainerAppTrafficSplitResource) invalidPercentage(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_traffic_split" "test" {
+  container_app_id = azurerm_container_app.test.id
+
+  traffic_weight {
+    latest_revision = true
+    percentage      = 50
+  }
+}
+`, r.template(data))
+}
+
+func (r ContainerAppTrafficSplitResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Multiple"
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+
+    revision_suffix = "rev1"
+  }
+
+  ingress {
+    external_enabled = true
+    target_port      = 5000
+
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [ingress[0].traffic_weight]
+  }
+}
+`, ContainerAppResource{}.template(data), data.RandomInteger)
+}
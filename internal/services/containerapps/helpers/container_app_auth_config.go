@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-01-01/containerappsauthconfigs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	appserviceHelpers "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+)
+
+// ContainerAppAuthConfigUnsupportedSettings lists the `auth_settings_v2` properties shared with App Service which
+// have no equivalent in the Container Apps AuthConfig API. Container Apps enforce authentication through
+// `unauthenticated_action` alone, so `require_authentication` is unsupported. `custom_oidc_v2` is included since the
+// App Service expand derives an upper-case secret setting name from the provider name, which isn't a valid Container
+// App secret name.
+var ContainerAppAuthConfigUnsupportedSettings = []string{
+	"auth_settings_v2.0.config_file_path",
+	"auth_settings_v2.0.custom_oidc_v2",
+	"auth_settings_v2.0.microsoft_v2",
+	"auth_settings_v2.0.require_authentication",
+	"auth_settings_v2.0.login.0.token_store_path",
+}
+
+// ExpandContainerAppAuthConfigProperties expands the App Service `auth_settings_v2` model into the Container Apps
+// AuthConfig properties. The two APIs share the same wire format for the overlapping properties, so the App Service
+// expand is reused and the result is converted via its JSON representation.
+func ExpandContainerAppAuthConfigProperties(input []appserviceHelpers.AuthV2Settings) (*containerappsauthconfigs.AuthConfigProperties, error) {
+	settings := appserviceHelpers.ExpandAuthV2Settings(input)
+	if settings == nil || settings.Properties == nil {
+		return &containerappsauthconfigs.AuthConfigProperties{}, nil
+	}
+
+	b, err := json.Marshal(settings.Properties)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling auth settings: %+v", err)
+	}
+
+	result := &containerappsauthconfigs.AuthConfigProperties{}
+	if err := json.Unmarshal(b, result); err != nil {
+		return nil, fmt.Errorf("unmarshalling auth settings into an auth config: %+v", err)
+	}
+
+	// the App Service expand always sends an empty blob storage token store, however `sasUrlSettingName` is required
+	// by the Container Apps API when the block is present
+	if login := result.Login; login != nil && login.TokenStore != nil {
+		if blob := login.TokenStore.AzureBlobStorage; blob != nil && blob.SasURLSettingName == "" {
+			login.TokenStore.AzureBlobStorage = nil
+		}
+	}
+
+	return result, nil
+}
+
+// FlattenContainerAppAuthConfigProperties flattens the Container Apps AuthConfig properties into the App Service
+// `auth_settings_v2` model.
+func FlattenContainerAppAuthConfigProperties(input *containerappsauthconfigs.AuthConfigProperties) ([]appserviceHelpers.AuthV2Settings, error) {
+	if input == nil {
+		return []appserviceHelpers.AuthV2Settings{}, nil
+	}
+
+	b, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling auth config: %+v", err)
+	}
+
+	props := &webapps.SiteAuthSettingsV2Properties{}
+	if err := json.Unmarshal(b, props); err != nil {
+		return nil, fmt.Errorf("unmarshalling auth config into auth settings: %+v", err)
+	}
+
+	return appserviceHelpers.FlattenAuthV2Settings(webapps.SiteAuthSettingsV2{
+		Properties: props,
+	}), nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"testing"
+
+	appserviceHelpers "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+)
+
+func TestContainerAppAuthConfigPropertiesRoundTrip(t *testing.T) {
+	input := []appserviceHelpers.AuthV2Settings{
+		{
+			AuthEnabled:           true,
+			RuntimeVersion:        "~1",
+			UnauthenticatedAction: "RedirectToLoginPage",
+			DefaultAuthProvider:   "azureactivedirectory",
+			ExcludedPaths:         []string{"/health"},
+			AzureActiveDirectoryAuth: []appserviceHelpers.AadAuthV2Settings{
+				{
+					ClientId:                "00000000-0000-0000-0000-000000000000",
+					TenantAuthURI:           "https://sts.windows.net/00000000-0000-0000-0000-000000000000/v2.0",
+					ClientSecretSettingName: "microsoft-provider-authentication-secret",
+				},
+			},
+			Login: []appserviceHelpers.AuthV2Login{
+				{
+					TokenStoreEnabled:          true,
+					TokenRefreshExtension:      72,
+					TokenBlobStorageSAS:        "token-store-sas",
+					CookieExpirationConvention: "FixedTime",
+					CookieExpirationTime:       "08:00:00",
+					NonceExpirationTime:        "00:05:00",
+					ValidateNonce:              true,
+				},
+			},
+			RequireHTTPS:           true,
+			HttpRoutesAPIPrefix:    "/.auth",
+			ForwardProxyConvention: "NoProxy",
+		},
+	}
+
+	props, err := ExpandContainerAppAuthConfigProperties(input)
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+
+	if props.IdentityProviders == nil || props.IdentityProviders.AzureActiveDirectory == nil {
+		t.Fatalf("expected the Azure Active Directory identity provider to be set")
+	}
+	if props.Login == nil || props.Login.TokenStore == nil || props.Login.TokenStore.AzureBlobStorage == nil {
+		t.Fatalf("expected the blob storage token store to be set")
+	}
+	if actual := props.Login.TokenStore.AzureBlobStorage.SasURLSettingName; actual != "token-store-sas" {
+		t.Fatalf("expected `sasUrlSettingName` to be %q but got %q", "token-store-sas", actual)
+	}
+
+	output, err := FlattenContainerAppAuthConfigProperties(props)
+	if err != nil {
+		t.Fatalf("flattening: %+v", err)
+	}
+	if len(output) != 1 {
+		t.Fatalf("expected 1 auth settings block but got %d", len(output))
+	}
+
+	actual := output[0]
+	if !actual.AuthEnabled || actual.RuntimeVersion != "~1" || actual.UnauthenticatedAction != "RedirectToLoginPage" {
+		t.Fatalf("unexpected platform or global validation settings: %+v", actual)
+	}
+	if actual.DefaultAuthProvider != "azureactivedirectory" {
+		t.Fatalf("expected `default_provider` to be %q but got %q", "azureactivedirectory", actual.DefaultAuthProvider)
+	}
+	if len(actual.AzureActiveDirectoryAuth) != 1 || actual.AzureActiveDirectoryAuth[0].ClientId != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("unexpected `active_directory_v2`: %+v", actual.AzureActiveDirectoryAuth)
+	}
+	if len(actual.Login) != 1 || actual.Login[0].TokenBlobStorageSAS != "token-store-sas" || actual.Login[0].CookieExpirationTime != "08:00:00" {
+		t.Fatalf("unexpected `login`: %+v", actual.Login)
+	}
+}
+
+func TestExpandContainerAppAuthConfigPropertiesWithoutTokenStore(t *testing.T) {
+	input := []appserviceHelpers.AuthV2Settings{
+		{
+			AuthEnabled: true,
+			Login: []appserviceHelpers.AuthV2Login{
+				{
+					TokenRefreshExtension: 72,
+				},
+			},
+		},
+	}
+
+	props, err := ExpandContainerAppAuthConfigProperties(input)
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+
+	if props.Login == nil || props.Login.TokenStore == nil {
+		t.Fatalf("expected the token store to be set")
+	}
+	if props.Login.TokenStore.AzureBlobStorage != nil {
+		t.Fatalf("expected the blob storage token store to be omitted when no SAS setting name is specified")
+	}
+}
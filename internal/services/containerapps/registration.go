@@ -4,10 +4,17 @@
 package containerapps
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 )
 
-var _ sdk.TypedServiceRegistration = Registration{}
+var (
+	_ sdk.TypedServiceRegistration          = Registration{}
+	_ sdk.FrameworkTypedServiceRegistration = Registration{}
+)
 
 type Registration struct{}
 
@@ -30,6 +37,8 @@ func (r Registration) DataSources() []sdk.DataSource {
 		ContainerAppDataSource{},
 		ContainerAppEnvironmentDataSource{},
 		ContainerAppEnvironmentCertificateDataSource{},
+		ContainerAppRevisionDataSource{},
+		ContainerAppRevisionsDataSource{},
 	}
 }
 
@@ -43,5 +52,25 @@ func (r Registration) Resources() []sdk.Resource {
 		ContainerAppResource{},
 		ContainerAppCustomDomainResource{},
 		ContainerAppJobResource{},
+		ContainerAppTrafficSplitResource{},
+		ContainerAppAuthConfigResource{},
+	}
+}
+
+func (r Registration) FrameworkResources() []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func (r Registration) FrameworkDataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		NewContainerAppRevisionOperationAction,
 	}
 }
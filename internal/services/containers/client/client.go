@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-09-01/trustedaccess"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-11-01/extensions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2023-05-01/fluxconfiguration"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -44,6 +45,8 @@ type Client struct {
 	SnapshotClient                              *snapshots.SnapshotsClient
 	TrustedAccessClient                         *trustedaccess.TrustedAccessClient
 	Environment                                 environments.Environment
+
+	registryAuthorizer auth.Authorizer
 }
 
 func NewContainersClient(o *common.ClientOptions) (*Client, error) {
@@ -157,5 +160,6 @@ func NewContainersClient(o *common.ClientOptions) (*Client, error) {
 		SnapshotClient:                              snapshotClient,
 		TrustedAccessClient:                         trustedAccessClient,
 		Environment:                                 o.Environment,
+		registryAuthorizer:                          o.Authorizers.ResourceManager,
 	}, nil
 }
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/sender"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+)
+
+// manifestMediaTypes are the manifest formats we're able to resolve, covering both single and multi-architecture images
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// RegistryManifestsClient is a minimal client for the Container Registry data plane, which exchanges the
+// Resource Manager token for a registry scoped token and then queries the Docker Registry V2 API.
+type RegistryManifestsClient struct {
+	authorizer  auth.Authorizer
+	sender      autorest.Sender
+	loginServer string
+}
+
+func (c *Client) RegistryManifestsClient(loginServer string) *RegistryManifestsClient {
+	return &RegistryManifestsClient{
+		authorizer:  c.registryAuthorizer,
+		sender:      sender.BuildSender("AzureRM"),
+		loginServer: loginServer,
+	}
+}
+
+// GetManifestDigest returns the digest of the manifest referenced by `reference` (either a tag or a digest) within
+// `repository`, returning an empty string when the manifest doesn't exist.
+func (c *RegistryManifestsClient) GetManifestDigest(ctx context.Context, repository, reference string) (string, error) {
+	accessToken, err := c.accessToken(ctx, fmt.Sprintf("repository:%s:pull", repository))
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, fmt.Sprintf("https://%s/v2/%s/manifests/%s", c.loginServer, repository, url.PathEscape(reference)), nil)
+	if err != nil {
+		return "", fmt.Errorf("building manifest request: %+v", err)
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+
+	resp, err := c.sender.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("retrieving manifest %s:%s: %+v", repository, reference, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Header.Get("Docker-Content-Digest"), nil
+	case http.StatusNotFound:
+		return "", nil
+	default:
+		return "", fmt.Errorf("retrieving manifest %s:%s: unexpected status %d", repository, reference, resp.StatusCode)
+	}
+}
+
+// accessToken exchanges the Resource Manager token for a registry refresh token, which is then used to obtain an
+// access token for the specified scope, see https://aka.ms/acr/authentication
+func (c *RegistryManifestsClient) accessToken(ctx context.Context, scope string) (string, error) {
+	exchangeUri := fmt.Sprintf("https://%s/oauth2/exchange", c.loginServer)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, exchangeUri, nil)
+	if err != nil {
+		return "", fmt.Errorf("building token exchange request: %+v", err)
+	}
+	token, err := c.authorizer.Token(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf("obtaining auth token for %q: %+v", c.loginServer, err)
+	}
+
+	var exchanged struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	err = c.postForm(ctx, exchangeUri, url.Values{
+		"grant_type":   []string{"access_token"},
+		"service":      []string{c.loginServer},
+		"access_token": []string{token.AccessToken},
+	}, &exchanged)
+	if err != nil {
+		return "", fmt.Errorf("exchanging auth token for a refresh token for %q: %+v", c.loginServer, err)
+	}
+
+	var issued struct {
+		AccessToken string `json:"access_token"`
+	}
+	err = c.postForm(ctx, fmt.Sprintf("https://%s/oauth2/token", c.loginServer), url.Values{
+		"grant_type":    []string{"refresh_token"},
+		"service":       []string{c.loginServer},
+		"scope":         []string{scope},
+		"refresh_token": []string{exchanged.RefreshToken},
+	}, &issued)
+	if err != nil {
+		return "", fmt.Errorf("obtaining access token with scope %q for %q: %+v", scope, c.loginServer, err)
+	}
+
+	return issued.AccessToken, nil
+}
+
+func (c *RegistryManifestsClient) postForm(ctx context.Context, uri string, values url.Values, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, strings.NewReader(values.Encode()))
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.sender.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
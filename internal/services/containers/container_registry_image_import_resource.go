@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ContainerRegistryImageImportResource{}
+
+type ContainerRegistryImageImportResource struct{}
+
+type ContainerRegistryImageImportModel struct {
+	ContainerRegistryId string                               `tfschema:"container_registry_id"`
+	Source              []ContainerRegistryImageImportSource `tfschema:"source"`
+	TargetTags          []string                             `tfschema:"target_tags"`
+	Mode                string                               `tfschema:"mode"`
+	Digest              string                               `tfschema:"digest"`
+}
+
+type ContainerRegistryImageImportSource struct {
+	Image               string `tfschema:"image"`
+	RegistryUri         string `tfschema:"registry_uri"`
+	ContainerRegistryId string `tfschema:"container_registry_id"`
+	Username            string `tfschema:"username"`
+	Password            string `tfschema:"password"`
+}
+
+func (r ContainerRegistryImageImportResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"image": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"registry_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.registry_uri", "source.0.container_registry_id"},
+					},
+
+					"container_registry_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: registries.ValidateRegistryID,
+						ExactlyOneOf: []string{"source.0.registry_uri", "source.0.container_registry_id"},
+					},
+
+					"username": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{"source.0.password"},
+					},
+
+					"password": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"target_tags": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ContainerRegistryImageTag,
+			},
+		},
+
+		"mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(registries.ImportModeNoForce),
+			ValidateFunc: validation.StringInSlice(registries.PossibleValuesForImportMode(), false),
+		},
+	}
+}
+
+func (r ContainerRegistryImageImportResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"digest": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerRegistryImageImportResource) ModelObject() interface{} {
+	return &ContainerRegistryImageImportModel{}
+}
+
+func (r ContainerRegistryImageImportResource) ResourceType() string {
+	return "azurerm_container_registry_image_import"
+}
+
+func (r ContainerRegistryImageImportResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerRegistryImageImportID
+}
+
+func (r ContainerRegistryImageImportResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.Registries
+
+			var config ContainerRegistryImageImportModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(config.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			// the first target tag identifies the import, since tags contain `/` it's escaped to form a single segment
+			id := parse.NewContainerRegistryImageImportID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, url.PathEscape(config.TargetTags[0]))
+
+			// there's no existence check here since an import is intentionally allowed to overwrite existing tags
+			// when `mode` is `Force`, otherwise the API rejects the import if a target tag already exists
+			source := config.Source[0]
+			parameters := registries.ImportImageParameters{
+				Mode: pointer.To(registries.ImportMode(config.Mode)),
+				Source: registries.ImportSource{
+					SourceImage: source.Image,
+				},
+				TargetTags: pointer.To(config.TargetTags),
+			}
+			if source.RegistryUri != "" {
+				parameters.Source.RegistryUri = pointer.To(source.RegistryUri)
+			}
+			if source.ContainerRegistryId != "" {
+				parameters.Source.ResourceId = pointer.To(source.ContainerRegistryId)
+			}
+			if source.Password != "" {
+				parameters.Source.Credentials = &registries.ImportSourceCredentials{
+					Password: source.Password,
+				}
+				if source.Username != "" {
+					parameters.Source.Credentials.Username = pointer.To(source.Username)
+				}
+			}
+
+			if err := client.ImportImageThenPoll(ctx, *registryId, parameters); err != nil {
+				return fmt.Errorf("importing %q into %s: %+v", source.Image, registryId, err)
+			}
+
+			metadata.SetID(id)
+			return r.Read().Func(ctx, metadata)
+		},
+	}
+}
+
+func (r ContainerRegistryImageImportResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.Registries
+
+			id, err := parse.ContainerRegistryImageImportID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ContainerRegistryImageImportModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId := registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+			resp, err := client.Get(ctx, registryId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", registryId, err)
+			}
+
+			loginServer := ""
+			if model := resp.Model; model != nil && model.Properties != nil {
+				loginServer = pointer.From(model.Properties.LoginServer)
+			}
+			if loginServer == "" {
+				return fmt.Errorf("retrieving %s: `loginServer` was nil", registryId)
+			}
+
+			state.ContainerRegistryId = registryId.ID()
+			if len(state.TargetTags) == 0 {
+				targetTag, err := url.PathUnescape(id.ImportedImageName)
+				if err != nil {
+					return fmt.Errorf("parsing target tag from %s: %+v", id, err)
+				}
+				state.TargetTags = []string{targetTag}
+			}
+			if state.Mode == "" {
+				state.Mode = string(registries.ImportModeNoForce)
+			}
+
+			manifestsClient := metadata.Client.Containers.RegistryManifestsClient(loginServer)
+			digests := make([]string, 0)
+			for _, targetTag := range state.TargetTags {
+				repository, tag := splitContainerRegistryImageTag(targetTag)
+				digest, err := manifestsClient.GetManifestDigest(ctx, repository, tag)
+				if err != nil {
+					return fmt.Errorf("verifying the manifest for %q in %s: %+v", targetTag, id, err)
+				}
+				if digest == "" {
+					log.Printf("[DEBUG] the manifest for %q was not found in %s - removing from state", targetTag, registryId)
+					return metadata.MarkAsGone(id)
+				}
+				digests = append(digests, digest)
+			}
+			state.Digest = digests[0]
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryImageImportResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the imported images are intentionally retained in the registry, since they may be in use by workloads
+			// and the same manifest may be referenced by tags not managed by Terraform
+			return nil
+		},
+	}
+}
+
+// splitContainerRegistryImageTag splits a `repository:tag` reference into its repository and tag
+func splitContainerRegistryImageTag(input string) (string, string) {
+	idx := strings.LastIndex(input, ":")
+	if idx == -1 {
+		return input, "latest"
+	}
+
+	return input[:idx], input[idx+1:]
+}
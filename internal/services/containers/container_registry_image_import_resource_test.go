@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerRegistryImageImportResource struct{}
+
+func TestAccContainerRegistryImageImport_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_image_import", "test")
+	r := ContainerRegistryImageImportResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("digest").MatchesRegex(regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)),
+			),
+		},
+	})
+}
+
+func TestAccContainerRegistryImageImport_fromContainerRegistry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_image_import", "test")
+	r := ContainerRegistryImageImportResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fromContainerRegistry(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_tags.#").HasValue("2"),
+				check.That(data.ResourceName).Key("digest").MatchesOtherKey(check.That("azurerm_container_registry_image_import.source").Key("digest")),
+			),
+		},
+	})
+}
+
+func (ContainerRegistryImageImportResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerRegistryImageImportID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	registryId := registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+	resp, err := clients.Containers.ContainerRegistryClient.Registries.Get(ctx, registryId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", registryId, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", registryId)
+	}
+
+	targetTag, err := url.PathUnescape(id.ImportedImageName)
+	if err != nil {
+		return nil, err
+	}
+	idx := strings.LastIndex(targetTag, ":")
+
+	digest, err := clients.Containers.RegistryManifestsClient(pointer.From(resp.Model.Properties.LoginServer)).GetManifestDigest(ctx, targetTag[:idx], targetTag[idx+1:])
+	if err != nil {
+		return nil, fmt.Errorf("retrieving manifest for %s: %+v", id, err)
+	}
+
+	return pointer.To(digest != ""), nil
+}
+
+func (ContainerRegistryImageImportResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-import-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r ContainerRegistryImageImportResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_image_import" "test" {
+  container_registry_id = azurerm_container_registry.test.id
+
+  source {
+    registry_uri = "mcr.microsoft.com"
+    image        = "hello-world:latest"
+  }
+
+  target_tags = ["mirror/hello-world:latest"]
+}
+`, r.template(data))
+}
+
+func (r ContainerRegistryImageImportResource) fromContainerRegistry(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry" "target" {
+  name                = "testacccrtarget%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+
+resource "azurerm_container_registry_image_import" "source" {
+  container_registry_id = azurerm_container_registry.test.id
+
+  source {
+    registry_uri = "mcr.microsoft.com"
+    image        = "hello-world:latest"
+  }
+
+  target_tags = ["hello-world:latest"]
+}
+
+resource "azurerm_container_registry_image_import" "test" {
+  container_registry_id = azurerm_container_registry.target.id
+
+  source {
+    container_registry_id = azurerm_container_registry.test.id
+    image                 = azurerm_container_registry_image_import.source.target_tags.0
+  }
+
+  target_tags = ["hello-world:latest", "hello-world:stable"]
+  mode        = "Force"
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+const (
+	kubernetesClusterPowerActionStart = "start"
+	kubernetesClusterPowerActionStop  = "stop"
+)
+
+var _ sdk.Action = &KubernetesClusterPowerAction{}
+
+func NewKubernetesClusterPowerAction() action.Action {
+	return &KubernetesClusterPowerAction{}
+}
+
+type KubernetesClusterPowerAction struct {
+	sdk.ActionMetadata
+}
+
+type KubernetesClusterPowerActionModel struct {
+	KubernetesClusterId types.String `tfsdk:"kubernetes_cluster_id"`
+	PowerAction         types.String `tfsdk:"power_action"`
+}
+
+func (a *KubernetesClusterPowerAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "azurerm_kubernetes_cluster_power"
+}
+
+func (a *KubernetesClusterPowerAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.Defaults(req, resp)
+}
+
+func (a *KubernetesClusterPowerAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts or stops a Kubernetes Cluster.",
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Kubernetes Cluster.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+				},
+			},
+
+			"power_action": schema.StringAttribute{
+				Required:    true,
+				Description: "The power action to perform on the Kubernetes Cluster. Possible values are `start` and `stop`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						kubernetesClusterPowerActionStart,
+						kubernetesClusterPowerActionStop,
+					),
+				},
+			},
+		},
+	}
+}
+
+func (a *KubernetesClusterPowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	client := a.Client.Containers.KubernetesClustersClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*90)
+	defer cancel()
+
+	var data KubernetesClusterPowerActionModel
+	if ok := a.DecodeInvoke(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseKubernetesClusterID(data.KubernetesClusterId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing `kubernetes_cluster_id`", err)
+		return
+	}
+
+	powerAction := data.PowerAction.ValueString()
+	a.SendProgress(resp, fmt.Sprintf("performing %q on %s..", powerAction, id))
+
+	switch powerAction {
+	case kubernetesClusterPowerActionStart:
+		err = client.StartThenPoll(ctx, *id)
+	case kubernetesClusterPowerActionStop:
+		err = client.StopThenPoll(ctx, *id)
+	}
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("performing %q on %s", powerAction, id), err)
+		return
+	}
+
+	a.SendProgress(resp, fmt.Sprintf("completed %q on %s", powerAction, id))
+}
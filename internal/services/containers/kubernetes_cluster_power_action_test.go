@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
+)
+
+type KubernetesClusterPowerAction struct{}
+
+func TestAccKubernetesClusterPowerAction_stop(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_power", "test")
+	a := KubernetesClusterPowerAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: a.stop(data),
+			},
+		},
+	})
+}
+
+func TestKubernetesClusterPowerAction_validation(t *testing.T) {
+	testData := []struct {
+		attribute string
+		value     string
+		valid     bool
+	}{
+		{
+			attribute: "kubernetes_cluster_id",
+			value:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1",
+			valid:     true,
+		},
+		{
+			attribute: "kubernetes_cluster_id",
+			value:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1/agentPools/pool1",
+			valid:     false,
+		},
+		{
+			attribute: "power_action",
+			value:     "start",
+			valid:     true,
+		},
+		{
+			attribute: "power_action",
+			value:     "stop",
+			valid:     true,
+		},
+		{
+			attribute: "power_action",
+			value:     "Stop",
+			valid:     false,
+		},
+		{
+			attribute: "power_action",
+			value:     "restart",
+			valid:     false,
+		},
+	}
+
+	s := KubernetesClusterPowerAction{}.schema()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q = %q", v.attribute, v.value)
+
+		resp := validator.StringResponse{}
+		for _, f := range s.Attributes[v.attribute].(schema.StringAttribute).Validators {
+			f.ValidateString(context.TODO(), validator.StringRequest{
+				Path:        path.Root(v.attribute),
+				ConfigValue: types.StringValue(v.value),
+			}, &resp)
+		}
+
+		if resp.Diagnostics.HasError() == v.valid {
+			t.Fatalf("expected valid to be %t but got diagnostics %+v", v.valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestKubernetesClusterPowerAction_invokeInvalidId(t *testing.T) {
+	ctx := context.TODO()
+	s := KubernetesClusterPowerAction{}.schema()
+	req := action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: s,
+			Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"kubernetes_cluster_id": tftypes.NewValue(tftypes.String, "cluster1"),
+				"power_action":          tftypes.NewValue(tftypes.String, "stop"),
+			}),
+		},
+	}
+
+	progress := make([]string, 0)
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+
+	a := containers.KubernetesClusterPowerAction{
+		ActionMetadata: sdk.ActionMetadata{
+			Client: &clients.Client{
+				Containers: &client.Client{},
+			},
+		},
+	}
+	a.Invoke(ctx, req, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected an error diagnostic for an invalid `kubernetes_cluster_id`")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "parsing `kubernetes_cluster_id`" {
+		t.Fatalf("expected the summary to be %q but got %q", "parsing `kubernetes_cluster_id`", summary)
+	}
+	if len(progress) != 0 {
+		t.Fatalf("expected no progress to be reported but got %+v", progress)
+	}
+}
+
+func (KubernetesClusterPowerAction) schema() schema.Schema {
+	resp := action.SchemaResponse{}
+	containers.NewKubernetesClusterPowerAction().Schema(context.TODO(), action.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+func (KubernetesClusterPowerAction) stop(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_kubernetes_cluster_power" "test" {
+  config {
+    kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+    power_action          = "stop"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_kubernetes_cluster.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_kubernetes_cluster_power.test]
+    }
+  }
+}
+`, KubernetesClusterResource{}.basicVMSSConfig(data))
+}
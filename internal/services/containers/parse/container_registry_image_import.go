@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ContainerRegistryImageImportId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	RegistryName      string
+	ImportedImageName string
+}
+
+func NewContainerRegistryImageImportID(subscriptionId, resourceGroup, registryName, importedImageName string) ContainerRegistryImageImportId {
+	return ContainerRegistryImageImportId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		RegistryName:      registryName,
+		ImportedImageName: importedImageName,
+	}
+}
+
+func (id ContainerRegistryImageImportId) String() string {
+	segments := []string{
+		fmt.Sprintf("Imported Image Name %q", id.ImportedImageName),
+		fmt.Sprintf("Registry Name %q", id.RegistryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Container Registry Image Import", segmentsStr)
+}
+
+func (id ContainerRegistryImageImportId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s/importedImages/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.ImportedImageName)
+}
+
+// ContainerRegistryImageImportID parses a ContainerRegistryImageImport ID into an ContainerRegistryImageImportId struct
+func ContainerRegistryImageImportID(input string) (*ContainerRegistryImageImportId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ContainerRegistryImageImport ID: %+v", input, err)
+	}
+
+	resourceId := ContainerRegistryImageImportId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RegistryName, err = id.PopSegment("registries"); err != nil {
+		return nil, err
+	}
+	if resourceId.ImportedImageName, err = id.PopSegment("importedImages"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// kubernetesClusterNodePoolPreflightValidation validates the VM Size and Zones of a Kubernetes Cluster Node Pool
+// during the plan, when the `preflight_validation` feature is enabled.
+//
+// NOTE: a Node Pool is a child resource of the Kubernetes Cluster, which the Template Deployment validation API can't
+// meaningfully validate in isolation - as such only the availability of the VM Size is checked.
+func kubernetesClusterNodePoolPreflightValidation(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client) error {
+	if !preflight.ValuesKnown(d, "kubernetes_cluster_id", "vm_size", "zones") {
+		return nil
+	}
+
+	vmSize := d.Get("vm_size").(string)
+	if vmSize == "" {
+		// the VM Size is selected by the service when omitted
+		return nil
+	}
+
+	clusterId, err := commonids.ParseKubernetesClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	cluster, err := client.Containers.KubernetesClustersClient.Get(ctx, *clusterId)
+	if err != nil || cluster.Model == nil {
+		log.Printf("[DEBUG] Skipping Preflight Validation of the Node Pool since the Location of %s couldn't be determined: %+v", *clusterId, err)
+		//nolint:nilerr // preflight validation is best-effort, so we skip the check rather than failing the plan
+		return nil
+	}
+
+	nodePoolZones := zones.ExpandUntyped(d.Get("zones").(*pluginsdk.Set).List())
+	return preflight.CheckVirtualMachineSize(ctx, client, cluster.Model.Location, vmSize, nodePoolZones)
+}
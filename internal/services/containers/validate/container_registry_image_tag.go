@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ContainerRegistryImageTag validates an image reference in the form `repository:tag`, e.g. `library/nginx:1.27`
+func ContainerRegistryImageTag(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-z0-9]+([._-][a-z0-9]+)*(/[a-z0-9]+([._-][a-z0-9]+)*)*:[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be in the format `repository:tag` using lowercase alphanumeric characters, separators (`.`, `_`, `-`) and `/` for the repository, got %q", k, value))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryImageTag(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "nginx",
+			ErrCount: 1,
+		},
+		{
+			Value:    "nginx:latest",
+			ErrCount: 0,
+		},
+		{
+			Value:    "library/nginx:1.27.0-alpine",
+			ErrCount: 0,
+		},
+		{
+			Value:    "mirror/k8s.gcr.io/pause:3_9",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Library/nginx:latest",
+			ErrCount: 1,
+		},
+		{
+			Value:    "library//nginx:latest",
+			ErrCount: 1,
+		},
+		{
+			Value:    "nginx:.latest",
+			ErrCount: 1,
+		},
+		{
+			Value:    "nginx@sha256:0d17b565c37bcbd895e9d92315a05c1c3c9a29f762b011a10c54a66cd53c9b31",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryImageTag(tc.Value, "target_tags")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("expected %d errors for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
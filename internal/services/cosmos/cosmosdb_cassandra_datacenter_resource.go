@@ -5,6 +5,7 @@ package cosmos
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -75,9 +77,10 @@ func resourceCassandraDatacenter() *pluginsdk.Resource {
 			},
 
 			"base64_encoded_yaml_fragment": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressCassandraYamlFragmentDiff,
 			},
 
 			"disk_sku": {
@@ -336,3 +339,18 @@ func flattenCassandraDatacenterSeedNodes(input *[]managedcassandras.SeedNode) []
 
 	return results
 }
+
+// suppressCassandraYamlFragmentDiff suppresses the diff between two Base64 encoded YAML fragments which are semantically
+// equal, for example where only the formatting or ordering of the keys differs
+func suppressCassandraYamlFragmentDiff(k, old, new string, d *pluginsdk.ResourceData) bool {
+	oldFragment, err := base64.StdEncoding.DecodeString(old)
+	if err != nil {
+		return false
+	}
+	newFragment, err := base64.StdEncoding.DecodeString(new)
+	if err != nil {
+		return false
+	}
+
+	return suppress.YamlDiff(k, string(oldFragment), string(newFragment), d)
+}
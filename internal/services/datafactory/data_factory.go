@@ -225,10 +225,6 @@ func flattenDataFactorySnowflakeSchemaColumns(input interface{}) []interface{} {
 	return output
 }
 
-func suppressJsonOrderingDifference(_, old, new string, _ *pluginsdk.ResourceData) bool {
-	return utils.NormalizeJson(old) == utils.NormalizeJson(new)
-}
-
 func expandAzureKeyVaultSecretReference(input []interface{}) *datafactory.AzureKeyVaultSecretReference {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -87,7 +88,7 @@ func resourceDataFactoryCustomDataset() *pluginsdk.Resource {
 				Type:             pluginsdk.TypeString,
 				Required:         true,
 				StateFunc:        utils.NormalizeJson,
-				DiffSuppressFunc: suppressJsonOrderingDifference,
+				DiffSuppressFunc: suppress.JsonDiff,
 			},
 
 			"additional_properties": {
@@ -130,7 +131,7 @@ func resourceDataFactoryCustomDataset() *pluginsdk.Resource {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
 				StateFunc:        utils.NormalizeJson,
-				DiffSuppressFunc: suppressJsonOrderingDifference,
+				DiffSuppressFunc: suppress.JsonDiff,
 			},
 		},
 	}
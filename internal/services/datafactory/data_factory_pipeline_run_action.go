@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datafactory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/pipelines"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+)
+
+var _ sdk.Action = &DataFactoryPipelineRunAction{}
+
+func NewDataFactoryPipelineRunAction() action.Action {
+	return &DataFactoryPipelineRunAction{}
+}
+
+type DataFactoryPipelineRunAction struct {
+	sdk.ActionMetadata
+}
+
+type DataFactoryPipelineRunActionModel struct {
+	DataFactoryPipelineId types.String `tfsdk:"data_factory_pipeline_id"`
+	Parameters            types.Map    `tfsdk:"parameters"`
+}
+
+func (a *DataFactoryPipelineRunAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "azurerm_data_factory_pipeline_run"
+}
+
+func (a *DataFactoryPipelineRunAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.Defaults(req, resp)
+}
+
+func (a *DataFactoryPipelineRunAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers a run of a Data Factory Pipeline.",
+		Attributes: map[string]schema.Attribute{
+			"data_factory_pipeline_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Data Factory Pipeline.",
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: pipelines.ValidatePipelineID,
+					},
+				},
+			},
+
+			"parameters": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "A mapping of parameter names to values which should be passed to the Pipeline Run.",
+			},
+		},
+	}
+}
+
+func (a *DataFactoryPipelineRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	client := a.Client.DataFactory.PipelinesClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data DataFactoryPipelineRunActionModel
+	if ok := a.DecodeInvoke(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := pipelines.ParsePipelineID(data.DataFactoryPipelineId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing `data_factory_pipeline_id`", err)
+		return
+	}
+
+	parameters := make(map[string]string)
+	if !data.Parameters.IsNull() {
+		resp.Diagnostics.Append(data.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	payload := make(map[string]interface{}, len(parameters))
+	for k, v := range parameters {
+		payload[k] = v
+	}
+
+	result, err := client.CreateRun(ctx, *id, payload, pipelines.DefaultCreateRunOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("creating a run for %s", id), err)
+		return
+	}
+
+	if result.Model != nil {
+		a.SendProgress(resp, fmt.Sprintf("created Pipeline Run %q for %s", result.Model.RunId, id))
+	}
+}
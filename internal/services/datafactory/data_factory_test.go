@@ -3,7 +3,11 @@
 
 package datafactory
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+)
 
 func TestDataFactoryLinkedServiceConnectionStringDiff(t *testing.T) {
 	cases := []struct {
@@ -110,10 +114,10 @@ func TestNormalizeJSON(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		suppress := suppressJsonOrderingDifference("test", tc.Old, tc.New, nil)
+		suppressed := suppress.JsonDiff("test", tc.Old, tc.New, nil)
 
-		if suppress != tc.Suppress {
-			t.Fatalf("Expected JsonOrderingDifference to be '%t' for '%s' '%s' - got '%t'", tc.Suppress, tc.Old, tc.New, suppress)
+		if suppressed != tc.Suppress {
+			t.Fatalf("Expected JsonDiff to be '%t' for '%s' '%s' - got '%t'", tc.Suppress, tc.Old, tc.New, suppressed)
 		}
 	}
 }
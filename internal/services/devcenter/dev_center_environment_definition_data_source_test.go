@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DevCenterEnvironmentDefinitionDataSource struct{}
+
+func TestAccDevCenterEnvironmentDefinitionDataSource_devCenterCatalog(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dev_center_environment_definition", "test")
+	r := DevCenterEnvironmentDefinitionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.devCenterCatalog(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("template_path").Exists(),
+				check.That(data.ResourceName).Key("validation_status").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDevCenterEnvironmentDefinitionDataSource_projectCatalog(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dev_center_environment_definition", "test")
+	r := DevCenterEnvironmentDefinitionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.projectCatalog(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("template_path").Exists(),
+				check.That(data.ResourceName).Key("validation_status").Exists(),
+			),
+		},
+	})
+}
+
+func (d DevCenterEnvironmentDefinitionDataSource) devCenterCatalog(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dev_center_environment_definition" "test" {
+  name                  = "AppConfig"
+  dev_center_catalog_id = azurerm_dev_center_catalog.test.id
+}
+`, DevCenterCatalogsResource{}.basic(data))
+}
+
+func (d DevCenterEnvironmentDefinitionDataSource) projectCatalog(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dev_center_environment_definition" "test" {
+  name                          = "AppConfig"
+  dev_center_project_catalog_id = azurerm_dev_center_project_catalog.test.id
+}
+`, DevCenterProjectCatalogTestResource{}.basic(data))
+}
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/projectpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/projects"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = DevCenterProjectPolicyResource{}
+	_ sdk.ResourceWithUpdate = DevCenterProjectPolicyResource{}
+)
+
+type DevCenterProjectPolicyResource struct{}
+
+func (r DevCenterProjectPolicyResource) ModelObject() interface{} {
+	return &DevCenterProjectPolicyResourceModel{}
+}
+
+type DevCenterProjectPolicyResourceModel struct {
+	Name                string                                 `tfschema:"name"`
+	DevCenterId         string                                 `tfschema:"dev_center_id"`
+	DevCenterProjectIds []string                               `tfschema:"dev_center_project_ids"`
+	ResourcePolicy      []DevCenterProjectPolicyResourcePolicy `tfschema:"resource_policy"`
+}
+
+type DevCenterProjectPolicyResourcePolicy struct {
+	Action       string `tfschema:"action"`
+	Filter       string `tfschema:"filter"`
+	ResourceId   string `tfschema:"resource_id"`
+	ResourceType string `tfschema:"resource_type"`
+}
+
+func (r DevCenterProjectPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return projectpolicies.ValidateProjectPolicyID
+}
+
+func (r DevCenterProjectPolicyResource) ResourceType() string {
+	return "azurerm_dev_center_project_policy"
+}
+
+func (r DevCenterProjectPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterProjectPolicyName,
+		},
+
+		"dev_center_id": commonschema.ResourceIDReferenceRequiredForceNew(&projectpolicies.DevCenterId{}),
+
+		"dev_center_project_ids": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: projects.ValidateProjectID,
+			},
+		},
+
+		"resource_policy": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"resource_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(projectpolicies.PossibleValuesForDevCenterResourceType(), false),
+					},
+
+					"action": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(projectpolicies.PossibleValuesForPolicyAction(), false),
+					},
+
+					"filter": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DevCenterProjectPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterProjectPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ProjectPolicies
+
+			var model DevCenterProjectPolicyResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			devCenterId, err := projectpolicies.ParseDevCenterID(model.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := projectpolicies.NewProjectPolicyID(devCenterId.SubscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := projectpolicies.ProjectPolicy{
+				Properties: &projectpolicies.ProjectPolicyProperties{
+					ResourcePolicies: expandDevCenterProjectPolicyResourcePolicies(model.ResourcePolicy),
+					Scopes:           pointer.To(model.DevCenterProjectIds),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ProjectPolicies
+
+			id, err := projectpolicies.ParseProjectPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterProjectPolicyResourceModel{
+				Name:        id.ProjectPolicyName,
+				DevCenterId: projectpolicies.NewDevCenterID(id.SubscriptionId, id.ResourceGroupName, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ResourcePolicy = flattenDevCenterProjectPolicyResourcePolicies(props.ResourcePolicies)
+
+					projectIds, err := flattenDevCenterProjectPolicyScopes(props.Scopes)
+					if err != nil {
+						return err
+					}
+					state.DevCenterProjectIds = projectIds
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterProjectPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ProjectPolicies
+
+			id, err := projectpolicies.ParseProjectPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterProjectPolicyResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := projectpolicies.ProjectPolicyUpdate{
+				Properties: &projectpolicies.ProjectPolicyUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("dev_center_project_ids") {
+				parameters.Properties.Scopes = pointer.To(model.DevCenterProjectIds)
+			}
+
+			if metadata.ResourceData.HasChange("resource_policy") {
+				parameters.Properties.ResourcePolicies = expandDevCenterProjectPolicyResourcePolicies(model.ResourcePolicy)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ProjectPolicies
+
+			id, err := projectpolicies.ParseProjectPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterProjectPolicyResourcePolicies(input []DevCenterProjectPolicyResourcePolicy) *[]projectpolicies.ResourcePolicy {
+	result := make([]projectpolicies.ResourcePolicy, 0)
+
+	for _, v := range input {
+		policy := projectpolicies.ResourcePolicy{}
+
+		if v.ResourceId != "" {
+			policy.Resources = pointer.To(v.ResourceId)
+		}
+
+		if v.ResourceType != "" {
+			policy.ResourceType = pointer.To(projectpolicies.DevCenterResourceType(v.ResourceType))
+		}
+
+		if v.Action != "" {
+			policy.Action = pointer.To(projectpolicies.PolicyAction(v.Action))
+		}
+
+		if v.Filter != "" {
+			policy.Filter = pointer.To(v.Filter)
+		}
+
+		result = append(result, policy)
+	}
+
+	return &result
+}
+
+func flattenDevCenterProjectPolicyResourcePolicies(input *[]projectpolicies.ResourcePolicy) []DevCenterProjectPolicyResourcePolicy {
+	result := make([]DevCenterProjectPolicyResourcePolicy, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, DevCenterProjectPolicyResourcePolicy{
+			Action:       string(pointer.From(v.Action)),
+			Filter:       pointer.From(v.Filter),
+			ResourceId:   pointer.From(v.Resources),
+			ResourceType: string(pointer.From(v.ResourceType)),
+		})
+	}
+
+	return result
+}
+
+func flattenDevCenterProjectPolicyScopes(input *[]string) ([]string, error) {
+	result := make([]string, 0)
+	if input == nil {
+		return result, nil
+	}
+
+	for _, v := range *input {
+		projectId, err := projects.ParseProjectIDInsensitively(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, projectId.ID())
+	}
+
+	return result, nil
+}
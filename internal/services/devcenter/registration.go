@@ -28,6 +28,7 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		DevCenterDataSource{},
+		DevCenterEnvironmentDefinitionDataSource{},
 		DevCenterNetworkConnectionDataSource{},
 		DevCenterProjectDataSource{},
 		DevCenterProjectEnvironmentTypeDataSource{},
@@ -42,7 +43,10 @@ func (r Registration) Resources() []sdk.Resource {
 		DevCenterDevBoxDefinitionResource{},
 		DevCenterEnvironmentTypeResource{},
 		DevCenterNetworkConnectionResource{},
+		DevCenterProjectCatalogResource{},
 		DevCenterProjectPoolResource{},
+		DevCenterProjectPoolScheduleResource{},
+		DevCenterProjectPolicyResource{},
 		DevCenterProjectResource{},
 		DevCenterProjectEnvironmentTypeResource{},
 		DevCenterResource{},
@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func DevCenterProjectPoolScheduleTime(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$").MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a time in the format `HH:mm` using the 24-hour clock", k))
+	}
+
+	return warnings, errors
+}
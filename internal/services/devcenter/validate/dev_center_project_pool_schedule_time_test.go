@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"testing"
+)
+
+func TestDevCenterProjectPoolScheduleTime(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "1:00",
+			Expected: false,
+		},
+		{
+			Input:    "00:00",
+			Expected: true,
+		},
+		{
+			Input:    "09:30",
+			Expected: true,
+		},
+		{
+			Input:    "19:00",
+			Expected: true,
+		},
+		{
+			Input:    "23:59",
+			Expected: true,
+		},
+		{
+			Input:    "24:00",
+			Expected: false,
+		},
+		{
+			Input:    "12:60",
+			Expected: false,
+		},
+		{
+			Input:    "12:5",
+			Expected: false,
+		},
+		{
+			Input:    "12:00:00",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		_, errors := DevCenterProjectPoolScheduleTime(v.Input, "time")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %t but got %t (and %d errors)", v.Expected, result, len(errors))
+		}
+	}
+}
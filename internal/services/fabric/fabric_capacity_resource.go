@@ -29,6 +29,7 @@ type FabricCapacityResourceModel struct {
 	AdministrationMembers []string          `tfschema:"administration_members"`
 	Location              string            `tfschema:"location"`
 	Sku                   []SkuModel        `tfschema:"sku"`
+	State                 string            `tfschema:"state"`
 	Tags                  map[string]string `tfschema:"tags"`
 }
 
@@ -108,6 +109,16 @@ func (r FabricCapacityResource) Arguments() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(fabriccapacities.ResourceStateActive),
+				string(fabriccapacities.ResourceStatePaused),
+			}, false),
+		},
+
 		"tags": commonschema.Tags(),
 	}
 }
@@ -160,6 +171,12 @@ func (r FabricCapacityResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
+			if model.State == string(fabriccapacities.ResourceStatePaused) {
+				if err := client.SuspendThenPoll(ctx, id); err != nil {
+					return fmt.Errorf("suspending %s: %+v", id, err)
+				}
+			}
+
 			metadata.SetID(id)
 			return nil
 		},
@@ -206,8 +223,28 @@ func (r FabricCapacityResource) Update() sdk.ResourceFunc {
 				payload.Tags = pointer.To(model.Tags)
 			}
 
-			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
-				return fmt.Errorf("updating %s: %+v", *id, err)
+			requiresUpdate := metadata.ResourceData.HasChanges("administration_members", "sku", "tags")
+			currentlyPaused := pointer.From(existing.Model.Properties.State) == fabriccapacities.ResourceStatePaused
+			resume, suspend := fabricCapacityStateTransitions(currentlyPaused, model.State == string(fabriccapacities.ResourceStatePaused), requiresUpdate)
+
+			// a paused capacity needs to be resumed before it can be updated, after which it's suspended again if
+			// it should remain paused
+			if resume {
+				if err := client.ResumeThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("resuming %s: %+v", *id, err)
+				}
+			}
+
+			if requiresUpdate {
+				if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if suspend {
+				if err := client.SuspendThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("suspending %s: %+v", *id, err)
+				}
 			}
 
 			return nil
@@ -244,6 +281,7 @@ func (r FabricCapacityResource) Read() sdk.ResourceFunc {
 				state.Location = location.Normalize(model.Location)
 				state.AdministrationMembers = model.Properties.Administration.Members
 				state.Sku = flattenSkuModel(model.Sku)
+				state.State = string(pointer.From(model.Properties.State))
 				state.Tags = pointer.From(model.Tags)
 			}
 
@@ -272,6 +310,14 @@ func (r FabricCapacityResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+// fabricCapacityStateTransitions returns whether the capacity needs to be resumed before, and/or suspended after,
+// any update - since a paused capacity must be resumed to be updated, even when it should remain paused.
+func fabricCapacityStateTransitions(currentlyPaused bool, shouldBePaused bool, requiresUpdate bool) (resume bool, suspend bool) {
+	resume = currentlyPaused && (requiresUpdate || !shouldBePaused)
+	suspend = shouldBePaused && (resume || !currentlyPaused)
+	return resume, suspend
+}
+
 func expandSkuModel(inputList []SkuModel) fabriccapacities.RpSku {
 	input := &inputList[0]
 	return fabriccapacities.RpSku{
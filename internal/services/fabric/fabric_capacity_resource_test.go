@@ -95,6 +95,62 @@ func TestAccFabricCapacity_update(t *testing.T) {
 	})
 }
 
+func TestAccFabricCapacity_state(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_fabric_capacity", "test")
+	r := FabricCapacityResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.state(data, "Paused"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Paused"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.state(data, "Active"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Active"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.state(data, "Paused"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Paused"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFabricCapacity_updateWhilePaused(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_fabric_capacity", "test")
+	r := FabricCapacityResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.state(data, "Paused"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Paused"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.pausedWithTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("Paused"),
+				check.That(data.ResourceName).Key("sku.0.name").HasValue("F4"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r FabricCapacityResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := fabriccapacities.ParseCapacityID(state.ID)
 	if err != nil {
@@ -208,3 +264,47 @@ resource "azurerm_fabric_capacity" "test" {
 }
 `, template, data.RandomInteger, data.Locations.Primary)
 }
+
+func (r FabricCapacityResource) state(data acceptance.TestData, state string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_fabric_capacity" "test" {
+  name                   = "acctestffc%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = "%s"
+  administration_members = [data.azurerm_client_config.current.object_id]
+  state                  = "%s"
+
+  sku {
+    name = "F2"
+    tier = "Fabric"
+  }
+}
+`, template, data.RandomInteger, data.Locations.Primary, state)
+}
+
+func (r FabricCapacityResource) pausedWithTags(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_fabric_capacity" "test" {
+  name                   = "acctestffc%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = "%s"
+  administration_members = [data.azurerm_client_config.current.object_id]
+  state                  = "Paused"
+
+  sku {
+    name = "F4"
+    tier = "Fabric"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, template, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fabric
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/fabric/2023-11-01/fabriccapacities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FabricCapacitySkusDataSource struct{}
+
+var _ sdk.DataSource = FabricCapacitySkusDataSource{}
+
+type FabricCapacitySkusDataSourceModel struct {
+	Location string   `tfschema:"location"`
+	SkuNames []string `tfschema:"sku_names"`
+}
+
+func (d FabricCapacitySkusDataSource) ModelObject() interface{} {
+	return &FabricCapacitySkusDataSourceModel{}
+}
+
+func (d FabricCapacitySkusDataSource) ResourceType() string {
+	return "azurerm_fabric_capacity_skus"
+}
+
+func (d FabricCapacitySkusDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+	}
+}
+
+func (d FabricCapacitySkusDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"sku_names": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (d FabricCapacitySkusDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Fabric.FabricCapacitiesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state FabricCapacitySkusDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := fabriccapacities.NewLocationID(subscriptionId, location.Normalize(state.Location))
+
+			resp, err := client.ListSkusComplete(ctx, commonids.NewSubscriptionID(subscriptionId))
+			if err != nil {
+				return fmt.Errorf("listing the Fabric Capacity SKUs for %s: %+v", id, err)
+			}
+
+			state.Location = location.Normalize(state.Location)
+			state.SkuNames = filterFabricCapacitySkusByLocation(resp.Items, state.Location)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// filterFabricCapacitySkusByLocation returns the names of the SKUs which are available within the specified location
+func filterFabricCapacitySkusByLocation(input []fabriccapacities.RpSkuDetailsForNewResource, locationName string) []string {
+	output := make([]string, 0)
+
+	for _, sku := range input {
+		for _, v := range sku.Locations {
+			if location.Normalize(v) == locationName {
+				output = append(output, sku.Name)
+				break
+			}
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fabric_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type FabricCapacitySkusDataSource struct{}
+
+func TestAccFabricCapacitySkusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_fabric_capacity_skus", "test")
+	r := FabricCapacitySkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sku_names.0").Exists(),
+			),
+		},
+	})
+}
+
+func (d FabricCapacitySkusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_fabric_capacity_skus" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
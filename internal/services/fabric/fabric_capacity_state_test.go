@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fabric
+
+import "testing"
+
+func TestFabricCapacityStateTransitions(t *testing.T) {
+	cases := []struct {
+		Name            string
+		CurrentlyPaused bool
+		ShouldBePaused  bool
+		RequiresUpdate  bool
+		Resume          bool
+		Suspend         bool
+	}{
+		{
+			Name: "active, no changes",
+		},
+		{
+			Name:           "active, updated",
+			RequiresUpdate: true,
+		},
+		{
+			Name:           "active to paused",
+			ShouldBePaused: true,
+			Suspend:        true,
+		},
+		{
+			Name:           "active to paused, updated",
+			ShouldBePaused: true,
+			RequiresUpdate: true,
+			Suspend:        true,
+		},
+		{
+			Name:            "paused to active",
+			CurrentlyPaused: true,
+			Resume:          true,
+		},
+		{
+			Name:            "paused to active, updated",
+			CurrentlyPaused: true,
+			RequiresUpdate:  true,
+			Resume:          true,
+		},
+		{
+			Name:            "paused, no changes",
+			CurrentlyPaused: true,
+			ShouldBePaused:  true,
+		},
+		{
+			Name:            "paused, updated",
+			CurrentlyPaused: true,
+			ShouldBePaused:  true,
+			RequiresUpdate:  true,
+			Resume:          true,
+			Suspend:         true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		resume, suspend := fabricCapacityStateTransitions(tc.CurrentlyPaused, tc.ShouldBePaused, tc.RequiresUpdate)
+		if resume != tc.Resume {
+			t.Fatalf("expected resume to be %t but got %t", tc.Resume, resume)
+		}
+		if suspend != tc.Suspend {
+			t.Fatalf("expected suspend to be %t but got %t", tc.Suspend, suspend)
+		}
+	}
+}
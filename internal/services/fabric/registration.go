@@ -22,7 +22,9 @@ func (r Registration) Name() string {
 
 // DataSources returns a list of Data Sources supported by this Service
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		FabricCapacitySkusDataSource{},
+	}
 }
 
 // Resources returns a list of Resources supported by this Service
@@ -0,0 +1,562 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/ipgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// firewallPolicyRuleAnalysisTimeout is the maximum amount of time spent resolving the IP Groups referenced by the
+// rules during the plan, after which the IP Groups are compared by ID rather than by the addresses they contain.
+const firewallPolicyRuleAnalysisTimeout = 2 * time.Minute
+
+type firewallPolicyRuleType string
+
+const (
+	firewallPolicyRuleTypeApplication firewallPolicyRuleType = "Application"
+	firewallPolicyRuleTypeNat         firewallPolicyRuleType = "NAT"
+	firewallPolicyRuleTypeNetwork     firewallPolicyRuleType = "Network"
+)
+
+// firewallPolicyRuleTypeEvaluationOrder is the order in which Azure Firewall processes the different types of rule
+// collection - DNAT rules are processed first, then Network rules and finally Application rules.
+var firewallPolicyRuleTypeEvaluationOrder = map[firewallPolicyRuleType]int{
+	firewallPolicyRuleTypeNat:         0,
+	firewallPolicyRuleTypeNetwork:     1,
+	firewallPolicyRuleTypeApplication: 2,
+}
+
+// firewallPolicyAnalysisRule is the normalised form of a rule within a Rule Collection Group, which is used to
+// compare the traffic matched by rules of the same type.
+type firewallPolicyAnalysisRule struct {
+	Type               firewallPolicyRuleType
+	CollectionName     string
+	CollectionPriority int
+	Action             string
+	Name               string
+
+	// Protocols contains the protocols matched by the rule (e.g. `TCP` or `Https:443`)
+	Protocols []string
+
+	// Sources contains the source IP Addresses, CIDRs and Ranges - including the contents of any IP Groups
+	Sources []string
+
+	// DestinationAddresses contains the destination IP Addresses, CIDRs and Ranges - including the contents of any IP Groups
+	DestinationAddresses []string
+
+	// DestinationNames contains the FQDNs, URLs, FQDN Tags and Web Categories matched by the rule, prefixed with their kind
+	DestinationNames []string
+
+	// DestinationPorts contains the destination ports and port ranges matched by the rule
+	DestinationPorts []string
+}
+
+func (r firewallPolicyAnalysisRule) String() string {
+	return fmt.Sprintf("%s rule %q in the rule collection %q", r.Type, r.Name, r.CollectionName)
+}
+
+// firewallPolicyRuleAnalysisCustomizeDiff analyses the rules within the Rule Collection Group when the `rule_analysis_enabled`
+// feature is enabled, returning the findings as an error unless `fail_on_rule_analysis_findings` is set to `false`. Since the
+// Plugin SDK doesn't support returning warnings from a CustomizeDiff, the findings are otherwise only logged.
+//
+// Only the rules within this Rule Collection Group are analysed - rules in other Rule Collection Groups of the same
+// Firewall Policy (which are evaluated by priority) aren't retrieved, so shadowing across Rule Collection Groups isn't detected.
+func firewallPolicyRuleAnalysisCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || !client.Features.FirewallPolicy.RuleAnalysisEnabled {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges("application_rule_collection", "network_rule_collection", "nat_rule_collection") {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, firewallPolicyRuleAnalysisTimeout)
+	defer cancel()
+
+	resolver := newFirewallPolicyIPGroupResolver(ctx, client.Network.Client.IPGroups)
+	rules := make([]firewallPolicyAnalysisRule, 0)
+	rules = append(rules, expandFirewallPolicyAnalysisRules(firewallPolicyRuleTypeApplication, d.Get("application_rule_collection").([]interface{}), resolver)...)
+	rules = append(rules, expandFirewallPolicyAnalysisRules(firewallPolicyRuleTypeNetwork, d.Get("network_rule_collection").([]interface{}), resolver)...)
+	rules = append(rules, expandFirewallPolicyAnalysisRules(firewallPolicyRuleTypeNat, d.Get("nat_rule_collection").([]interface{}), resolver)...)
+
+	findings := analyseFirewallPolicyRules(rules)
+	if len(findings) == 0 {
+		return nil
+	}
+
+	if client.Features.FirewallPolicy.FailOnRuleAnalysisFindings {
+		return fmt.Errorf("rule analysis for the Firewall Policy Rule Collection Group %q found %d issue(s):\n\n* %s\n\nThese findings can instead be logged as warnings by setting `fail_on_rule_analysis_findings` to `false`, or rule analysis can be disabled by setting `rule_analysis_enabled` to `false`, within the `firewall_policy` block of the Provider `features` block", d.Get("name").(string), len(findings), strings.Join(findings, "\n* "))
+	}
+
+	for _, finding := range findings {
+		log.Printf("[WARN] Firewall Policy Rule Collection Group %q: %s", d.Get("name").(string), finding)
+	}
+	log.Printf("[WARN] Rule analysis for the Firewall Policy Rule Collection Group %q found %d issue(s) - these are only logged since `fail_on_rule_analysis_findings` is set to `false` within the `firewall_policy` block of the Provider `features` block", d.Get("name").(string), len(findings))
+
+	return nil
+}
+
+// firewallPolicyIPGroupResolver retrieves (and caches) the IP Addresses contained within the IP Groups referenced by the rules
+type firewallPolicyIPGroupResolver struct {
+	ctx    context.Context
+	client *ipgroups.IPGroupsClient
+	cache  map[string][]string
+}
+
+func newFirewallPolicyIPGroupResolver(ctx context.Context, client *ipgroups.IPGroupsClient) *firewallPolicyIPGroupResolver {
+	return &firewallPolicyIPGroupResolver{
+		ctx:    ctx,
+		client: client,
+		cache:  make(map[string][]string),
+	}
+}
+
+// Resolve returns the IP Addresses within the IP Groups `input` - when an IP Group can't be retrieved (for example
+// because it's being created in the same plan) the ID of the IP Group is returned, so that the IP Group is only
+// considered equal to itself
+func (r *firewallPolicyIPGroupResolver) Resolve(input []interface{}) []string {
+	output := make([]string, 0)
+	for _, raw := range input {
+		v, _ := raw.(string)
+		if v == "" {
+			continue
+		}
+
+		addresses, ok := r.cache[strings.ToLower(v)]
+		if !ok {
+			addresses = r.retrieve(v)
+			r.cache[strings.ToLower(v)] = addresses
+		}
+		output = append(output, addresses...)
+	}
+
+	return output
+}
+
+func (r *firewallPolicyIPGroupResolver) retrieve(input string) []string {
+	unresolved := []string{strings.ToLower(input)}
+	if r.client == nil {
+		return unresolved
+	}
+
+	id, err := ipgroups.ParseIPGroupIDInsensitively(input)
+	if err != nil {
+		return unresolved
+	}
+
+	resp, err := r.client.Get(r.ctx, *id, ipgroups.DefaultGetOperationOptions())
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve %s for Firewall Policy rule analysis, comparing by ID instead: %+v", id, err)
+		return unresolved
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.IPAddresses != nil && len(*model.Properties.IPAddresses) > 0 {
+		return *model.Properties.IPAddresses
+	}
+
+	return unresolved
+}
+
+// expandFirewallPolicyAnalysisRules normalises the rules within the `application_rule_collection`, `network_rule_collection`
+// or `nat_rule_collection` blocks - rules containing values which aren't known during the plan are omitted
+func expandFirewallPolicyAnalysisRules(ruleType firewallPolicyRuleType, input []interface{}, resolver *firewallPolicyIPGroupResolver) []firewallPolicyAnalysisRule {
+	output := make([]firewallPolicyAnalysisRule, 0)
+	for _, rawCollection := range input {
+		collection, ok := rawCollection.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		action := collection["action"].(string)
+		if ruleType == firewallPolicyRuleTypeNat {
+			action = "Dnat"
+		}
+
+		for _, rawRule := range collection["rule"].([]interface{}) {
+			rule, ok := rawRule.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			item := firewallPolicyAnalysisRule{
+				Type:               ruleType,
+				CollectionName:     collection["name"].(string),
+				CollectionPriority: collection["priority"].(int),
+				Action:             action,
+				Name:               rule["name"].(string),
+				Sources:            append(firewallPolicyAnalysisStrings(rule["source_addresses"]), resolver.Resolve(rule["source_ip_groups"].([]interface{}))...),
+			}
+
+			switch ruleType {
+			case firewallPolicyRuleTypeApplication:
+				for _, rawProtocol := range rule["protocols"].([]interface{}) {
+					if protocol, ok := rawProtocol.(map[string]interface{}); ok {
+						item.Protocols = append(item.Protocols, fmt.Sprintf("%s:%d", protocol["type"].(string), protocol["port"].(int)))
+					}
+				}
+				item.DestinationAddresses = firewallPolicyAnalysisStrings(rule["destination_addresses"])
+				item.DestinationNames = append(item.DestinationNames, firewallPolicyAnalysisPrefixedStrings("fqdn", rule["destination_fqdns"])...)
+				item.DestinationNames = append(item.DestinationNames, firewallPolicyAnalysisPrefixedStrings("url", rule["destination_urls"])...)
+				item.DestinationNames = append(item.DestinationNames, firewallPolicyAnalysisPrefixedStrings("tag", rule["destination_fqdn_tags"])...)
+				item.DestinationNames = append(item.DestinationNames, firewallPolicyAnalysisPrefixedStrings("category", rule["web_categories"])...)
+
+			case firewallPolicyRuleTypeNetwork:
+				item.Protocols = firewallPolicyAnalysisStrings(rule["protocols"])
+				item.DestinationAddresses = append(firewallPolicyAnalysisStrings(rule["destination_addresses"]), resolver.Resolve(rule["destination_ip_groups"].([]interface{}))...)
+				item.DestinationNames = firewallPolicyAnalysisPrefixedStrings("fqdn", rule["destination_fqdns"])
+				item.DestinationPorts = firewallPolicyAnalysisStrings(rule["destination_ports"])
+
+			case firewallPolicyRuleTypeNat:
+				item.Protocols = firewallPolicyAnalysisStrings(rule["protocols"])
+				if v := rule["destination_address"].(string); v != "" {
+					item.DestinationAddresses = []string{v}
+				}
+				item.DestinationPorts = firewallPolicyAnalysisStrings(rule["destination_ports"])
+			}
+
+			if item.Name == "" || len(item.Sources) == 0 || (len(item.DestinationAddresses) == 0 && len(item.DestinationNames) == 0) {
+				log.Printf("[DEBUG] Skipping Firewall Policy rule analysis for the %s since its values aren't known during the plan", item)
+				continue
+			}
+
+			output = append(output, item)
+		}
+	}
+
+	return output
+}
+
+func firewallPolicyAnalysisStrings(input interface{}) []string {
+	output := make([]string, 0)
+	raw, ok := input.([]interface{})
+	if !ok {
+		return output
+	}
+
+	for _, v := range raw {
+		if s, ok := v.(string); ok && s != "" {
+			output = append(output, s)
+		}
+	}
+
+	return output
+}
+
+func firewallPolicyAnalysisPrefixedStrings(prefix string, input interface{}) []string {
+	output := make([]string, 0)
+	for _, v := range firewallPolicyAnalysisStrings(input) {
+		output = append(output, fmt.Sprintf("%s:%s", prefix, strings.ToLower(v)))
+	}
+
+	return output
+}
+
+// analyseFirewallPolicyRules returns the shadowed, duplicated and overly broad rules within `input`
+//
+// Rules are compared against the rules of the same type which Azure Firewall evaluates before them - a rule is
+// shadowed when an earlier rule matches all of the traffic it matches, and duplicated when both rules match the
+// same traffic and have the same action
+func analyseFirewallPolicyRules(input []firewallPolicyAnalysisRule) []string {
+	rules := make([]firewallPolicyAnalysisRule, len(input))
+	copy(rules, input)
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Type != rules[j].Type {
+			return firewallPolicyRuleTypeEvaluationOrder[rules[i].Type] < firewallPolicyRuleTypeEvaluationOrder[rules[j].Type]
+		}
+		return rules[i].CollectionPriority < rules[j].CollectionPriority
+	})
+
+	findings := make([]string, 0)
+	for i, rule := range rules {
+		if rule.Action != "Deny" && firewallPolicyAnalysisAddressesAreAny(rule.Sources) && firewallPolicyAnalysisDestinationsAreAny(rule) {
+			findings = append(findings, fmt.Sprintf("the %s allows traffic from any source to any destination", rule))
+		}
+
+		for j := 0; j < i; j++ {
+			earlier := rules[j]
+			if earlier.Type != rule.Type || !firewallPolicyAnalysisRuleCovers(earlier, rule) {
+				continue
+			}
+
+			if earlier.Action == rule.Action && firewallPolicyAnalysisRuleCovers(rule, earlier) {
+				findings = append(findings, fmt.Sprintf("the %s is a duplicate of the %s", rule, earlier))
+			} else {
+				findings = append(findings, fmt.Sprintf("the %s is shadowed by the %s (action %q), which is evaluated first and matches all of its traffic", rule, earlier, earlier.Action))
+			}
+			break
+		}
+	}
+
+	return findings
+}
+
+// firewallPolicyAnalysisRuleCovers returns whether all traffic matched by the rule `other` is also matched by `rule`
+func firewallPolicyAnalysisRuleCovers(rule, other firewallPolicyAnalysisRule) bool {
+	if !firewallPolicyAnalysisProtocolsCover(rule.Protocols, other.Protocols) {
+		return false
+	}
+
+	if !firewallPolicyAnalysisAddressesCover(rule.Sources, other.Sources) {
+		return false
+	}
+
+	if !firewallPolicyAnalysisPortsCover(rule.DestinationPorts, other.DestinationPorts) {
+		return false
+	}
+
+	if firewallPolicyAnalysisAddressesAreAny(rule.DestinationAddresses) {
+		return true
+	}
+
+	return firewallPolicyAnalysisAddressesCover(rule.DestinationAddresses, other.DestinationAddresses) && firewallPolicyAnalysisNamesCover(rule.DestinationNames, other.DestinationNames)
+}
+
+func firewallPolicyAnalysisDestinationsAreAny(rule firewallPolicyAnalysisRule) bool {
+	if firewallPolicyAnalysisAddressesAreAny(rule.DestinationAddresses) {
+		return true
+	}
+
+	for _, v := range rule.DestinationNames {
+		if v == "fqdn:*" || v == "url:*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// firewallPolicyAnalysisProtocolsCover returns whether the protocols `input` include all of the protocols `other`
+func firewallPolicyAnalysisProtocolsCover(input, other []string) bool {
+	if len(input) == 0 || len(other) == 0 {
+		return len(input) == 0 && len(other) == 0
+	}
+
+	protocols := make(map[string]bool)
+	for _, v := range input {
+		protocols[strings.ToLower(v)] = true
+	}
+	if protocols["any"] {
+		return true
+	}
+
+	for _, v := range other {
+		if strings.EqualFold(v, "Any") {
+			if !protocols["tcp"] || !protocols["udp"] || !protocols["icmp"] {
+				return false
+			}
+			continue
+		}
+
+		if !protocols[strings.ToLower(v)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// firewallPolicyAnalysisNamesCover returns whether each of the FQDNs, URLs, FQDN Tags and Web Categories `other` is
+// matched by one of `input`, where FQDNs and URLs can contain a leading wildcard (e.g. `*.example.com`)
+func firewallPolicyAnalysisNamesCover(input, other []string) bool {
+	for _, v := range other {
+		covered := false
+		for _, candidate := range input {
+			if firewallPolicyAnalysisNameMatches(candidate, v) {
+				covered = true
+				break
+			}
+		}
+
+		if !covered {
+			return false
+		}
+	}
+
+	return true
+}
+
+func firewallPolicyAnalysisNameMatches(pattern, input string) bool {
+	if pattern == input {
+		return true
+	}
+
+	patternKind, patternValue, _ := strings.Cut(pattern, ":")
+	inputKind, inputValue, _ := strings.Cut(input, ":")
+	if patternKind != inputKind || (patternKind != "fqdn" && patternKind != "url") {
+		return false
+	}
+
+	if patternValue == "*" {
+		return true
+	}
+
+	if suffix, ok := strings.CutPrefix(patternValue, "*"); ok {
+		return strings.HasSuffix(inputValue, suffix)
+	}
+
+	return false
+}
+
+// firewallPolicyAnalysisAddressesAreAny returns whether the addresses `input` match any IPv4 Address
+func firewallPolicyAnalysisAddressesAreAny(input []string) bool {
+	for _, v := range input {
+		if v == "*" || v == "0.0.0.0/0" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// firewallPolicyAnalysisAddressesCover returns whether the IP Addresses, CIDRs and Ranges `input` include all of
+// the addresses `other` - values which aren't IPv4 addresses (such as Service Tags or unresolved IP Groups) must
+// be present in both
+func firewallPolicyAnalysisAddressesCover(input, other []string) bool {
+	if firewallPolicyAnalysisAddressesAreAny(input) {
+		return true
+	}
+
+	ranges, tokens := parseFirewallPolicyAnalysisAddresses(input)
+	otherRanges, otherTokens := parseFirewallPolicyAnalysisAddresses(other)
+
+	for token := range otherTokens {
+		if !tokens[token] {
+			return false
+		}
+	}
+
+	return firewallPolicyAnalysisRangesCover(ranges, otherRanges)
+}
+
+// firewallPolicyAnalysisPortsCover returns whether the ports and port ranges `input` include all of the ports `other`
+func firewallPolicyAnalysisPortsCover(input, other []string) bool {
+	ranges, tokens := parseFirewallPolicyAnalysisPorts(input)
+	otherRanges, otherTokens := parseFirewallPolicyAnalysisPorts(other)
+
+	for token := range otherTokens {
+		if !tokens[token] {
+			return false
+		}
+	}
+
+	return firewallPolicyAnalysisRangesCover(ranges, otherRanges)
+}
+
+type firewallPolicyAnalysisRange struct {
+	start uint64
+	end   uint64
+}
+
+func parseFirewallPolicyAnalysisAddresses(input []string) ([]firewallPolicyAnalysisRange, map[string]bool) {
+	ranges := make([]firewallPolicyAnalysisRange, 0)
+	tokens := make(map[string]bool)
+	for _, v := range input {
+		if v == "*" {
+			ranges = append(ranges, firewallPolicyAnalysisRange{start: 0, end: 1<<32 - 1})
+			continue
+		}
+
+		if _, network, err := net.ParseCIDR(v); err == nil && network.IP.To4() != nil {
+			start := uint64(binary.BigEndian.Uint32(network.IP.To4()))
+			ones, bits := network.Mask.Size()
+			ranges = append(ranges, firewallPolicyAnalysisRange{start: start, end: start + (1 << (bits - ones)) - 1})
+			continue
+		}
+
+		if start, end, ok := strings.Cut(v, "-"); ok {
+			startIP := net.ParseIP(strings.TrimSpace(start)).To4()
+			endIP := net.ParseIP(strings.TrimSpace(end)).To4()
+			if startIP != nil && endIP != nil {
+				ranges = append(ranges, firewallPolicyAnalysisRange{start: uint64(binary.BigEndian.Uint32(startIP)), end: uint64(binary.BigEndian.Uint32(endIP))})
+				continue
+			}
+		}
+
+		if ip := net.ParseIP(v).To4(); ip != nil {
+			address := uint64(binary.BigEndian.Uint32(ip))
+			ranges = append(ranges, firewallPolicyAnalysisRange{start: address, end: address})
+			continue
+		}
+
+		tokens[strings.ToLower(v)] = true
+	}
+
+	return ranges, tokens
+}
+
+func parseFirewallPolicyAnalysisPorts(input []string) ([]firewallPolicyAnalysisRange, map[string]bool) {
+	ranges := make([]firewallPolicyAnalysisRange, 0)
+	tokens := make(map[string]bool)
+	for _, v := range input {
+		if v == "*" {
+			ranges = append(ranges, firewallPolicyAnalysisRange{start: 0, end: 65535})
+			continue
+		}
+
+		start, end, isRange := strings.Cut(v, "-")
+		if !isRange {
+			end = start
+		}
+
+		startPort, startErr := strconv.ParseUint(strings.TrimSpace(start), 10, 16)
+		endPort, endErr := strconv.ParseUint(strings.TrimSpace(end), 10, 16)
+		if startErr != nil || endErr != nil {
+			tokens[v] = true
+			continue
+		}
+
+		ranges = append(ranges, firewallPolicyAnalysisRange{start: startPort, end: endPort})
+	}
+
+	return ranges, tokens
+}
+
+// firewallPolicyAnalysisRangesCover returns whether the union of the ranges `input` contains each of the ranges `other`
+func firewallPolicyAnalysisRangesCover(input, other []firewallPolicyAnalysisRange) bool {
+	merged := make([]firewallPolicyAnalysisRange, len(input))
+	copy(merged, input)
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].start < merged[j].start
+	})
+
+	union := make([]firewallPolicyAnalysisRange, 0)
+	for _, v := range merged {
+		if last := len(union) - 1; last >= 0 && v.start <= union[last].end+1 {
+			if v.end > union[last].end {
+				union[last].end = v.end
+			}
+			continue
+		}
+		union = append(union, v)
+	}
+
+	for _, v := range other {
+		covered := false
+		for _, candidate := range union {
+			if candidate.start <= v.start && v.end <= candidate.end {
+				covered = true
+				break
+			}
+		}
+
+		if !covered {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyseFirewallPolicyRules(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []firewallPolicyAnalysisRule
+		Expected []string
+	}{
+		{
+			Name: "Distinct Rules",
+			Input: []firewallPolicyAnalysisRule{
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "first", CollectionPriority: 100, Action: "Allow", Name: "dns", Protocols: []string{"UDP"}, Sources: []string{"10.0.0.0/24"}, DestinationAddresses: []string{"10.1.0.4"}, DestinationPorts: []string{"53"}},
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "first", CollectionPriority: 100, Action: "Allow", Name: "ssh", Protocols: []string{"TCP"}, Sources: []string{"10.0.0.0/24"}, DestinationAddresses: []string{"10.1.0.4"}, DestinationPorts: []string{"22"}},
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Duplicated Rule",
+			Input: []firewallPolicyAnalysisRule{
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "first", CollectionPriority: 100, Action: "Allow", Name: "dns", Protocols: []string{"UDP"}, Sources: []string{"10.0.0.0/24"}, DestinationAddresses: []string{"10.1.0.4"}, DestinationPorts: []string{"53"}},
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "second", CollectionPriority: 200, Action: "Allow", Name: "dns-again", Protocols: []string{"UDP"}, Sources: []string{"10.0.0.0-10.0.0.255"}, DestinationAddresses: []string{"10.1.0.4/32"}, DestinationPorts: []string{"53-53"}},
+			},
+			Expected: []string{
+				`the Network rule "dns-again" in the rule collection "second" is a duplicate of the Network rule "dns" in the rule collection "first"`,
+			},
+		},
+		{
+			Name: "Shadowed by a Higher Priority Collection",
+			Input: []firewallPolicyAnalysisRule{
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "allow", CollectionPriority: 300, Action: "Allow", Name: "web", Protocols: []string{"TCP"}, Sources: []string{"10.0.0.5"}, DestinationAddresses: []string{"10.1.0.4"}, DestinationPorts: []string{"443"}},
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "deny", CollectionPriority: 200, Action: "Deny", Name: "block", Protocols: []string{"Any"}, Sources: []string{"10.0.0.0/25", "10.0.0.128/25"}, DestinationAddresses: []string{"10.1.0.0/16"}, DestinationPorts: []string{"*"}},
+			},
+			Expected: []string{
+				`the Network rule "web" in the rule collection "allow" is shadowed by the Network rule "block" in the rule collection "deny" (action "Deny"), which is evaluated first and matches all of its traffic`,
+			},
+		},
+		{
+			Name: "Partial Overlap Is Not Shadowed",
+			Input: []firewallPolicyAnalysisRule{
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "first", CollectionPriority: 100, Action: "Deny", Name: "block", Protocols: []string{"TCP"}, Sources: []string{"10.0.0.0/25"}, DestinationAddresses: []string{"10.1.0.4"}, DestinationPorts: []string{"1-1024"}},
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "first", CollectionPriority: 100, Action: "Allow", Name: "web", Protocols: []string{"TCP"}, Sources: []string{"10.0.0.0/24"}, DestinationAddresses: []string{"10.1.0.4"}, DestinationPorts: []string{"443"}},
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Service Tags and IP Groups Compared By Value",
+			Input: []firewallPolicyAnalysisRule{
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "first", CollectionPriority: 100, Action: "Allow", Name: "first", Protocols: []string{"TCP"}, Sources: []string{"VirtualNetwork"}, DestinationAddresses: []string{"AzureCloud"}, DestinationPorts: []string{"443"}},
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "first", CollectionPriority: 100, Action: "Allow", Name: "second", Protocols: []string{"TCP"}, Sources: []string{"10.0.0.1"}, DestinationAddresses: []string{"AzureCloud"}, DestinationPorts: []string{"443"}},
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Application Rule Shadowed by Wildcard FQDN",
+			Input: []firewallPolicyAnalysisRule{
+				{Type: firewallPolicyRuleTypeApplication, CollectionName: "apps", CollectionPriority: 500, Action: "Allow", Name: "microsoft", Protocols: []string{"Https:443"}, Sources: []string{"10.0.0.0/16"}, DestinationNames: []string{"fqdn:*.microsoft.com"}},
+				{Type: firewallPolicyRuleTypeApplication, CollectionName: "apps", CollectionPriority: 500, Action: "Allow", Name: "learn", Protocols: []string{"Https:443"}, Sources: []string{"10.0.1.0/24"}, DestinationNames: []string{"fqdn:learn.microsoft.com"}},
+			},
+			Expected: []string{
+				`the Application rule "learn" in the rule collection "apps" is shadowed by the Application rule "microsoft" in the rule collection "apps" (action "Allow"), which is evaluated first and matches all of its traffic`,
+			},
+		},
+		{
+			Name: "Different Rule Types Are Not Compared",
+			Input: []firewallPolicyAnalysisRule{
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "network", CollectionPriority: 100, Action: "Deny", Name: "block", Protocols: []string{"TCP"}, Sources: []string{"10.0.0.0/8"}, DestinationAddresses: []string{"10.1.0.4"}, DestinationPorts: []string{"443"}},
+				{Type: firewallPolicyRuleTypeNat, CollectionName: "nat", CollectionPriority: 100, Action: "Dnat", Name: "inbound", Protocols: []string{"TCP"}, Sources: []string{"10.0.0.1"}, DestinationAddresses: []string{"10.1.0.4"}, DestinationPorts: []string{"443"}},
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Overly Broad Rule",
+			Input: []firewallPolicyAnalysisRule{
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "network", CollectionPriority: 100, Action: "Allow", Name: "everything", Protocols: []string{"Any"}, Sources: []string{"*"}, DestinationAddresses: []string{"0.0.0.0/0"}, DestinationPorts: []string{"*"}},
+				{Type: firewallPolicyRuleTypeNetwork, CollectionName: "network", CollectionPriority: 100, Action: "Deny", Name: "block", Protocols: []string{"Any"}, Sources: []string{"*"}, DestinationAddresses: []string{"*"}, DestinationPorts: []string{"*"}},
+			},
+			Expected: []string{
+				`the Network rule "everything" in the rule collection "network" allows traffic from any source to any destination`,
+				`the Network rule "block" in the rule collection "network" is shadowed by the Network rule "everything" in the rule collection "network" (action "Allow"), which is evaluated first and matches all of its traffic`,
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := analyseFirewallPolicyRules(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestFirewallPolicyAnalysisAddressesCover(t *testing.T) {
+	testData := []struct {
+		Input    []string
+		Other    []string
+		Expected bool
+	}{
+		{Input: []string{"*"}, Other: []string{"VirtualNetwork"}, Expected: true},
+		{Input: []string{"10.0.0.0/24"}, Other: []string{"10.0.0.10-10.0.0.20"}, Expected: true},
+		{Input: []string{"10.0.0.0/24"}, Other: []string{"10.0.0.0/23"}, Expected: false},
+		{Input: []string{"10.0.0.0/24", "10.0.1.0/24"}, Other: []string{"10.0.0.0/23"}, Expected: true},
+		{Input: []string{"10.0.0.0/24"}, Other: []string{"VirtualNetwork"}, Expected: false},
+		{Input: []string{"VirtualNetwork"}, Other: []string{"virtualnetwork"}, Expected: true},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v covers %+v", v.Input, v.Other)
+
+		if actual := firewallPolicyAnalysisAddressesCover(v.Input, v.Other); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestFirewallPolicyAnalysisPortsCover(t *testing.T) {
+	testData := []struct {
+		Input    []string
+		Other    []string
+		Expected bool
+	}{
+		{Input: []string{"*"}, Other: []string{"8080"}, Expected: true},
+		{Input: []string{"80-90"}, Other: []string{"85", "90"}, Expected: true},
+		{Input: []string{"80-90"}, Other: []string{"79-85"}, Expected: false},
+		{Input: []string{"80"}, Other: []string{"*"}, Expected: false},
+		{Input: []string{}, Other: []string{}, Expected: true},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v covers %+v", v.Input, v.Other)
+
+		if actual := firewallPolicyAnalysisPortsCover(v.Input, v.Other); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
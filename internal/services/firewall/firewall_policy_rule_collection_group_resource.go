@@ -43,6 +43,8 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: firewallPolicyRuleAnalysisCustomizeDiff,
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -4,6 +4,7 @@
 package keyvault
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -90,3 +91,7 @@ func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource
 		NewKeyVaultSecretEphemeralResource,
 	}
 }
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{}
+}
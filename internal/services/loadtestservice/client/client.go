@@ -7,12 +7,18 @@ import (
 	"fmt"
 
 	loadtestserviceV20221201 "github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type AutoClient struct {
 	V20221201 loadtestserviceV20221201.Client
+
+	authorizerFunc  common.ApiAuthorizerFunc
+	configureFunc   func(c client.BaseClient, authorizer auth.Authorizer)
+	environmentName string
 }
 
 func NewClient(o *common.ClientOptions) (*AutoClient, error) {
@@ -25,5 +31,9 @@ func NewClient(o *common.ClientOptions) (*AutoClient, error) {
 
 	return &AutoClient{
 		V20221201: *v20221201Client,
+
+		authorizerFunc:  o.Authorizers.AuthorizerFunc,
+		configureFunc:   o.Configure,
+		environmentName: o.Environment.Name,
 	}, nil
 }
@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+const dataPlaneApiVersion = "2024-12-01"
+
+// dataPlaneTokenAudiences are the audiences tokens for the Load Testing data plane must be issued for, keyed by the
+// name of the Cloud Environment. These are shared by all Load Testing resources within a Cloud rather than being
+// specific to the `data_plane_uri` of each resource - the Load Testing data plane is currently only available in
+// the Azure Public Cloud, so other Clouds are unsupported.
+var dataPlaneTokenAudiences = map[string]string{
+	"Public": "https://cnt-prod.loadtesting.azure.com",
+}
+
+const (
+	FileTypeJmxFile             = "JMX_FILE"
+	FileTypeTestScript          = "TEST_SCRIPT"
+	FileTypeUserProperties      = "USER_PROPERTIES"
+	FileTypeAdditionalArtifacts = "ADDITIONAL_ARTIFACTS"
+
+	FileValidationStatusNotValidated          = "NOT_VALIDATED"
+	FileValidationStatusValidationInitiated   = "VALIDATION_INITIATED"
+	FileValidationStatusValidationSuccess     = "VALIDATION_SUCCESS"
+	FileValidationStatusValidationFailure     = "VALIDATION_FAILURE"
+	FileValidationStatusValidationNotRequired = "VALIDATION_NOT_REQUIRED"
+
+	SecretTypeKeyVaultSecretUri = "AKV_SECRET_URI"
+
+	TestKindJMX    = "JMX"
+	TestKindLocust = "Locust"
+
+	TestRunStatusCancelled = "CANCELLED"
+	TestRunStatusDone      = "DONE"
+	TestRunStatusFailed    = "FAILED"
+
+	TestRunResultFailed = "FAILED"
+	TestRunResultPassed = "PASSED"
+)
+
+// DataPlaneClient is a minimal client for the Azure Load Testing data plane, which manages the Tests and Test Runs
+// within a Load Testing resource, see https://learn.microsoft.com/rest/api/loadtesting/dataplane
+type DataPlaneClient struct {
+	*dataplane.Client
+}
+
+// DataPlaneClient returns a DataPlaneClient for the `data_plane_uri` exposed by a Load Testing resource
+func (c *AutoClient) DataPlaneClient(dataPlaneUri string) (*DataPlaneClient, error) {
+	audience, err := dataPlaneTokenAudience(c.environmentName)
+	if err != nil {
+		return nil, err
+	}
+
+	api := environments.NewApiEndpoint("LoadTestService", audience, nil)
+	authorizer, err := c.authorizerFunc(api)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", dataPlaneUri, err)
+	}
+
+	baseUri := dataPlaneUri
+	if !strings.HasPrefix(baseUri, "https://") {
+		baseUri = fmt.Sprintf("https://%s", baseUri)
+	}
+
+	dataPlaneClient := dataplane.NewDataPlaneClient(strings.TrimSuffix(baseUri, "/"), "loadtestservice", dataPlaneApiVersion)
+	c.configureFunc(dataPlaneClient.Client, authorizer)
+
+	return &DataPlaneClient{
+		Client: dataPlaneClient,
+	}, nil
+}
+
+func dataPlaneTokenAudience(environmentName string) (string, error) {
+	if audience, ok := dataPlaneTokenAudiences[environmentName]; ok {
+		return audience, nil
+	}
+	return "", fmt.Errorf("the Load Testing data plane isn't supported in the %q Cloud Environment, only the Azure Public Cloud is supported", environmentName)
+}
+
+type Test struct {
+	TestId                        *string                `json:"testId,omitempty"`
+	DisplayName                   *string                `json:"displayName,omitempty"`
+	Description                   *string                `json:"description,omitempty"`
+	Kind                          *string                `json:"kind,omitempty"`
+	LoadTestConfiguration         *LoadTestConfiguration `json:"loadTestConfiguration,omitempty"`
+	PassFailCriteria              *PassFailCriteria      `json:"passFailCriteria,omitempty"`
+	Secrets                       map[string]*Secret     `json:"secrets,omitempty"`
+	EnvironmentVariables          map[string]*string     `json:"environmentVariables,omitempty"`
+	KeyVaultReferenceIdentityType *string                `json:"keyvaultReferenceIdentityType,omitempty"`
+	KeyVaultReferenceIdentityId   *string                `json:"keyvaultReferenceIdentityId,omitempty"`
+	InputArtifacts                *TestInputArtifacts    `json:"inputArtifacts,omitempty"`
+}
+
+type LoadTestConfiguration struct {
+	EngineInstances *int64 `json:"engineInstances,omitempty"`
+	SplitAllCSVs    *bool  `json:"splitAllCSVs,omitempty"`
+}
+
+type PassFailCriteria struct {
+	PassFailMetrics map[string]*PassFailMetric `json:"passFailMetrics,omitempty"`
+}
+
+type PassFailMetric struct {
+	ClientMetric *string  `json:"clientMetric,omitempty"`
+	Aggregate    *string  `json:"aggregate,omitempty"`
+	Condition    *string  `json:"condition,omitempty"`
+	RequestName  *string  `json:"requestName,omitempty"`
+	Value        *float64 `json:"value,omitempty"`
+	Action       *string  `json:"action,omitempty"`
+	ActualValue  *float64 `json:"actualValue,omitempty"`
+	Result       *string  `json:"result,omitempty"`
+}
+
+type Secret struct {
+	Value *string `json:"value,omitempty"`
+	Type  *string `json:"type,omitempty"`
+}
+
+type TestInputArtifacts struct {
+	TestScriptFileInfo *TestFileInfo  `json:"testScriptFileInfo,omitempty"`
+	UserPropFileInfo   *TestFileInfo  `json:"userPropFileInfo,omitempty"`
+	AdditionalFileInfo []TestFileInfo `json:"additionalFileInfo,omitempty"`
+}
+
+type TestFileInfo struct {
+	FileName                 *string `json:"fileName,omitempty"`
+	Url                      *string `json:"url,omitempty"`
+	FileType                 *string `json:"fileType,omitempty"`
+	ExpireDateTime           *string `json:"expireDateTime,omitempty"`
+	ValidationStatus         *string `json:"validationStatus,omitempty"`
+	ValidationFailureDetails *string `json:"validationFailureDetails,omitempty"`
+}
+
+type TestAppComponents struct {
+	Components map[string]*AppComponent `json:"components"`
+}
+
+type AppComponent struct {
+	ResourceId        *string `json:"resourceId,omitempty"`
+	ResourceName      *string `json:"resourceName,omitempty"`
+	ResourceType      *string `json:"resourceType,omitempty"`
+	Kind              *string `json:"kind,omitempty"`
+	ResourceGroup     *string `json:"resourceGroup,omitempty"`
+	SubscriptionId    *string `json:"subscriptionId,omitempty"`
+	DisplayName       *string `json:"displayName,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+type TestRun struct {
+	TestRunId             *string                `json:"testRunId,omitempty"`
+	TestId                *string                `json:"testId,omitempty"`
+	DisplayName           *string                `json:"displayName,omitempty"`
+	Description           *string                `json:"description,omitempty"`
+	Status                *string                `json:"status,omitempty"`
+	TestResult            *string                `json:"testResult,omitempty"`
+	StartDateTime         *string                `json:"startDateTime,omitempty"`
+	EndDateTime           *string                `json:"endDateTime,omitempty"`
+	ExecutedDateTime      *string                `json:"executedDateTime,omitempty"`
+	Duration              *int64                 `json:"duration,omitempty"`
+	VirtualUsers          *int64                 `json:"virtualUsers,omitempty"`
+	PortalUrl             *string                `json:"portalUrl,omitempty"`
+	PassFailCriteria      *PassFailCriteria      `json:"passFailCriteria,omitempty"`
+	ErrorDetails          []TestRunErrorDetail   `json:"errorDetails,omitempty"`
+	LoadTestConfiguration *LoadTestConfiguration `json:"loadTestConfiguration,omitempty"`
+}
+
+type TestRunErrorDetail struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// CreateOrUpdateTest creates or updates the Test, the payload is applied as a JSON Merge Patch (RFC 7386) so any
+// keys within maps (such as `secrets` or `passFailMetrics`) which need removing must be explicitly sent as `null`
+func (c *DataPlaneClient) CreateOrUpdateTest(ctx context.Context, testId string, input interface{}) (*Test, error) {
+	var result Test
+	if err := c.doJSON(ctx, http.MethodPatch, fmt.Sprintf("/tests/%s", url.PathEscape(testId)), nil, input, &result, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, fmt.Errorf("creating/updating test %q: %+v", testId, err)
+	}
+	return &result, nil
+}
+
+// GetTest retrieves the Test, returning nil when the Test doesn't exist
+func (c *DataPlaneClient) GetTest(ctx context.Context, testId string) (*Test, error) {
+	var result Test
+	if err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/tests/%s", url.PathEscape(testId)), nil, nil, &result, http.StatusOK); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving test %q: %+v", testId, err)
+	}
+	return &result, nil
+}
+
+func (c *DataPlaneClient) DeleteTest(ctx context.Context, testId string) error {
+	if err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/tests/%s", url.PathEscape(testId)), nil, nil, nil, http.StatusOK, http.StatusNoContent); err != nil && !isNotFound(err) {
+		return fmt.Errorf("deleting test %q: %+v", testId, err)
+	}
+	return nil
+}
+
+// UploadTestFile uploads `content` as the file `fileName` of type `fileType` to the Test, the file is then validated
+// asynchronously by the service and its ValidationStatus should be polled via GetTestFile
+func (c *DataPlaneClient) UploadTestFile(ctx context.Context, testId, fileName, fileType string, content []byte) (*TestFileInfo, error) {
+	var result TestFileInfo
+	if err := c.execute(ctx, http.MethodPut, fmt.Sprintf("/tests/%s/files/%s", url.PathEscape(testId), url.PathEscape(fileName)), url.Values{"fileType": []string{fileType}}, "application/octet-stream", content, &result, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, fmt.Errorf("uploading file %q to test %q: %+v", fileName, testId, err)
+	}
+	return &result, nil
+}
+
+// GetTestFile retrieves information about the file `fileName` within the Test, returning nil when it doesn't exist
+func (c *DataPlaneClient) GetTestFile(ctx context.Context, testId, fileName string) (*TestFileInfo, error) {
+	var result TestFileInfo
+	if err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/tests/%s/files/%s", url.PathEscape(testId), url.PathEscape(fileName)), nil, nil, &result, http.StatusOK); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving file %q from test %q: %+v", fileName, testId, err)
+	}
+	return &result, nil
+}
+
+func (c *DataPlaneClient) DeleteTestFile(ctx context.Context, testId, fileName string) error {
+	if err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/tests/%s/files/%s", url.PathEscape(testId), url.PathEscape(fileName)), nil, nil, nil, http.StatusOK, http.StatusNoContent); err != nil && !isNotFound(err) {
+		return fmt.Errorf("deleting file %q from test %q: %+v", fileName, testId, err)
+	}
+	return nil
+}
+
+// CreateOrUpdateAppComponents associates the Azure resources being load tested with the Test, like CreateOrUpdateTest
+// this is a JSON Merge Patch, so components which need removing must be sent as `null`
+func (c *DataPlaneClient) CreateOrUpdateAppComponents(ctx context.Context, testId string, input interface{}) (*TestAppComponents, error) {
+	var result TestAppComponents
+	if err := c.doJSON(ctx, http.MethodPatch, fmt.Sprintf("/tests/%s/app-components", url.PathEscape(testId)), nil, input, &result, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, fmt.Errorf("creating/updating app components for test %q: %+v", testId, err)
+	}
+	return &result, nil
+}
+
+func (c *DataPlaneClient) GetAppComponents(ctx context.Context, testId string) (*TestAppComponents, error) {
+	var result TestAppComponents
+	if err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/tests/%s/app-components", url.PathEscape(testId)), nil, nil, &result, http.StatusOK); err != nil {
+		if isNotFound(err) {
+			return &TestAppComponents{}, nil
+		}
+		return nil, fmt.Errorf("retrieving app components for test %q: %+v", testId, err)
+	}
+	return &result, nil
+}
+
+// CreateTestRun starts a new run of the Test referenced by `input.TestId`
+func (c *DataPlaneClient) CreateTestRun(ctx context.Context, testRunId string, input TestRun) (*TestRun, error) {
+	var result TestRun
+	if err := c.doJSON(ctx, http.MethodPatch, fmt.Sprintf("/test-runs/%s", url.PathEscape(testRunId)), nil, input, &result, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, fmt.Errorf("creating test run %q: %+v", testRunId, err)
+	}
+	return &result, nil
+}
+
+// GetTestRun retrieves the Test Run, returning nil when the Test Run doesn't exist
+func (c *DataPlaneClient) GetTestRun(ctx context.Context, testRunId string) (*TestRun, error) {
+	var result TestRun
+	if err := c.doJSON(ctx, http.MethodGet, fmt.Sprintf("/test-runs/%s", url.PathEscape(testRunId)), nil, nil, &result, http.StatusOK); err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving test run %q: %+v", testRunId, err)
+	}
+	return &result, nil
+}
+
+func (c *DataPlaneClient) StopTestRun(ctx context.Context, testRunId string) error {
+	if err := c.doJSON(ctx, http.MethodPost, fmt.Sprintf("/test-runs/%s:stop", url.PathEscape(testRunId)), nil, nil, nil, http.StatusOK); err != nil {
+		return fmt.Errorf("stopping test run %q: %+v", testRunId, err)
+	}
+	return nil
+}
+
+func (c *DataPlaneClient) DeleteTestRun(ctx context.Context, testRunId string) error {
+	if err := c.doJSON(ctx, http.MethodDelete, fmt.Sprintf("/test-runs/%s", url.PathEscape(testRunId)), nil, nil, nil, http.StatusOK, http.StatusNoContent); err != nil && !isNotFound(err) {
+		return fmt.Errorf("deleting test run %q: %+v", testRunId, err)
+	}
+	return nil
+}
+
+// IsTestRunInTerminalState returns whether the Test Run has finished, regardless of whether it was successful
+func IsTestRunInTerminalState(status string) bool {
+	switch status {
+	case TestRunStatusCancelled, TestRunStatusDone, TestRunStatusFailed:
+		return true
+	}
+	return false
+}
+
+type notFoundError struct {
+	err error
+}
+
+func (e notFoundError) Error() string {
+	return e.err.Error()
+}
+
+func isNotFound(err error) bool {
+	_, ok := err.(notFoundError)
+	return ok
+}
+
+func (c *DataPlaneClient) doJSON(ctx context.Context, method, path string, query url.Values, input, output interface{}, expectedStatusCodes ...int) error {
+	var body []byte
+	contentType := ""
+	if input != nil {
+		payload, err := json.Marshal(input)
+		if err != nil {
+			return fmt.Errorf("marshaling request: %+v", err)
+		}
+		body = payload
+
+		contentType = "application/json"
+		if method == http.MethodPatch {
+			contentType = "application/merge-patch+json"
+		}
+	}
+
+	return c.execute(ctx, method, path, query, contentType, body, output, expectedStatusCodes...)
+}
+
+func (c *DataPlaneClient) execute(ctx context.Context, method, path string, query url.Values, contentType string, body []byte, output interface{}, expectedStatusCodes ...int) error {
+	req, err := c.Client.NewRequest(ctx, client.RequestOptions{
+		ContentType:         contentType,
+		ExpectedStatusCodes: expectedStatusCodes,
+		HttpMethod:          method,
+		Path:                path,
+	})
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("api-version", dataPlaneApiVersion)
+	req.URL.RawQuery = query.Encode()
+	req.Header.Set("Accept", "application/json")
+
+	// the payload is set directly since the request body is either already-marshalled JSON or the raw file content
+	if body != nil {
+		req.ContentLength = int64(len(body))
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		if resp != nil && resp.Response != nil && resp.StatusCode == http.StatusNotFound {
+			return notFoundError{err: err}
+		}
+		return err
+	}
+
+	if output == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	return resp.Unmarshal(output)
+}
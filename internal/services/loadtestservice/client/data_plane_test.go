@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+	"golang.org/x/oauth2"
+)
+
+type fakeAuthorizer struct{}
+
+func (fakeAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: "fake-token"}, nil
+}
+
+func (fakeAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+// fakeDataPlane is a minimal in-memory stand-in for the Load Testing data plane
+type fakeDataPlane struct {
+	sync.Mutex
+
+	tests         map[string]map[string]interface{}
+	files         map[string]map[string]interface{}
+	appComponents map[string]map[string]interface{}
+	testRuns      map[string]map[string]interface{}
+}
+
+func newFakeDataPlane(t *testing.T) (*DataPlaneClient, *fakeDataPlane) {
+	fake := &fakeDataPlane{
+		tests:         map[string]map[string]interface{}{},
+		files:         map[string]map[string]interface{}{},
+		appComponents: map[string]map[string]interface{}{},
+		testRuns:      map[string]map[string]interface{}{},
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	dataPlaneClient := dataplane.NewDataPlaneClient(server.URL, "loadtestservice", dataPlaneApiVersion)
+	dataPlaneClient.SetAuthorizer(fakeAuthorizer{})
+
+	return &DataPlaneClient{
+		Client: dataPlaneClient,
+	}, fake
+}
+
+func (f *fakeDataPlane) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer fake-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.URL.Query().Get("api-version") != dataPlaneApiVersion {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(segments) == 2 && segments[0] == "tests":
+		f.serveObject(w, r, f.tests, segments[1], "testId")
+	case len(segments) == 3 && segments[0] == "tests" && segments[2] == "app-components":
+		f.serveObject(w, r, f.appComponents, segments[1], "testId")
+	case len(segments) == 4 && segments[0] == "tests" && segments[2] == "files":
+		key := segments[1] + "/" + segments[3]
+		if r.Method == http.MethodPut {
+			if _, ok := f.tests[segments[1]]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			content, _ := io.ReadAll(r.Body)
+			status := FileValidationStatusValidationSuccess
+			if len(content) == 0 {
+				status = FileValidationStatusValidationFailure
+			}
+			f.files[key] = map[string]interface{}{
+				"fileName":         segments[3],
+				"fileType":         r.URL.Query().Get("fileType"),
+				"validationStatus": status,
+			}
+			writeJSON(w, http.StatusCreated, f.files[key])
+			return
+		}
+		f.serveObject(w, r, f.files, key, "")
+	case len(segments) == 2 && segments[0] == "test-runs" && strings.HasSuffix(segments[1], ":stop"):
+		run, ok := f.testRuns[strings.TrimSuffix(segments[1], ":stop")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		run["status"] = TestRunStatusCancelled
+		writeJSON(w, http.StatusOK, run)
+	case len(segments) == 2 && segments[0] == "test-runs":
+		if r.Method == http.MethodPatch {
+			// runs complete immediately within the stand-in
+			defer func() {
+				if run, ok := f.testRuns[segments[1]]; ok {
+					run["status"] = TestRunStatusDone
+					run["testResult"] = TestRunResultPassed
+				}
+			}()
+		}
+		f.serveObject(w, r, f.testRuns, segments[1], "testRunId")
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeDataPlane) serveObject(w http.ResponseWriter, r *http.Request, store map[string]map[string]interface{}, key, idField string) {
+	existing, exists := store[key]
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, existing)
+	case http.MethodDelete:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(store, key)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodPatch:
+		if r.Header.Get("Content-Type") != "application/merge-patch+json" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		var patch map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if existing == nil {
+			existing = map[string]interface{}{}
+		}
+		merged := mergePatch(existing, patch).(map[string]interface{})
+		if idField != "" {
+			merged[idField] = key
+		}
+		store[key] = merged
+
+		status := http.StatusOK
+		if !exists {
+			status = http.StatusCreated
+		}
+		writeJSON(w, status, merged)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// mergePatch applies `patch` to `target` as described in RFC 7386
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchMap, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetMap, ok := target.(map[string]interface{})
+	if !ok {
+		targetMap = map[string]interface{}{}
+	}
+	for k, v := range patchMap {
+		if v == nil {
+			delete(targetMap, k)
+			continue
+		}
+		targetMap[k] = mergePatch(targetMap[k], v)
+	}
+	return targetMap
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestDataPlaneClient_TestLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	client, _ := newFakeDataPlane(t)
+
+	existing, err := client.GetTest(ctx, "sample")
+	if err != nil {
+		t.Fatalf("retrieving missing test: %+v", err)
+	}
+	if existing != nil {
+		t.Fatalf("expected a missing test to return nil but got %+v", existing)
+	}
+
+	_, err = client.CreateOrUpdateTest(ctx, "sample", Test{
+		DisplayName: pointer.To("Sample"),
+		Kind:        pointer.To(TestKindJMX),
+		LoadTestConfiguration: &LoadTestConfiguration{
+			EngineInstances: pointer.To(int64(2)),
+		},
+		PassFailCriteria: &PassFailCriteria{
+			PassFailMetrics: map[string]*PassFailMetric{
+				"errors": {
+					ClientMetric: pointer.To("error"),
+					Aggregate:    pointer.To("percentage"),
+					Condition:    pointer.To(">"),
+					Value:        pointer.To(5.0),
+				},
+				"latency": {
+					ClientMetric: pointer.To("response_time_ms"),
+					Aggregate:    pointer.To("p90"),
+					Condition:    pointer.To(">"),
+					Value:        pointer.To(300.0),
+				},
+			},
+		},
+		Secrets: map[string]*Secret{
+			"token": {
+				Value: pointer.To("https://example.vault.azure.net/secrets/token"),
+				Type:  pointer.To(SecretTypeKeyVaultSecretUri),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("creating test: %+v", err)
+	}
+
+	// removing a criterion requires sending it as `null`
+	_, err = client.CreateOrUpdateTest(ctx, "sample", Test{
+		DisplayName: pointer.To("Sample Updated"),
+		PassFailCriteria: &PassFailCriteria{
+			PassFailMetrics: map[string]*PassFailMetric{
+				"latency": nil,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("updating test: %+v", err)
+	}
+
+	test, err := client.GetTest(ctx, "sample")
+	if err != nil {
+		t.Fatalf("retrieving test: %+v", err)
+	}
+	if test == nil {
+		t.Fatalf("expected the test to exist")
+	}
+	if pointer.From(test.TestId) != "sample" || pointer.From(test.DisplayName) != "Sample Updated" {
+		t.Fatalf("unexpected test %+v", test)
+	}
+	if test.LoadTestConfiguration == nil || pointer.From(test.LoadTestConfiguration.EngineInstances) != 2 {
+		t.Fatalf("expected the engine instances to be retained but got %+v", test.LoadTestConfiguration)
+	}
+	if test.PassFailCriteria == nil || len(test.PassFailCriteria.PassFailMetrics) != 1 || test.PassFailCriteria.PassFailMetrics["errors"] == nil {
+		t.Fatalf("expected only the `errors` criterion to remain but got %+v", test.PassFailCriteria)
+	}
+	if secret := test.Secrets["token"]; secret == nil || pointer.From(secret.Type) != SecretTypeKeyVaultSecretUri {
+		t.Fatalf("expected the `token` secret to be retained but got %+v", test.Secrets)
+	}
+
+	file, err := client.UploadTestFile(ctx, "sample", "sample.jmx", FileTypeTestScript, []byte("<jmeterTestPlan/>"))
+	if err != nil {
+		t.Fatalf("uploading file: %+v", err)
+	}
+	if pointer.From(file.FileType) != FileTypeTestScript {
+		t.Fatalf("expected the file type %q but got %q", FileTypeTestScript, pointer.From(file.FileType))
+	}
+	file, err = client.GetTestFile(ctx, "sample", "sample.jmx")
+	if err != nil {
+		t.Fatalf("retrieving file: %+v", err)
+	}
+	if file == nil || pointer.From(file.ValidationStatus) != FileValidationStatusValidationSuccess {
+		t.Fatalf("expected the file to have been validated but got %+v", file)
+	}
+
+	if err := client.DeleteTestFile(ctx, "sample", "sample.jmx"); err != nil {
+		t.Fatalf("deleting file: %+v", err)
+	}
+	if file, err = client.GetTestFile(ctx, "sample", "sample.jmx"); err != nil || file != nil {
+		t.Fatalf("expected the file to be gone but got %+v / %+v", file, err)
+	}
+
+	if err := client.DeleteTest(ctx, "sample"); err != nil {
+		t.Fatalf("deleting test: %+v", err)
+	}
+	if err := client.DeleteTest(ctx, "sample"); err != nil {
+		t.Fatalf("deleting an already deleted test should be a no-op: %+v", err)
+	}
+}
+
+func TestDataPlaneClient_AppComponents(t *testing.T) {
+	ctx := context.TODO()
+	client, _ := newFakeDataPlane(t)
+
+	resourceId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Web/sites/site1"
+	_, err := client.CreateOrUpdateAppComponents(ctx, "sample", TestAppComponents{
+		Components: map[string]*AppComponent{
+			resourceId: {
+				ResourceId:   pointer.To(resourceId),
+				ResourceName: pointer.To("site1"),
+				ResourceType: pointer.To("Microsoft.Web/sites"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("creating app components: %+v", err)
+	}
+
+	_, err = client.CreateOrUpdateAppComponents(ctx, "sample", TestAppComponents{
+		Components: map[string]*AppComponent{
+			resourceId: nil,
+		},
+	})
+	if err != nil {
+		t.Fatalf("removing app components: %+v", err)
+	}
+
+	components, err := client.GetAppComponents(ctx, "sample")
+	if err != nil {
+		t.Fatalf("retrieving app components: %+v", err)
+	}
+	if len(components.Components) != 0 {
+		t.Fatalf("expected no app components but got %+v", components.Components)
+	}
+}
+
+func TestDataPlaneClient_TestRunLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	client, _ := newFakeDataPlane(t)
+
+	run, err := client.CreateTestRun(ctx, "run1", TestRun{
+		TestId:      pointer.To("sample"),
+		DisplayName: pointer.To("Run 1"),
+	})
+	if err != nil {
+		t.Fatalf("creating test run: %+v", err)
+	}
+	if IsTestRunInTerminalState(pointer.From(run.Status)) {
+		t.Fatalf("expected a newly created run not to be in a terminal state but got %q", pointer.From(run.Status))
+	}
+
+	run, err = client.GetTestRun(ctx, "run1")
+	if err != nil {
+		t.Fatalf("retrieving test run: %+v", err)
+	}
+	if !IsTestRunInTerminalState(pointer.From(run.Status)) || pointer.From(run.TestResult) != TestRunResultPassed {
+		t.Fatalf("expected the run to have passed but got %+v", run)
+	}
+
+	if err := client.StopTestRun(ctx, "run1"); err != nil {
+		t.Fatalf("stopping test run: %+v", err)
+	}
+	if err := client.StopTestRun(ctx, "missing"); err == nil {
+		t.Fatalf("expected stopping a missing test run to fail")
+	}
+
+	if err := client.DeleteTestRun(ctx, "run1"); err != nil {
+		t.Fatalf("deleting test run: %+v", err)
+	}
+	if run, err = client.GetTestRun(ctx, "run1"); err != nil || run != nil {
+		t.Fatalf("expected the test run to be gone but got %+v / %+v", run, err)
+	}
+}
+
+func TestDataPlaneTokenAudience(t *testing.T) {
+	cases := []struct {
+		EnvironmentName string
+		Expected        string
+		Valid           bool
+	}{
+		{
+			EnvironmentName: "Public",
+			Expected:        "https://cnt-prod.loadtesting.azure.com",
+			Valid:           true,
+		},
+		{
+			EnvironmentName: "USGovernment",
+			Valid:           false,
+		},
+		{
+			EnvironmentName: "China",
+			Valid:           false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.EnvironmentName)
+
+		actual, err := dataPlaneTokenAudience(tc.EnvironmentName)
+		if err != nil {
+			if tc.Valid {
+				t.Fatalf("expected %q to be supported but got: %+v", tc.EnvironmentName, err)
+			}
+			continue
+		}
+
+		if !tc.Valid {
+			t.Fatalf("expected %q to be unsupported but got %q", tc.EnvironmentName, actual)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadtestservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// dataPlaneClientForLoadTest returns a Data Plane client for the specified Load Testing resource, the bool returned
+// indicates whether the Load Testing resource exists, so that callers can remove dependent resources from the state.
+func dataPlaneClientForLoadTest(ctx context.Context, autoClient *client.AutoClient, id loadtests.LoadTestId) (*client.DataPlaneClient, bool, error) {
+	resp, err := autoClient.V20221201.LoadTests.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	dataPlaneUri := ""
+	if model := resp.Model; model != nil && model.Properties != nil {
+		dataPlaneUri = pointer.From(model.Properties.DataPlaneURI)
+	}
+	if dataPlaneUri == "" {
+		return nil, true, fmt.Errorf("retrieving %s: `data_plane_uri` was nil", id)
+	}
+
+	dataPlaneClient, err := autoClient.DataPlaneClient(dataPlaneUri)
+	if err != nil {
+		return nil, true, fmt.Errorf("building Data Plane client for %s: %+v", id, err)
+	}
+
+	return dataPlaneClient, true, nil
+}
+
+// waitForTestFileValidation waits for the service to finish validating an uploaded test file, returning an error
+// including the failure details should validation fail.
+func waitForTestFileValidation(ctx context.Context, dataPlaneClient *client.DataPlaneClient, testName, fileName string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			client.FileValidationStatusNotValidated,
+			client.FileValidationStatusValidationInitiated,
+		},
+		Target: []string{
+			client.FileValidationStatusValidationSuccess,
+			client.FileValidationStatusValidationNotRequired,
+		},
+		Refresh: func() (interface{}, string, error) {
+			file, err := dataPlaneClient.GetTestFile(ctx, testName, fileName)
+			if err != nil {
+				return nil, "", err
+			}
+			if file == nil {
+				return nil, "", fmt.Errorf("file %q was not found within test %q", fileName, testName)
+			}
+
+			status := pointer.From(file.ValidationStatus)
+			if status == client.FileValidationStatusValidationFailure {
+				return file, status, fmt.Errorf("validation of file %q failed: %s", fileName, pointer.From(file.ValidationFailureDetails))
+			}
+
+			return file, status, nil
+		},
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for validation of file %q within test %q: %+v", fileName, testName, err)
+	}
+
+	return nil
+}
+
+// waitForTestRunToFinish waits for a Test Run to reach a terminal state, returning the final Test Run.
+func waitForTestRunToFinish(ctx context.Context, dataPlaneClient *client.DataPlaneClient, testRunName string) (*client.TestRun, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil, fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Running"},
+		Target:  []string{"Finished"},
+		Refresh: func() (interface{}, string, error) {
+			run, err := dataPlaneClient.GetTestRun(ctx, testRunName)
+			if err != nil {
+				return nil, "", err
+			}
+			if run == nil {
+				return nil, "", fmt.Errorf("test run %q was not found", testRunName)
+			}
+
+			if client.IsTestRunInTerminalState(pointer.From(run.Status)) {
+				return run, "Finished", nil
+			}
+			return run, "Running", nil
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for test run %q to finish: %+v", testRunName, err)
+	}
+
+	return result.(*client.TestRun), nil
+}
+
+// parseLoadTestAppComponentType returns the fully qualified resource type (e.g. `Microsoft.Web/sites`) and name of
+// the resource referenced by the Resource ID, which the service requires for each App Component.
+func parseLoadTestAppComponentType(input string) (string, string, error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+
+	providerIndex := -1
+	for i, v := range segments {
+		if strings.EqualFold(v, "providers") {
+			providerIndex = i
+		}
+	}
+	// the remaining segments after the provider namespace are pairs of type and name
+	if providerIndex == -1 || len(segments) < providerIndex+4 || (len(segments)-providerIndex-2)%2 != 0 {
+		return "", "", fmt.Errorf("expected %q to be the Resource ID of an Azure Resource", input)
+	}
+
+	types := []string{segments[providerIndex+1]}
+	for i := providerIndex + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	return strings.Join(types, "/"), segments[len(segments)-1], nil
+}
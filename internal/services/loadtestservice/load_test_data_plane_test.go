@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadtestservice
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/client"
+)
+
+func TestParseLoadTestAppComponentType(t *testing.T) {
+	cases := []struct {
+		Input        string
+		ExpectedType string
+		ExpectedName string
+		ShouldError  bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			ShouldError: true,
+		},
+		{
+			Input:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Web/sites",
+			ShouldError: true,
+		},
+		{
+			Input:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Web/sites/site1",
+			ExpectedType: "Microsoft.Web/sites",
+			ExpectedName: "site1",
+		},
+		{
+			Input:        "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Web/sites/site1/slots/staging",
+			ExpectedType: "Microsoft.Web/sites/slots",
+			ExpectedName: "staging",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		resourceType, resourceName, err := parseLoadTestAppComponentType(tc.Input)
+		if err != nil {
+			if tc.ShouldError {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if tc.ShouldError {
+			t.Fatalf("expected an error but got %q / %q", resourceType, resourceName)
+		}
+
+		if resourceType != tc.ExpectedType {
+			t.Fatalf("expected the type %q but got %q", tc.ExpectedType, resourceType)
+		}
+		if resourceName != tc.ExpectedName {
+			t.Fatalf("expected the name %q but got %q", tc.ExpectedName, resourceName)
+		}
+	}
+}
+
+func TestExpandLoadTestTestRemovesExistingItems(t *testing.T) {
+	existing := &client.Test{
+		PassFailCriteria: &client.PassFailCriteria{
+			PassFailMetrics: map[string]*client.PassFailMetric{
+				"previous": {
+					ClientMetric: pointer.To("error"),
+				},
+			},
+		},
+		Secrets: map[string]*client.Secret{
+			"removed": {
+				Value: pointer.To("https://example.vault.azure.net/secrets/removed"),
+			},
+			"retained": {
+				Value: pointer.To("https://example.vault.azure.net/secrets/retained"),
+			},
+		},
+		EnvironmentVariables: map[string]*string{
+			"REMOVED": pointer.To("value"),
+		},
+	}
+
+	input := LoadTestTestResourceModel{
+		DisplayName:     "Sample",
+		Kind:            client.TestKindJMX,
+		EngineInstances: 2,
+		PassFailCriterion: []LoadTestPassFailCriterion{
+			{
+				ClientMetric: "response_time_ms",
+				Aggregate:    "p90",
+				Condition:    ">",
+				Threshold:    300,
+				Action:       "continue",
+			},
+		},
+		Secret: []LoadTestSecret{
+			{
+				Name:             "retained",
+				KeyVaultSecretId: "https://example.vault.azure.net/secrets/retained/version",
+			},
+		},
+		EnvironmentVariables: map[string]string{
+			"ADDED": "value",
+		},
+	}
+
+	actual, err := expandLoadTestTest(input, existing)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if v, ok := actual.PassFailCriteria.PassFailMetrics["previous"]; !ok || v != nil {
+		t.Fatalf("expected the previous criterion to be sent as null but got %+v", v)
+	}
+	criteria := 0
+	for _, v := range actual.PassFailCriteria.PassFailMetrics {
+		if v != nil {
+			criteria++
+			if pointer.From(v.Value) != 300 || pointer.From(v.RequestName) != "" || v.RequestName != nil {
+				t.Fatalf("unexpected criterion %+v", v)
+			}
+		}
+	}
+	if criteria != 1 {
+		t.Fatalf("expected a single criterion but got %d", criteria)
+	}
+
+	if v, ok := actual.Secrets["removed"]; !ok || v != nil {
+		t.Fatalf("expected the removed secret to be sent as null but got %+v", v)
+	}
+	if v := actual.Secrets["retained"]; v == nil || pointer.From(v.Type) != client.SecretTypeKeyVaultSecretUri {
+		t.Fatalf("expected the retained secret to reference Key Vault but got %+v", v)
+	}
+
+	if v, ok := actual.EnvironmentVariables["REMOVED"]; !ok || v != nil {
+		t.Fatalf("expected the removed environment variable to be sent as null but got %+v", v)
+	}
+	if pointer.From(actual.EnvironmentVariables["ADDED"]) != "value" {
+		t.Fatalf("expected the added environment variable to be present")
+	}
+
+	if pointer.From(actual.KeyVaultReferenceIdentityType) != "SystemAssigned" {
+		t.Fatalf("expected the SystemAssigned identity to be used for Key Vault references but got %q", pointer.From(actual.KeyVaultReferenceIdentityType))
+	}
+}
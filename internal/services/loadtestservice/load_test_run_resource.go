@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadtestservice
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/client"
+	loadTestParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = LoadTestRunResource{}
+
+type LoadTestRunResource struct{}
+
+type LoadTestRunResourceModel struct {
+	Name                   string                         `tfschema:"name"`
+	LoadTestTestId         string                         `tfschema:"load_test_test_id"`
+	DisplayName            string                         `tfschema:"display_name"`
+	Description            string                         `tfschema:"description"`
+	FailOnTestFailure      bool                           `tfschema:"fail_on_test_failure"`
+	Status                 string                         `tfschema:"status"`
+	TestResult             string                         `tfschema:"test_result"`
+	StartTime              string                         `tfschema:"start_time"`
+	EndTime                string                         `tfschema:"end_time"`
+	DurationInMilliseconds int64                          `tfschema:"duration_in_milliseconds"`
+	VirtualUsers           int64                          `tfschema:"virtual_users"`
+	PortalUrl              string                         `tfschema:"portal_url"`
+	PassFailCriterion      []LoadTestRunPassFailCriterion `tfschema:"pass_fail_criterion"`
+	ErrorMessages          []string                       `tfschema:"error_messages"`
+}
+
+type LoadTestRunPassFailCriterion struct {
+	ClientMetric string  `tfschema:"client_metric"`
+	Aggregate    string  `tfschema:"aggregate"`
+	Condition    string  `tfschema:"condition"`
+	Threshold    float64 `tfschema:"threshold"`
+	RequestName  string  `tfschema:"request_name"`
+	ActualValue  float64 `tfschema:"actual_value"`
+	Result       string  `tfschema:"result"`
+}
+
+func (r LoadTestRunResource) ModelObject() interface{} {
+	return &LoadTestRunResourceModel{}
+}
+
+func (r LoadTestRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.LoadTestRunID
+}
+
+func (r LoadTestRunResource) ResourceType() string {
+	return "azurerm_load_test_run"
+}
+
+func (r LoadTestRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneName,
+		},
+
+		"load_test_test_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.LoadTestTestID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 100),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(2, 50),
+		},
+
+		"fail_on_test_failure": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r LoadTestRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"duration_in_milliseconds": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"end_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"error_messages": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"pass_fail_criterion": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"actual_value": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"aggregate": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"client_metric": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"condition": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"request_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"result": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"threshold": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"portal_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"test_result": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"virtual_users": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r LoadTestRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config LoadTestRunResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			testId, err := loadTestParse.LoadTestTestID(config.LoadTestTestId)
+			if err != nil {
+				return err
+			}
+
+			id := loadTestParse.NewLoadTestRunId(testId.SubscriptionId, testId.ResourceGroupName, testId.LoadTestName, config.Name)
+
+			loadTestId := loadtests.NewLoadTestID(testId.SubscriptionId, testId.ResourceGroupName, testId.LoadTestName)
+			dataPlaneClient, exists, err := dataPlaneClientForLoadTest(ctx, metadata.Client.LoadTestService, loadTestId)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return fmt.Errorf("%s was not found", loadTestId)
+			}
+
+			existing, err := dataPlaneClient.GetTestRun(ctx, id.TestRunName)
+			if err != nil {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if existing != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := client.TestRun{
+				TestId:      pointer.To(testId.TestName),
+				DisplayName: pointer.To(config.DisplayName),
+			}
+			if config.DisplayName == "" {
+				payload.DisplayName = pointer.To(config.Name)
+			}
+			if config.Description != "" {
+				payload.Description = pointer.To(config.Description)
+			}
+
+			if _, err := dataPlaneClient.CreateTestRun(ctx, id.TestRunName, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			run, err := waitForTestRunToFinish(ctx, dataPlaneClient, id.TestRunName)
+			if err != nil {
+				return fmt.Errorf("waiting for %s: %+v", id, err)
+			}
+
+			// the run is kept in the state so that its results can be inspected, however it's marked as tainted by
+			// returning an error so that a subsequent apply triggers a new run
+			if config.FailOnTestFailure {
+				if status := pointer.From(run.Status); status != client.TestRunStatusDone {
+					return fmt.Errorf("%s finished with the status %q", id, status)
+				}
+				if result := pointer.From(run.TestResult); result == client.TestRunResultFailed {
+					return fmt.Errorf("%s failed one or more pass/fail criteria", id)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LoadTestRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := loadTestParse.LoadTestRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroupName, id.LoadTestName)
+			dataPlaneClient, exists, err := dataPlaneClientForLoadTest(ctx, metadata.Client.LoadTestService, loadTestId)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return metadata.MarkAsGone(id)
+			}
+
+			run, err := dataPlaneClient.GetTestRun(ctx, id.TestRunName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if run == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := LoadTestRunResourceModel{
+				Name:                   id.TestRunName,
+				LoadTestTestId:         loadTestParse.NewLoadTestTestId(id.SubscriptionId, id.ResourceGroupName, id.LoadTestName, pointer.From(run.TestId)).ID(),
+				DisplayName:            pointer.From(run.DisplayName),
+				Description:            pointer.From(run.Description),
+				FailOnTestFailure:      metadata.ResourceData.Get("fail_on_test_failure").(bool),
+				Status:                 pointer.From(run.Status),
+				TestResult:             pointer.From(run.TestResult),
+				StartTime:              pointer.From(run.StartDateTime),
+				EndTime:                pointer.From(run.EndDateTime),
+				DurationInMilliseconds: pointer.From(run.Duration),
+				VirtualUsers:           pointer.From(run.VirtualUsers),
+				PortalUrl:              pointer.From(run.PortalUrl),
+				PassFailCriterion:      flattenLoadTestRunPassFailCriteria(run.PassFailCriteria),
+				ErrorMessages:          make([]string, 0),
+			}
+			for _, v := range run.ErrorDetails {
+				if v.Message != nil {
+					state.ErrorMessages = append(state.ErrorMessages, *v.Message)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LoadTestRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := loadTestParse.LoadTestRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroupName, id.LoadTestName)
+			dataPlaneClient, exists, err := dataPlaneClientForLoadTest(ctx, metadata.Client.LoadTestService, loadTestId)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return nil
+			}
+
+			run, err := dataPlaneClient.GetTestRun(ctx, id.TestRunName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if run == nil {
+				return nil
+			}
+
+			// a run which is still in progress has to be stopped before it can be deleted
+			if !client.IsTestRunInTerminalState(pointer.From(run.Status)) {
+				if err := dataPlaneClient.StopTestRun(ctx, id.TestRunName); err != nil {
+					return fmt.Errorf("stopping %s: %+v", id, err)
+				}
+				if _, err := waitForTestRunToFinish(ctx, dataPlaneClient, id.TestRunName); err != nil {
+					return fmt.Errorf("waiting for %s to stop: %+v", id, err)
+				}
+			}
+
+			if err := dataPlaneClient.DeleteTestRun(ctx, id.TestRunName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenLoadTestRunPassFailCriteria(input *client.PassFailCriteria) []LoadTestRunPassFailCriterion {
+	output := make([]LoadTestRunPassFailCriterion, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range input.PassFailMetrics {
+		if v == nil {
+			continue
+		}
+		output = append(output, LoadTestRunPassFailCriterion{
+			ClientMetric: pointer.From(v.ClientMetric),
+			Aggregate:    pointer.From(v.Aggregate),
+			Condition:    pointer.From(v.Condition),
+			Threshold:    pointer.From(v.Value),
+			RequestName:  pointer.From(v.RequestName),
+			ActualValue:  pointer.From(v.ActualValue),
+			Result:       pointer.From(v.Result),
+		})
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].ClientMetric != output[j].ClientMetric {
+			return output[i].ClientMetric < output[j].ClientMetric
+		}
+		if output[i].Aggregate != output[j].Aggregate {
+			return output[i].Aggregate < output[j].Aggregate
+		}
+		return output[i].RequestName < output[j].RequestName
+	})
+
+	return output
+}
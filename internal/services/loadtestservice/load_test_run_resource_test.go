@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadtestservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LoadTestRunResource struct{}
+
+func TestAccLoadTestRun_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test_run", "test")
+	r := LoadTestRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("DONE"),
+				check.That(data.ResourceName).Key("test_result").Exists(),
+			),
+		},
+		data.ImportStep("fail_on_test_failure"),
+	})
+}
+
+func TestAccLoadTestRun_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test_run", "test")
+	r := LoadTestRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLoadTestRun_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test_run", "test")
+	r := LoadTestRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("test_result").HasValue("PASSED"),
+				check.That(data.ResourceName).Key("pass_fail_criterion.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("fail_on_test_failure"),
+	})
+}
+
+func (r LoadTestRunResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LoadTestRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroupName, id.LoadTestName)
+	resp, err := clients.LoadTestService.V20221201.LoadTests.Get(ctx, loadTestId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", loadTestId, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", loadTestId)
+	}
+
+	dataPlaneClient, err := clients.LoadTestService.DataPlaneClient(pointer.From(resp.Model.Properties.DataPlaneURI))
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := dataPlaneClient.GetTestRun(ctx, id.TestRunName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(run != nil), nil
+}
+
+func (r LoadTestRunResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_load_test_run" "test" {
+  name              = "acctest-run-%d"
+  load_test_test_id = azurerm_load_test_test.test.id
+}
+`, LoadTestDataPlaneTestResource{}.basic(data), data.RandomInteger)
+}
+
+func (r LoadTestRunResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_load_test_run" "import" {
+  name              = azurerm_load_test_run.test.name
+  load_test_test_id = azurerm_load_test_run.test.load_test_test_id
+}
+`, r.basic(data))
+}
+
+func (r LoadTestRunResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_load_test_test" "test" {
+  name         = "acctest-%[2]d"
+  load_test_id = azurerm_load_test.test.id
+  display_name = "Acceptance Test"
+
+  test_script {
+    file_name = "sample.jmx"
+    content   = local.test_script
+  }
+
+  pass_fail_criterion {
+    client_metric = "error"
+    aggregate     = "percentage"
+    condition     = ">"
+    threshold     = 100
+  }
+}
+
+resource "azurerm_load_test_run" "test" {
+  name                 = "acctest-run-%[2]d"
+  load_test_test_id    = azurerm_load_test_test.test.id
+  display_name         = "Acceptance Test Run"
+  description          = "Triggered by Terraform"
+  fail_on_test_failure = true
+}
+`, LoadTestDataPlaneTestResource{}.template(data), data.RandomInteger)
+}
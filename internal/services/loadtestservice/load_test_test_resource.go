@@ -0,0 +1,699 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadtestservice
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/client"
+	loadTestParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = LoadTestTestResource{}
+
+type LoadTestTestResource struct{}
+
+type LoadTestTestResourceModel struct {
+	Name                        string                      `tfschema:"name"`
+	LoadTestId                  string                      `tfschema:"load_test_id"`
+	DisplayName                 string                      `tfschema:"display_name"`
+	Description                 string                      `tfschema:"description"`
+	Kind                        string                      `tfschema:"kind"`
+	EngineInstances             int64                       `tfschema:"engine_instances"`
+	TestScript                  []LoadTestTestScript        `tfschema:"test_script"`
+	PassFailCriterion           []LoadTestPassFailCriterion `tfschema:"pass_fail_criterion"`
+	AppComponentIds             []string                    `tfschema:"app_component_ids"`
+	Secret                      []LoadTestSecret            `tfschema:"secret"`
+	EnvironmentVariables        map[string]string           `tfschema:"environment_variables"`
+	KeyVaultReferenceIdentityId string                      `tfschema:"key_vault_reference_identity_id"`
+}
+
+type LoadTestTestScript struct {
+	FileName string `tfschema:"file_name"`
+	Content  string `tfschema:"content"`
+}
+
+type LoadTestPassFailCriterion struct {
+	ClientMetric string  `tfschema:"client_metric"`
+	Aggregate    string  `tfschema:"aggregate"`
+	Condition    string  `tfschema:"condition"`
+	Threshold    float64 `tfschema:"threshold"`
+	RequestName  string  `tfschema:"request_name"`
+	Action       string  `tfschema:"action"`
+}
+
+type LoadTestSecret struct {
+	Name             string `tfschema:"name"`
+	KeyVaultSecretId string `tfschema:"key_vault_secret_id"`
+}
+
+func (r LoadTestTestResource) ModelObject() interface{} {
+	return &LoadTestTestResourceModel{}
+}
+
+func (r LoadTestTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.LoadTestTestID
+}
+
+func (r LoadTestTestResource) ResourceType() string {
+	return "azurerm_load_test_test"
+}
+
+func (r LoadTestTestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DataPlaneName,
+		},
+
+		"load_test_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: loadtests.ValidateLoadTestID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(2, 50),
+		},
+
+		"test_script": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"file_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"content": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"app_component_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 100),
+		},
+
+		"engine_instances": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 400),
+		},
+
+		"environment_variables": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"key_vault_reference_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  client.TestKindJMX,
+			ValidateFunc: validation.StringInSlice([]string{
+				client.TestKindJMX,
+				client.TestKindLocust,
+			}, false),
+		},
+
+		"pass_fail_criterion": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"aggregate": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"avg",
+							"count",
+							"max",
+							"min",
+							"p50",
+							"p75",
+							"p90",
+							"p95",
+							"p96",
+							"p97",
+							"p98",
+							"p99",
+							"p99.9",
+							"p99.99",
+							"percentage",
+						}, false),
+					},
+
+					"client_metric": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"error",
+							"latency",
+							"requests",
+							"requests_per_sec",
+							"response_time_ms",
+						}, false),
+					},
+
+					"condition": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"<",
+							">",
+						}, false),
+					},
+
+					"threshold": {
+						Type:     pluginsdk.TypeFloat,
+						Required: true,
+					},
+
+					"action": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  "continue",
+						ValidateFunc: validation.StringInSlice([]string{
+							"continue",
+							"stop",
+						}, false),
+					},
+
+					"request_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"secret": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"key_vault_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemId,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r LoadTestTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LoadTestTestResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config LoadTestTestResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			loadTestId, err := loadtests.ParseLoadTestID(config.LoadTestId)
+			if err != nil {
+				return err
+			}
+
+			id := loadTestParse.NewLoadTestTestId(loadTestId.SubscriptionId, loadTestId.ResourceGroupName, loadTestId.LoadTestName, config.Name)
+
+			dataPlaneClient, exists, err := dataPlaneClientForLoadTest(ctx, metadata.Client.LoadTestService, *loadTestId)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return fmt.Errorf("%s was not found", *loadTestId)
+			}
+
+			existing, err := dataPlaneClient.GetTest(ctx, id.TestName)
+			if err != nil {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if existing != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandLoadTestTest(config, nil)
+			if err != nil {
+				return err
+			}
+			if _, err := dataPlaneClient.CreateOrUpdateTest(ctx, id.TestName, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if err := uploadLoadTestTestScript(ctx, dataPlaneClient, id.TestName, config.Kind, config.TestScript, ""); err != nil {
+				return fmt.Errorf("uploading the test script for %s: %+v", id, err)
+			}
+
+			if len(config.AppComponentIds) > 0 {
+				components, err := expandLoadTestAppComponents(config.AppComponentIds, nil)
+				if err != nil {
+					return err
+				}
+				if _, err := dataPlaneClient.CreateOrUpdateAppComponents(ctx, id.TestName, components); err != nil {
+					return fmt.Errorf("creating the app components for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LoadTestTestResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := loadTestParse.LoadTestTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroupName, id.LoadTestName)
+			dataPlaneClient, exists, err := dataPlaneClientForLoadTest(ctx, metadata.Client.LoadTestService, loadTestId)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return metadata.MarkAsGone(id)
+			}
+
+			test, err := dataPlaneClient.GetTest(ctx, id.TestName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if test == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			// the content of the test script can't be retrieved from the API, so is preserved from the existing state
+			var existing LoadTestTestResourceModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := LoadTestTestResourceModel{
+				Name:                        id.TestName,
+				LoadTestId:                  loadTestId.ID(),
+				DisplayName:                 pointer.From(test.DisplayName),
+				Description:                 pointer.From(test.Description),
+				Kind:                        pointer.From(test.Kind),
+				EngineInstances:             1,
+				PassFailCriterion:           flattenLoadTestPassFailCriteria(test.PassFailCriteria),
+				Secret:                      flattenLoadTestSecrets(test.Secrets),
+				EnvironmentVariables:        flattenLoadTestEnvironmentVariables(test.EnvironmentVariables),
+				KeyVaultReferenceIdentityId: pointer.From(test.KeyVaultReferenceIdentityId),
+			}
+			if state.Kind == "" {
+				state.Kind = client.TestKindJMX
+			}
+			if configuration := test.LoadTestConfiguration; configuration != nil && configuration.EngineInstances != nil {
+				state.EngineInstances = *configuration.EngineInstances
+			}
+
+			if artifacts := test.InputArtifacts; artifacts != nil && artifacts.TestScriptFileInfo != nil {
+				script := LoadTestTestScript{
+					FileName: pointer.From(artifacts.TestScriptFileInfo.FileName),
+				}
+				if len(existing.TestScript) > 0 && existing.TestScript[0].FileName == script.FileName {
+					script.Content = existing.TestScript[0].Content
+				}
+				state.TestScript = []LoadTestTestScript{script}
+			}
+
+			components, err := dataPlaneClient.GetAppComponents(ctx, id.TestName)
+			if err != nil {
+				return fmt.Errorf("retrieving app components for %s: %+v", id, err)
+			}
+			state.AppComponentIds = flattenLoadTestAppComponents(components.Components)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LoadTestTestResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := loadTestParse.LoadTestTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config LoadTestTestResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroupName, id.LoadTestName)
+			dataPlaneClient, exists, err := dataPlaneClientForLoadTest(ctx, metadata.Client.LoadTestService, loadTestId)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return fmt.Errorf("%s was not found", loadTestId)
+			}
+
+			existing, err := dataPlaneClient.GetTest(ctx, id.TestName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing == nil {
+				return fmt.Errorf("retrieving %s: test was not found", id)
+			}
+
+			if metadata.ResourceData.HasChangesExcept("test_script", "app_component_ids") {
+				payload, err := expandLoadTestTest(config, existing)
+				if err != nil {
+					return err
+				}
+				if _, err := dataPlaneClient.CreateOrUpdateTest(ctx, id.TestName, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("test_script") {
+				previousFileName := ""
+				if artifacts := existing.InputArtifacts; artifacts != nil && artifacts.TestScriptFileInfo != nil {
+					previousFileName = pointer.From(artifacts.TestScriptFileInfo.FileName)
+				}
+				if err := uploadLoadTestTestScript(ctx, dataPlaneClient, id.TestName, config.Kind, config.TestScript, previousFileName); err != nil {
+					return fmt.Errorf("uploading the test script for %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("app_component_ids") {
+				existingComponents, err := dataPlaneClient.GetAppComponents(ctx, id.TestName)
+				if err != nil {
+					return fmt.Errorf("retrieving app components for %s: %+v", id, err)
+				}
+				components, err := expandLoadTestAppComponents(config.AppComponentIds, existingComponents.Components)
+				if err != nil {
+					return err
+				}
+				if _, err := dataPlaneClient.CreateOrUpdateAppComponents(ctx, id.TestName, components); err != nil {
+					return fmt.Errorf("updating the app components for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LoadTestTestResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := loadTestParse.LoadTestTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroupName, id.LoadTestName)
+			dataPlaneClient, exists, err := dataPlaneClientForLoadTest(ctx, metadata.Client.LoadTestService, loadTestId)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return nil
+			}
+
+			if err := dataPlaneClient.DeleteTest(ctx, id.TestName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// expandLoadTestTest builds the JSON Merge Patch payload for the Test, any pass/fail criteria, secrets and environment
+// variables present in `existing` but no longer defined in `input` are sent as `null` so that they're removed.
+func expandLoadTestTest(input LoadTestTestResourceModel, existing *client.Test) (*client.Test, error) {
+	output := &client.Test{
+		DisplayName: pointer.To(input.DisplayName),
+		Description: pointer.To(input.Description),
+		Kind:        pointer.To(input.Kind),
+		LoadTestConfiguration: &client.LoadTestConfiguration{
+			EngineInstances: pointer.To(input.EngineInstances),
+		},
+		PassFailCriteria: &client.PassFailCriteria{
+			PassFailMetrics: map[string]*client.PassFailMetric{},
+		},
+		Secrets:              map[string]*client.Secret{},
+		EnvironmentVariables: map[string]*string{},
+	}
+
+	if input.KeyVaultReferenceIdentityId != "" {
+		output.KeyVaultReferenceIdentityType = pointer.To("UserAssigned")
+		output.KeyVaultReferenceIdentityId = pointer.To(input.KeyVaultReferenceIdentityId)
+	} else {
+		output.KeyVaultReferenceIdentityType = pointer.To("SystemAssigned")
+	}
+
+	if existing != nil {
+		if existing.PassFailCriteria != nil {
+			for k := range existing.PassFailCriteria.PassFailMetrics {
+				output.PassFailCriteria.PassFailMetrics[k] = nil
+			}
+		}
+		for k := range existing.Secrets {
+			output.Secrets[k] = nil
+		}
+		for k := range existing.EnvironmentVariables {
+			output.EnvironmentVariables[k] = nil
+		}
+	}
+
+	// the criteria are keyed by an identifier which isn't exposed, since any existing criteria are removed above a new
+	// identifier is generated for each criterion every time the Test is updated
+	for _, v := range input.PassFailCriterion {
+		key, err := uuid.GenerateUUID()
+		if err != nil {
+			return nil, fmt.Errorf("generating an identifier for the pass/fail criterion: %+v", err)
+		}
+
+		metric := &client.PassFailMetric{
+			ClientMetric: pointer.To(v.ClientMetric),
+			Aggregate:    pointer.To(v.Aggregate),
+			Condition:    pointer.To(v.Condition),
+			Value:        pointer.To(v.Threshold),
+			Action:       pointer.To(v.Action),
+		}
+		if v.RequestName != "" {
+			metric.RequestName = pointer.To(v.RequestName)
+		}
+		output.PassFailCriteria.PassFailMetrics[key] = metric
+	}
+
+	for _, v := range input.Secret {
+		output.Secrets[v.Name] = &client.Secret{
+			Value: pointer.To(v.KeyVaultSecretId),
+			Type:  pointer.To(client.SecretTypeKeyVaultSecretUri),
+		}
+	}
+
+	for k, v := range input.EnvironmentVariables {
+		output.EnvironmentVariables[k] = pointer.To(v)
+	}
+
+	return output, nil
+}
+
+// expandLoadTestAppComponents builds the JSON Merge Patch payload for the App Components of a Test, removing any
+// components present in `existing` which are no longer defined.
+func expandLoadTestAppComponents(input []string, existing map[string]*client.AppComponent) (*client.TestAppComponents, error) {
+	output := &client.TestAppComponents{
+		Components: map[string]*client.AppComponent{},
+	}
+
+	for k := range existing {
+		output.Components[k] = nil
+	}
+
+	for _, v := range input {
+		id, err := resourceids.ParseAzureResourceID(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing app component %q: %+v", v, err)
+		}
+
+		resourceType, resourceName, err := parseLoadTestAppComponentType(v)
+		if err != nil {
+			return nil, err
+		}
+
+		output.Components[v] = &client.AppComponent{
+			ResourceId:     pointer.To(v),
+			ResourceName:   pointer.To(resourceName),
+			ResourceType:   pointer.To(resourceType),
+			ResourceGroup:  pointer.To(id.ResourceGroup),
+			SubscriptionId: pointer.To(id.SubscriptionID),
+		}
+	}
+
+	return output, nil
+}
+
+// uploadLoadTestTestScript uploads the test script and waits for the service to validate it, removing the previous
+// test script when the file name has changed.
+func uploadLoadTestTestScript(ctx context.Context, dataPlaneClient *client.DataPlaneClient, testName, kind string, input []LoadTestTestScript, previousFileName string) error {
+	if len(input) == 0 {
+		return nil
+	}
+	script := input[0]
+
+	fileType := client.FileTypeJmxFile
+	if kind == client.TestKindLocust {
+		fileType = client.FileTypeTestScript
+	}
+
+	if _, err := dataPlaneClient.UploadTestFile(ctx, testName, script.FileName, fileType, []byte(script.Content)); err != nil {
+		return err
+	}
+
+	if err := waitForTestFileValidation(ctx, dataPlaneClient, testName, script.FileName); err != nil {
+		return err
+	}
+
+	if previousFileName != "" && previousFileName != script.FileName {
+		if err := dataPlaneClient.DeleteTestFile(ctx, testName, previousFileName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flattenLoadTestPassFailCriteria(input *client.PassFailCriteria) []LoadTestPassFailCriterion {
+	output := make([]LoadTestPassFailCriterion, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range input.PassFailMetrics {
+		if v == nil {
+			continue
+		}
+		output = append(output, LoadTestPassFailCriterion{
+			ClientMetric: pointer.From(v.ClientMetric),
+			Aggregate:    pointer.From(v.Aggregate),
+			Condition:    pointer.From(v.Condition),
+			Threshold:    pointer.From(v.Value),
+			RequestName:  pointer.From(v.RequestName),
+			Action:       pointer.From(v.Action),
+		})
+	}
+
+	return output
+}
+
+func flattenLoadTestSecrets(input map[string]*client.Secret) []LoadTestSecret {
+	output := make([]LoadTestSecret, 0)
+	for k, v := range input {
+		if v == nil {
+			continue
+		}
+		output = append(output, LoadTestSecret{
+			Name:             k,
+			KeyVaultSecretId: pointer.From(v.Value),
+		})
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].Name < output[j].Name
+	})
+
+	return output
+}
+
+func flattenLoadTestEnvironmentVariables(input map[string]*string) map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		if v != nil {
+			output[k] = *v
+		}
+	}
+	return output
+}
+
+func flattenLoadTestAppComponents(input map[string]*client.AppComponent) []string {
+	output := make([]string, 0)
+	for k, v := range input {
+		if v == nil {
+			continue
+		}
+		id := pointer.From(v.ResourceId)
+		if id == "" {
+			id = k
+		}
+		output = append(output, id)
+	}
+
+	sort.Strings(output)
+
+	return output
+}
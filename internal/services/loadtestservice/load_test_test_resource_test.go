@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package loadtestservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// LoadTestDataPlaneTestResource tests the `azurerm_load_test_test` resource, the name `LoadTestTestResource` is
+// already used for the tests of the `azurerm_load_test` resource.
+type LoadTestDataPlaneTestResource struct{}
+
+func TestAccLoadTestTest_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test_test", "test")
+	r := LoadTestDataPlaneTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("test_script.0.content"),
+	})
+}
+
+func TestAccLoadTestTest_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test_test", "test")
+	r := LoadTestDataPlaneTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLoadTestTest_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test_test", "test")
+	r := LoadTestDataPlaneTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pass_fail_criterion.#").HasValue("2"),
+				check.That(data.ResourceName).Key("secret.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("test_script.0.content"),
+	})
+}
+
+func TestAccLoadTestTest_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test_test", "test")
+	r := LoadTestDataPlaneTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("test_script.0.content"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("test_script.0.content"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pass_fail_criterion.#").HasValue("0"),
+				check.That(data.ResourceName).Key("secret.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("test_script.0.content"),
+	})
+}
+
+func (r LoadTestDataPlaneTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LoadTestTestID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroupName, id.LoadTestName)
+	resp, err := clients.LoadTestService.V20221201.LoadTests.Get(ctx, loadTestId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", loadTestId, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", loadTestId)
+	}
+
+	dataPlaneClient, err := clients.LoadTestService.DataPlaneClient(pointer.From(resp.Model.Properties.DataPlaneURI))
+	if err != nil {
+		return nil, err
+	}
+
+	test, err := dataPlaneClient.GetTest(ctx, id.TestName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(test != nil), nil
+}
+
+func (r LoadTestDataPlaneTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_load_test_test" "test" {
+  name         = "acctest-%d"
+  load_test_id = azurerm_load_test.test.id
+  display_name = "Acceptance Test"
+
+  test_script {
+    file_name = "sample.jmx"
+    content   = local.test_script
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LoadTestDataPlaneTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_load_test_test" "import" {
+  name         = azurerm_load_test_test.test.name
+  load_test_id = azurerm_load_test_test.test.load_test_id
+  display_name = azurerm_load_test_test.test.display_name
+
+  test_script {
+    file_name = "sample.jmx"
+    content   = local.test_script
+  }
+}
+`, r.basic(data))
+}
+
+func (r LoadTestDataPlaneTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id          = data.azurerm_client_config.current.tenant_id
+    object_id          = data.azurerm_client_config.current.object_id
+    secret_permissions = ["Delete", "Get", "Purge", "Set"]
+  }
+
+  access_policy {
+    tenant_id          = azurerm_user_assigned_identity.test.tenant_id
+    object_id          = azurerm_user_assigned_identity.test.principal_id
+    secret_permissions = ["Get"]
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "token"
+  value        = "s3cr3t"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_load_test_test" "test" {
+  name             = "acctest-%[2]d"
+  load_test_id     = azurerm_load_test.test.id
+  display_name     = "Acceptance Test Updated"
+  description      = "Created by Terraform"
+  engine_instances = 2
+
+  test_script {
+    file_name = "sample-updated.jmx"
+    content   = local.test_script
+  }
+
+  pass_fail_criterion {
+    client_metric = "error"
+    aggregate     = "percentage"
+    condition     = ">"
+    threshold     = 5
+  }
+
+  pass_fail_criterion {
+    client_metric = "response_time_ms"
+    aggregate     = "p90"
+    condition     = ">"
+    threshold     = 500
+    request_name  = "Home"
+    action        = "stop"
+  }
+
+  secret {
+    name                = "token"
+    key_vault_secret_id = azurerm_key_vault_secret.test.id
+  }
+
+  environment_variables = {
+    TARGET_HOST = "example.com"
+  }
+
+  key_vault_reference_identity_id = azurerm_user_assigned_identity.test.id
+  app_component_ids               = [azurerm_user_assigned_identity.test.id]
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r LoadTestDataPlaneTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_load_test" "test" {
+  location            = azurerm_resource_group.test.location
+  name                = "acctestlt-${var.random_string}"
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+
+locals {
+  test_script = <<XML
+<?xml version="1.0" encoding="UTF-8"?>
+<jmeterTestPlan version="1.2" properties="5.0" jmeter="5.5">
+  <hashTree>
+    <TestPlan guiclass="TestPlanGui" testclass="TestPlan" testname="Sample" enabled="true">
+      <elementProp name="TestPlan.user_defined_variables" elementType="Arguments" guiclass="ArgumentsPanel" testclass="Arguments" enabled="true">
+        <collectionProp name="Arguments.arguments"/>
+      </elementProp>
+    </TestPlan>
+    <hashTree>
+      <ThreadGroup guiclass="ThreadGroupGui" testclass="ThreadGroup" testname="Users" enabled="true">
+        <stringProp name="ThreadGroup.on_sample_error">continue</stringProp>
+        <elementProp name="ThreadGroup.main_controller" elementType="LoopController" guiclass="LoopControlPanel" testclass="LoopController" enabled="true">
+          <boolProp name="LoopController.continue_forever">false</boolProp>
+          <stringProp name="LoopController.loops">1</stringProp>
+        </elementProp>
+        <stringProp name="ThreadGroup.num_threads">1</stringProp>
+        <stringProp name="ThreadGroup.ramp_time">1</stringProp>
+      </ThreadGroup>
+      <hashTree>
+        <HTTPSamplerProxy guiclass="HttpTestSampleGui" testclass="HTTPSamplerProxy" testname="Home" enabled="true">
+          <stringProp name="HTTPSampler.domain">example.com</stringProp>
+          <stringProp name="HTTPSampler.protocol">https</stringProp>
+          <stringProp name="HTTPSampler.path">/</stringProp>
+          <stringProp name="HTTPSampler.method">GET</stringProp>
+        </HTTPSamplerProxy>
+        <hashTree/>
+      </hashTree>
+    </hashTree>
+  </hashTree>
+</jmeterTestPlan>
+XML
+}
+`, LoadTestTestResource{}.template(data))
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &LoadTestRunId{}
+
+// LoadTestRunId is a struct representing the Resource ID for a Load Test Run
+type LoadTestRunId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	LoadTestName      string
+	TestRunName       string
+}
+
+// NewLoadTestRunId returns a new LoadTestRunId struct
+func NewLoadTestRunId(subscriptionId string, resourceGroupName string, loadTestName string, testRunName string) LoadTestRunId {
+	return LoadTestRunId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		LoadTestName:      loadTestName,
+		TestRunName:       testRunName,
+	}
+}
+
+// LoadTestRunID parses 'input' into a LoadTestRunId
+func LoadTestRunID(input string) (*LoadTestRunId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&LoadTestRunId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := LoadTestRunId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// LoadTestRunIDInsensitively parses 'input' case-insensitively into a LoadTestRunId
+// note: this method should only be used for API response data and not user input
+func LoadTestRunIDInsensitively(input string) (*LoadTestRunId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&LoadTestRunId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := LoadTestRunId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *LoadTestRunId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.LoadTestName, ok = input.Parsed["loadTestName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "loadTestName", input)
+	}
+
+	if id.TestRunName, ok = input.Parsed["testRunName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "testRunName", input)
+	}
+
+	return nil
+}
+
+// ID returns the formatted Load Test Run ID
+func (id LoadTestRunId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.LoadTestService/loadTests/%s/testRuns/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.LoadTestName, id.TestRunName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Load Test Run ID
+func (id LoadTestRunId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftLoadTestService", "Microsoft.LoadTestService", "Microsoft.LoadTestService"),
+		resourceids.StaticSegment("staticLoadTests", "loadTests", "loadTests"),
+		resourceids.UserSpecifiedSegment("loadTestName", "loadTestValue"),
+		resourceids.StaticSegment("staticTestRuns", "testRuns", "testRuns"),
+		resourceids.UserSpecifiedSegment("testRunName", "testRunValue"),
+	}
+}
+
+// String returns a human-readable description of this Load Test Run ID
+func (id LoadTestRunId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Load Test Name: %q", id.LoadTestName),
+		fmt.Sprintf("Test Run Name: %q", id.TestRunName),
+	}
+	return fmt.Sprintf("Load Test Run (%s)", strings.Join(components, "\n"))
+}
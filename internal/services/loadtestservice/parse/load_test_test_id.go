@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &LoadTestTestId{}
+
+// LoadTestTestId is a struct representing the Resource ID for a Load Test Test
+type LoadTestTestId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	LoadTestName      string
+	TestName          string
+}
+
+// NewLoadTestTestId returns a new LoadTestTestId struct
+func NewLoadTestTestId(subscriptionId string, resourceGroupName string, loadTestName string, testName string) LoadTestTestId {
+	return LoadTestTestId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		LoadTestName:      loadTestName,
+		TestName:          testName,
+	}
+}
+
+// LoadTestTestID parses 'input' into a LoadTestTestId
+func LoadTestTestID(input string) (*LoadTestTestId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&LoadTestTestId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := LoadTestTestId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// LoadTestTestIDInsensitively parses 'input' case-insensitively into a LoadTestTestId
+// note: this method should only be used for API response data and not user input
+func LoadTestTestIDInsensitively(input string) (*LoadTestTestId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&LoadTestTestId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := LoadTestTestId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *LoadTestTestId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.LoadTestName, ok = input.Parsed["loadTestName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "loadTestName", input)
+	}
+
+	if id.TestName, ok = input.Parsed["testName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "testName", input)
+	}
+
+	return nil
+}
+
+// ID returns the formatted Load Test Test ID
+func (id LoadTestTestId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.LoadTestService/loadTests/%s/tests/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.LoadTestName, id.TestName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Load Test Test ID
+func (id LoadTestTestId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftLoadTestService", "Microsoft.LoadTestService", "Microsoft.LoadTestService"),
+		resourceids.StaticSegment("staticLoadTests", "loadTests", "loadTests"),
+		resourceids.UserSpecifiedSegment("loadTestName", "loadTestValue"),
+		resourceids.StaticSegment("staticTests", "tests", "tests"),
+		resourceids.UserSpecifiedSegment("testName", "testValue"),
+	}
+}
+
+// String returns a human-readable description of this Load Test Test ID
+func (id LoadTestTestId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Load Test Name: %q", id.LoadTestName),
+		fmt.Sprintf("Test Name: %q", id.TestName),
+	}
+	return fmt.Sprintf("Load Test Test (%s)", strings.Join(components, "\n"))
+}
@@ -34,5 +34,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		LoadTestResource{},
+		LoadTestRunResource{},
+		LoadTestTestResource{},
 	}
 }
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DataPlaneName validates the identifier of a Test or Test Run within a Load Testing resource
+func DataPlaneName(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-z0-9_-]{2,50}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 50 characters and may only contain lowercase letters, numbers, underscores and hyphens", key))
+	}
+
+	return
+}
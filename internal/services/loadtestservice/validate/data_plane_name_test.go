@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestDataPlaneName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "a",
+			Valid: false,
+		},
+		{
+			Input: "ab",
+			Valid: true,
+		},
+		{
+			Input: "sample-test_01",
+			Valid: true,
+		},
+		{
+			Input: "Sample",
+			Valid: false,
+		},
+		{
+			Input: "sample.test",
+			Valid: false,
+		},
+		{
+			Input: "abcdefghijabcdefghijabcdefghijabcdefghijabcdefghij",
+			Valid: true,
+		},
+		{
+			Input: "abcdefghijabcdefghijabcdefghijabcdefghijabcdefghijk",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := DataPlaneName(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
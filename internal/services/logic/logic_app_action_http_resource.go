@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
@@ -75,7 +76,7 @@ func resourceLogicAppActionHTTP() *pluginsdk.Resource {
 				Optional: true,
 				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 					if json.Valid([]byte(oldValue)) && json.Valid([]byte(newValue)) {
-						return pluginsdk.SuppressJsonDiff(k, oldValue, newValue, d)
+						return suppress.JsonDiff(k, oldValue, newValue, d)
 					}
 					return false
 				},
@@ -6,15 +6,23 @@ package client
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mongocluster/2024-07-01/firewallrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mongocluster/2024-07-01/mongoclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
+	FirewallRulesClient *firewallrules.FirewallRulesClient
 	MongoClustersClient *mongoclusters.MongoClustersClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	firewallRulesClient, err := firewallrules.NewFirewallRulesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building FirewallRules client: %+v", err)
+	}
+	o.Configure(firewallRulesClient.Client, o.Authorizers.ResourceManager)
+
 	mongoClustersClient, err := mongoclusters.NewMongoClustersClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building MongoClusters client: %+v", err)
@@ -22,6 +30,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(mongoClustersClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
+		FirewallRulesClient: firewallRulesClient,
 		MongoClustersClient: mongoClustersClient,
 	}, nil
 }
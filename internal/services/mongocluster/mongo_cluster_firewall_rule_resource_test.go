@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mongocluster_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mongocluster/2024-07-01/firewallrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MongoClusterFirewallRuleResource struct{}
+
+func TestAccMongoClusterFirewallRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mongo_cluster_firewall_rule", "test")
+	r := MongoClusterFirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "122.122.0.0", "122.122.0.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMongoClusterFirewallRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mongo_cluster_firewall_rule", "test")
+	r := MongoClusterFirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "122.122.0.0", "122.122.0.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMongoClusterFirewallRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mongo_cluster_firewall_rule", "test")
+	r := MongoClusterFirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "122.122.0.0", "122.122.0.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "123.0.0.0", "123.0.0.255"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MongoClusterFirewallRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := firewallrules.ParseFirewallRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MongoCluster.FirewallRulesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MongoClusterFirewallRuleResource) basic(data acceptance.TestData, startIPAddress, endIPAddress string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mongo_cluster_firewall_rule" "test" {
+  name             = "acctest-mcfr%d"
+  mongo_cluster_id = azurerm_mongo_cluster.test.id
+  start_ip_address = "%s"
+  end_ip_address   = "%s"
+}
+`, MongoClusterResource{}.source(data), data.RandomInteger, startIPAddress, endIPAddress)
+}
+
+func (r MongoClusterFirewallRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mongo_cluster_firewall_rule" "import" {
+  name             = azurerm_mongo_cluster_firewall_rule.test.name
+  mongo_cluster_id = azurerm_mongo_cluster_firewall_rule.test.mongo_cluster_id
+  start_ip_address = azurerm_mongo_cluster_firewall_rule.test.start_ip_address
+  end_ip_address   = azurerm_mongo_cluster_firewall_rule.test.end_ip_address
+}
+`, r.basic(data, "122.122.0.0", "122.122.0.0"))
+}
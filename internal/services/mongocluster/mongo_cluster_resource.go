@@ -40,6 +40,7 @@ type MongoClusterResourceModel struct {
 	HighAvailabilityMode  string                         `tfschema:"high_availability_mode"`
 	PublicNetworkAccess   string                         `tfschema:"public_network_access"`
 	PreviewFeatures       []string                       `tfschema:"preview_features"`
+	ReplicationRole       string                         `tfschema:"replication_role"`
 	StorageSizeInGb       int64                          `tfschema:"storage_size_in_gb"`
 	ConnectionStrings     []MongoClusterConnectionString `tfschema:"connection_strings"`
 	Tags                  map[string]string              `tfschema:"tags"`
@@ -176,6 +177,14 @@ func (r MongoClusterResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: validation.StringInSlice(mongoclusters.PossibleValuesForPublicNetworkAccess(), false),
 		},
 
+		"replication_role": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(mongoclusters.ReplicationRolePrimary),
+			}, false),
+		},
+
 		"storage_size_in_gb": {
 			Type:         pluginsdk.TypeInt,
 			Optional:     true,
@@ -336,6 +345,25 @@ func (r MongoClusterResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			// the replica has to be promoted before any other changes are applied, since these may only be supported on a primary cluster.
+			if metadata.ResourceData.HasChange("replication_role") {
+				if state.CreateMode != string(mongoclusters.CreateModeGeoReplica) || state.ReplicationRole != string(mongoclusters.ReplicationRolePrimary) {
+					return fmt.Errorf("`replication_role` can only be updated to `%s` for a cluster with `create_mode` set to `%s`", string(mongoclusters.ReplicationRolePrimary), string(mongoclusters.CreateModeGeoReplica))
+				}
+
+				metadata.Logger.Infof("promoting %s", *id)
+				parameter := mongoclusters.PromoteReplicaRequest{
+					PromoteOption: mongoclusters.PromoteOptionForced,
+				}
+				if err := client.PromoteThenPoll(ctx, *id, parameter); err != nil {
+					return fmt.Errorf("promoting %s: %+v", *id, err)
+				}
+
+				if !metadata.ResourceData.HasChangesExcept("replication_role") {
+					return nil
+				}
+			}
+
 			existing, err := client.Get(ctx, *id)
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -448,10 +476,16 @@ func (r MongoClusterResource) Read() sdk.ResourceFunc {
 						state.AdministratorUserName = pointer.From(v.UserName)
 					}
 
+					// the API returns the role of the cluster after promotion as `Primary`, the value is only set when promoting a replica so it's taken from the config
+					state.ReplicationRole = metadata.ResourceData.Get("replication_role").(string)
+
 					if v := props.Replica; v != nil {
 						// API doesn't return the value of source_location, https://github.com/Azure/azure-rest-api-specs/issues/31266 has been filed to track it.
 						state.SourceLocation = metadata.ResourceData.Get("source_location").(string)
-						if v.SourceResourceId != nil {
+						// once promoted the cluster is no longer replicating from the source cluster, so the value is taken from the config to avoid recreating the cluster
+						if state.ReplicationRole == string(mongoclusters.ReplicationRolePrimary) {
+							state.SourceServerId = metadata.ResourceData.Get("source_server_id").(string)
+						} else if v.SourceResourceId != nil {
 							id, err := mongoclusters.ParseMongoClusterID(pointer.From(v.SourceResourceId))
 							if err != nil {
 								return err
@@ -526,6 +560,10 @@ func (r MongoClusterResource) CustomizeDiff() sdk.ResourceFunc {
 				return fmt.Errorf("DecodeDiff: %+v", err)
 			}
 
+			if metadata.ResourceDiff.Id() == "" && state.ReplicationRole != "" {
+				return fmt.Errorf("`replication_role` cannot be set while creating")
+			}
+
 			switch state.CreateMode {
 			case string(mongoclusters.CreateModeDefault):
 				if state.AdministratorUserName == "" {
@@ -119,6 +119,29 @@ func TestAccMongoCluster_geoReplica(t *testing.T) {
 	})
 }
 
+func TestAccMongoCluster_promoteReplica(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mongo_cluster", "geo_replica")
+	r := MongoClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.geoReplica(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode", "source_location", "connection_strings.0.value", "connection_strings.1.value"),
+		{
+			Config: r.geoReplicaPromoted(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replication_role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode", "replication_role", "source_location", "source_server_id", "connection_strings.0.value", "connection_strings.1.value"),
+	})
+}
+
 func (r MongoClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := mongoclusters.ParseMongoClusterID(state.ID)
 	if err != nil {
@@ -253,6 +276,26 @@ resource "azurerm_mongo_cluster" "geo_replica" {
 `, r.source(data), data.RandomInteger, data.Locations.Secondary)
 }
 
+func (r MongoClusterResource) geoReplicaPromoted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mongo_cluster" "geo_replica" {
+  name                = "acctest-mc-replica%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "%s"
+  source_server_id    = azurerm_mongo_cluster.test.id
+  source_location     = azurerm_mongo_cluster.test.location
+  create_mode         = "GeoReplica"
+  replication_role    = "Primary"
+
+  lifecycle {
+    ignore_changes = ["administrator_username", "high_availability_mode", "preview_features", "shard_count", "storage_size_in_gb", "compute_tier", "version"]
+  }
+}
+`, r.source(data), data.RandomInteger, data.Locations.Secondary)
+}
+
 func (r MongoClusterResource) template(data acceptance.TestData, location string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
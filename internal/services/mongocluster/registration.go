@@ -34,5 +34,6 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		MongoClusterResource{},
+		MongoClusterFirewallRuleResource{},
 	}
 }
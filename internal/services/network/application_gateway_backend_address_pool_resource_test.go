@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApplicationGatewayBackendAddressPoolResource struct{}
+
+func TestAccApplicationGatewayBackendAddressPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool", "test")
+	r := ApplicationGatewayBackendAddressPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// confirm that the Application Gateway doesn't take ownership of the Backend Address Pool
+			Config:   r.basic(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccApplicationGatewayBackendAddressPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool", "test")
+	r := ApplicationGatewayBackendAddressPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayBackendAddressPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool", "test")
+	r := ApplicationGatewayBackendAddressPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackendAddressPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	resp, err := clients.Network.ApplicationGateways.Get(ctx, applicationGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.BackendAddressPools != nil {
+		for _, v := range *model.Properties.BackendAddressPools {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "test" {
+  name                   = "acctest-beap-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+}
+`, ApplicationGatewayResource{}.standaloneSubResources(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "import" {
+  name                   = azurerm_application_gateway_backend_address_pool.test.name
+  application_gateway_id = azurerm_application_gateway_backend_address_pool.test.application_gateway_id
+}
+`, r.basic(data))
+}
+
+func (r ApplicationGatewayBackendAddressPoolResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool" "test" {
+  name                   = "acctest-beap-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  fqdns                  = ["backend.example.com"]
+  ip_addresses           = ["10.0.1.4", "10.0.1.5"]
+}
+`, ApplicationGatewayResource{}.standaloneSubResources(data), data.RandomInteger)
+}
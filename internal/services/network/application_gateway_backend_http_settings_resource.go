@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceApplicationGatewayBackendHTTPSettings() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendHTTPSettingsCreate,
+		Read:   resourceApplicationGatewayBackendHTTPSettingsRead,
+		Update: resourceApplicationGatewayBackendHTTPSettingsUpdate,
+		Delete: resourceApplicationGatewayBackendHTTPSettingsDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendHttpSettingsCollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewaySubResourceSchema(applicationGatewayBackendHTTPSettingsSchema()),
+	}
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationGatewayId, err := applicationgateways.ParseApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendHttpSettingsCollectionID(applicationGatewayId.SubscriptionId, applicationGatewayId.ResourceGroupName, applicationGatewayId.ApplicationGatewayName, d.Get("name").(string))
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, *applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	backendHTTPSettingsCollection := make([]applicationgateways.ApplicationGatewayBackendHTTPSettings, 0)
+	if existing := applicationGateway.Properties.BackendHTTPSettingsCollection; existing != nil {
+		for _, v := range *existing {
+			if strings.EqualFold(pointer.From(v.Name), id.BackendHttpSettingsCollectionName) {
+				return tf.ImportAsExistsError("azurerm_application_gateway_backend_http_settings", id.ID())
+			}
+
+			backendHTTPSettingsCollection = append(backendHTTPSettingsCollection, v)
+		}
+	}
+
+	backendHTTPSettings := expandApplicationGatewayBackendHTTPSetting(expandApplicationGatewaySubResource(d, applicationGatewayBackendHTTPSettingsSchema()), applicationGatewayId.ID())
+
+	backendHTTPSettingsCollection = append(backendHTTPSettingsCollection, backendHTTPSettings)
+	applicationGateway.Properties.BackendHTTPSettingsCollection = &backendHTTPSettingsCollection
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayBackendHTTPSettingsRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	resp, err := client.Get(ctx, applicationGatewayId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", applicationGatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	var backendHTTPSettings *applicationgateways.ApplicationGatewayBackendHTTPSettings
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.BackendHTTPSettingsCollection != nil {
+		for _, v := range *model.Properties.BackendHTTPSettingsCollection {
+			if strings.EqualFold(pointer.From(v.Name), id.BackendHttpSettingsCollectionName) {
+				backendHTTPSettings = pointer.To(v)
+				break
+			}
+		}
+	}
+
+	if backendHTTPSettings == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	flattened, err := flattenApplicationGatewayBackendHTTPSettings(&[]applicationgateways.ApplicationGatewayBackendHTTPSettings{*backendHTTPSettings})
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", id, err)
+	}
+
+	if len(flattened) > 0 {
+		if err := flattenApplicationGatewaySubResource(d, flattened[0].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	d.Set("name", id.BackendHttpSettingsCollectionName)
+	d.Set("application_gateway_id", applicationGatewayId.ID())
+
+	return nil
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	backendHTTPSettings := expandApplicationGatewayBackendHTTPSetting(expandApplicationGatewaySubResource(d, applicationGatewayBackendHTTPSettingsSchema()), applicationGatewayId.ID())
+
+	found := false
+	backendHTTPSettingsCollection := make([]applicationgateways.ApplicationGatewayBackendHTTPSettings, 0)
+	if existing := applicationGateway.Properties.BackendHTTPSettingsCollection; existing != nil {
+		for _, v := range *existing {
+			if strings.EqualFold(pointer.From(v.Name), id.BackendHttpSettingsCollectionName) {
+				v = backendHTTPSettings
+				found = true
+			}
+
+			backendHTTPSettingsCollection = append(backendHTTPSettingsCollection, v)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%s was not found", id)
+	}
+
+	applicationGateway.Properties.BackendHTTPSettingsCollection = &backendHTTPSettingsCollection
+
+	if err := client.CreateOrUpdateThenPoll(ctx, applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return resourceApplicationGatewayBackendHTTPSettingsRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendHTTPSettingsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendHttpSettingsCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	backendHTTPSettingsCollection := make([]applicationgateways.ApplicationGatewayBackendHTTPSettings, 0)
+	if existing := applicationGateway.Properties.BackendHTTPSettingsCollection; existing != nil {
+		for _, v := range *existing {
+			if !strings.EqualFold(pointer.From(v.Name), id.BackendHttpSettingsCollectionName) {
+				backendHTTPSettingsCollection = append(backendHTTPSettingsCollection, v)
+			}
+		}
+	}
+	applicationGateway.Properties.BackendHTTPSettingsCollection = &backendHTTPSettingsCollection
+
+	if err := client.CreateOrUpdateThenPoll(ctx, applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
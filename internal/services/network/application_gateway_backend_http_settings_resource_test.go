@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApplicationGatewayBackendHTTPSettingsResource struct{}
+
+func TestAccApplicationGatewayBackendHTTPSettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// confirm that the Application Gateway doesn't take ownership of the Backend HTTP Settings
+			Config:   r.basic(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayBackendHTTPSettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_http_settings", "test")
+	r := ApplicationGatewayBackendHTTPSettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackendHttpSettingsCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	resp, err := clients.Network.ApplicationGateways.Get(ctx, applicationGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.BackendHTTPSettingsCollection != nil {
+		for _, v := range *model.Properties.BackendHTTPSettingsCollection {
+			if strings.EqualFold(pointer.From(v.Name), id.BackendHttpSettingsCollectionName) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "test" {
+  name                   = "acctest-be-htst-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  cookie_based_affinity  = "Disabled"
+  port                   = 8080
+  protocol               = "Http"
+}
+`, ApplicationGatewayResource{}.standaloneSubResources(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "import" {
+  name                   = azurerm_application_gateway_backend_http_settings.test.name
+  application_gateway_id = azurerm_application_gateway_backend_http_settings.test.application_gateway_id
+  cookie_based_affinity  = azurerm_application_gateway_backend_http_settings.test.cookie_based_affinity
+  port                   = azurerm_application_gateway_backend_http_settings.test.port
+  protocol               = azurerm_application_gateway_backend_http_settings.test.protocol
+}
+`, r.basic(data))
+}
+
+func (r ApplicationGatewayBackendHTTPSettingsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_http_settings" "test" {
+  name                   = "acctest-be-htst-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  cookie_based_affinity  = "Enabled"
+  affinity_cookie_name   = "acctest"
+  path                   = "/api/"
+  port                   = 8080
+  protocol               = "Http"
+  request_timeout        = 60
+  host_name              = "backend.example.com"
+
+  connection_draining {
+    enabled           = true
+    drain_timeout_sec = 120
+  }
+}
+`, ApplicationGatewayResource{}.standaloneSubResources(data), data.RandomInteger)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceApplicationGatewayHTTPListener() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayHTTPListenerCreate,
+		Read:   resourceApplicationGatewayHTTPListenerRead,
+		Update: resourceApplicationGatewayHTTPListenerUpdate,
+		Delete: resourceApplicationGatewayHTTPListenerDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.HttpListenerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewaySubResourceSchema(applicationGatewayHTTPListenerSchema()),
+	}
+}
+
+func resourceApplicationGatewayHTTPListenerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationGatewayId, err := applicationgateways.ParseApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewHttpListenerID(applicationGatewayId.SubscriptionId, applicationGatewayId.ResourceGroupName, applicationGatewayId.ApplicationGatewayName, d.Get("name").(string))
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, *applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	httpListeners := make([]applicationgateways.ApplicationGatewayHTTPListener, 0)
+	if existing := applicationGateway.Properties.HTTPListeners; existing != nil {
+		for _, v := range *existing {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				return tf.ImportAsExistsError("azurerm_application_gateway_http_listener", id.ID())
+			}
+
+			httpListeners = append(httpListeners, v)
+		}
+	}
+
+	httpListener, err := expandApplicationGatewayHTTPListener(expandApplicationGatewaySubResource(d, applicationGatewayHTTPListenerSchema()), applicationGatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	httpListeners = append(httpListeners, *httpListener)
+	applicationGateway.Properties.HTTPListeners = &httpListeners
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayHTTPListenerRead(d, meta)
+}
+
+func resourceApplicationGatewayHTTPListenerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	resp, err := client.Get(ctx, applicationGatewayId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", applicationGatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	var httpListener *applicationgateways.ApplicationGatewayHTTPListener
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.HTTPListeners != nil {
+		for _, v := range *model.Properties.HTTPListeners {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				httpListener = pointer.To(v)
+				break
+			}
+		}
+	}
+
+	if httpListener == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	flattened, err := flattenApplicationGatewayHTTPListeners(&[]applicationgateways.ApplicationGatewayHTTPListener{*httpListener})
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", id, err)
+	}
+
+	if len(flattened) > 0 {
+		if err := flattenApplicationGatewaySubResource(d, flattened[0].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", applicationGatewayId.ID())
+
+	return nil
+}
+
+func resourceApplicationGatewayHTTPListenerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	httpListener, err := expandApplicationGatewayHTTPListener(expandApplicationGatewaySubResource(d, applicationGatewayHTTPListenerSchema()), applicationGatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	found := false
+	httpListeners := make([]applicationgateways.ApplicationGatewayHTTPListener, 0)
+	if existing := applicationGateway.Properties.HTTPListeners; existing != nil {
+		for _, v := range *existing {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				v = *httpListener
+				found = true
+			}
+
+			httpListeners = append(httpListeners, v)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%s was not found", id)
+	}
+
+	applicationGateway.Properties.HTTPListeners = &httpListeners
+
+	if err := client.CreateOrUpdateThenPoll(ctx, applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return resourceApplicationGatewayHTTPListenerRead(d, meta)
+}
+
+func resourceApplicationGatewayHTTPListenerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HttpListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	httpListeners := make([]applicationgateways.ApplicationGatewayHTTPListener, 0)
+	if existing := applicationGateway.Properties.HTTPListeners; existing != nil {
+		for _, v := range *existing {
+			if !strings.EqualFold(pointer.From(v.Name), id.Name) {
+				httpListeners = append(httpListeners, v)
+			}
+		}
+	}
+	applicationGateway.Properties.HTTPListeners = &httpListeners
+
+	if err := client.CreateOrUpdateThenPoll(ctx, applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
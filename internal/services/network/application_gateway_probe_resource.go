@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceApplicationGatewayProbe() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayProbeCreate,
+		Read:   resourceApplicationGatewayProbeRead,
+		Update: resourceApplicationGatewayProbeUpdate,
+		Delete: resourceApplicationGatewayProbeDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ProbeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewaySubResourceSchema(applicationGatewayProbeSchema()),
+	}
+}
+
+func resourceApplicationGatewayProbeCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationGatewayId, err := applicationgateways.ParseApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewProbeID(applicationGatewayId.SubscriptionId, applicationGatewayId.ResourceGroupName, applicationGatewayId.ApplicationGatewayName, d.Get("name").(string))
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, *applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	probes := make([]applicationgateways.ApplicationGatewayProbe, 0)
+	if existing := applicationGateway.Properties.Probes; existing != nil {
+		for _, v := range *existing {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				return tf.ImportAsExistsError("azurerm_application_gateway_probe", id.ID())
+			}
+
+			probes = append(probes, v)
+		}
+	}
+
+	probe := expandApplicationGatewayProbe(expandApplicationGatewaySubResource(d, applicationGatewayProbeSchema()))
+
+	probes = append(probes, probe)
+	applicationGateway.Properties.Probes = &probes
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayProbeRead(d, meta)
+}
+
+func resourceApplicationGatewayProbeRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	resp, err := client.Get(ctx, applicationGatewayId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", applicationGatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	var probe *applicationgateways.ApplicationGatewayProbe
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Probes != nil {
+		for _, v := range *model.Properties.Probes {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				probe = pointer.To(v)
+				break
+			}
+		}
+	}
+
+	if probe == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	flattened := flattenApplicationGatewayProbes(&[]applicationgateways.ApplicationGatewayProbe{*probe})
+
+	if len(flattened) > 0 {
+		if err := flattenApplicationGatewaySubResource(d, flattened[0].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", applicationGatewayId.ID())
+
+	return nil
+}
+
+func resourceApplicationGatewayProbeUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	probe := expandApplicationGatewayProbe(expandApplicationGatewaySubResource(d, applicationGatewayProbeSchema()))
+
+	found := false
+	probes := make([]applicationgateways.ApplicationGatewayProbe, 0)
+	if existing := applicationGateway.Properties.Probes; existing != nil {
+		for _, v := range *existing {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				v = probe
+				found = true
+			}
+
+			probes = append(probes, v)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%s was not found", id)
+	}
+
+	applicationGateway.Properties.Probes = &probes
+
+	if err := client.CreateOrUpdateThenPoll(ctx, applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return resourceApplicationGatewayProbeRead(d, meta)
+}
+
+func resourceApplicationGatewayProbeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	probes := make([]applicationgateways.ApplicationGatewayProbe, 0)
+	if existing := applicationGateway.Properties.Probes; existing != nil {
+		for _, v := range *existing {
+			if !strings.EqualFold(pointer.From(v.Name), id.Name) {
+				probes = append(probes, v)
+			}
+		}
+	}
+	applicationGateway.Properties.Probes = &probes
+
+	if err := client.CreateOrUpdateThenPoll(ctx, applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApplicationGatewayProbeResource struct{}
+
+func TestAccApplicationGatewayProbe_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_probe", "test")
+	r := ApplicationGatewayProbeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// confirm that the Application Gateway doesn't take ownership of the Probe
+			Config:   r.basic(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccApplicationGatewayProbe_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_probe", "test")
+	r := ApplicationGatewayProbeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayProbe_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_probe", "test")
+	r := ApplicationGatewayProbeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationGatewayProbeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ProbeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	resp, err := clients.Network.ApplicationGateways.Get(ctx, applicationGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Probes != nil {
+		for _, v := range *model.Properties.Probes {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r ApplicationGatewayProbeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "test" {
+  name                   = "acctest-probe-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  protocol               = "Http"
+  path                   = "/health"
+  host                   = "acctest.example.com"
+  interval               = 30
+  timeout                = 30
+  unhealthy_threshold    = 3
+}
+`, ApplicationGatewayResource{}.standaloneSubResources(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayProbeResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "import" {
+  name                   = azurerm_application_gateway_probe.test.name
+  application_gateway_id = azurerm_application_gateway_probe.test.application_gateway_id
+  protocol               = azurerm_application_gateway_probe.test.protocol
+  path                   = azurerm_application_gateway_probe.test.path
+  host                   = azurerm_application_gateway_probe.test.host
+  interval               = azurerm_application_gateway_probe.test.interval
+  timeout                = azurerm_application_gateway_probe.test.timeout
+  unhealthy_threshold    = azurerm_application_gateway_probe.test.unhealthy_threshold
+}
+`, r.basic(data))
+}
+
+func (r ApplicationGatewayProbeResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "test" {
+  name                                      = "acctest-probe-%d"
+  application_gateway_id                    = azurerm_application_gateway.test.id
+  protocol                                  = "Http"
+  path                                      = "/status"
+  pick_host_name_from_backend_http_settings = true
+  interval                                  = 15
+  timeout                                   = 10
+  unhealthy_threshold                       = 5
+  port                                      = 8080
+  minimum_servers                           = 1
+
+  match {
+    body        = "healthy"
+    status_code = ["200-399"]
+  }
+}
+`, ApplicationGatewayResource{}.standaloneSubResources(data), data.RandomInteger)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceApplicationGatewayRequestRoutingRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayRequestRoutingRuleCreate,
+		Read:   resourceApplicationGatewayRequestRoutingRuleRead,
+		Update: resourceApplicationGatewayRequestRoutingRuleUpdate,
+		Delete: resourceApplicationGatewayRequestRoutingRuleDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RequestRoutingRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewaySubResourceSchema(applicationGatewayRequestRoutingRuleSchema()),
+	}
+}
+
+func resourceApplicationGatewayRequestRoutingRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	applicationGatewayId, err := applicationgateways.ParseApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewRequestRoutingRuleID(applicationGatewayId.SubscriptionId, applicationGatewayId.ResourceGroupName, applicationGatewayId.ApplicationGatewayName, d.Get("name").(string))
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, *applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	requestRoutingRules := make([]applicationgateways.ApplicationGatewayRequestRoutingRule, 0)
+	if existing := applicationGateway.Properties.RequestRoutingRules; existing != nil {
+		for _, v := range *existing {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				return tf.ImportAsExistsError("azurerm_application_gateway_request_routing_rule", id.ID())
+			}
+
+			requestRoutingRules = append(requestRoutingRules, v)
+		}
+	}
+
+	requestRoutingRule, err := expandApplicationGatewayRequestRoutingRule(expandApplicationGatewaySubResource(d, applicationGatewayRequestRoutingRuleSchema()), applicationGatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	requestRoutingRules = append(requestRoutingRules, *requestRoutingRule)
+	applicationGateway.Properties.RequestRoutingRules = &requestRoutingRules
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayRequestRoutingRuleRead(d, meta)
+}
+
+func resourceApplicationGatewayRequestRoutingRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	resp, err := client.Get(ctx, applicationGatewayId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", applicationGatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	var requestRoutingRule *applicationgateways.ApplicationGatewayRequestRoutingRule
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.RequestRoutingRules != nil {
+		for _, v := range *model.Properties.RequestRoutingRules {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				requestRoutingRule = pointer.To(v)
+				break
+			}
+		}
+	}
+
+	if requestRoutingRule == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	flattened, err := flattenApplicationGatewayRequestRoutingRules(&[]applicationgateways.ApplicationGatewayRequestRoutingRule{*requestRoutingRule})
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", id, err)
+	}
+
+	if len(flattened) > 0 {
+		if err := flattenApplicationGatewaySubResource(d, flattened[0].(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", applicationGatewayId.ID())
+
+	return nil
+}
+
+func resourceApplicationGatewayRequestRoutingRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	requestRoutingRule, err := expandApplicationGatewayRequestRoutingRule(expandApplicationGatewaySubResource(d, applicationGatewayRequestRoutingRuleSchema()), applicationGatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	found := false
+	requestRoutingRules := make([]applicationgateways.ApplicationGatewayRequestRoutingRule, 0)
+	if existing := applicationGateway.Properties.RequestRoutingRules; existing != nil {
+		for _, v := range *existing {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				v = *requestRoutingRule
+				found = true
+			}
+
+			requestRoutingRules = append(requestRoutingRules, v)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("%s was not found", id)
+	}
+
+	applicationGateway.Properties.RequestRoutingRules = &requestRoutingRules
+
+	if err := client.CreateOrUpdateThenPoll(ctx, applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return resourceApplicationGatewayRequestRoutingRuleRead(d, meta)
+}
+
+func resourceApplicationGatewayRequestRoutingRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGateways
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
+	applicationGateway, err := retrieveApplicationGatewayForSubResource(ctx, client, applicationGatewayId)
+	if err != nil {
+		return err
+	}
+
+	requestRoutingRules := make([]applicationgateways.ApplicationGatewayRequestRoutingRule, 0)
+	if existing := applicationGateway.Properties.RequestRoutingRules; existing != nil {
+		for _, v := range *existing {
+			if !strings.EqualFold(pointer.From(v.Name), id.Name) {
+				requestRoutingRules = append(requestRoutingRules, v)
+			}
+		}
+	}
+	applicationGateway.Properties.RequestRoutingRules = &requestRoutingRules
+
+	if err := client.CreateOrUpdateThenPoll(ctx, applicationGatewayId, *applicationGateway); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -34,6 +35,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var applicationGatewayResourceName = "azurerm_application_gateway"
+
 func base64EncodedStateFunc(v interface{}) string {
 	switch s := v.(type) {
 	case string:
@@ -110,10 +113,10 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 		Read:   resourceApplicationGatewayRead,
 		Update: resourceApplicationGatewayUpdate,
 		Delete: resourceApplicationGatewayDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := applicationgateways.ParseApplicationGatewayID(id)
 			return err
-		}),
+		}, importApplicationGateway),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
@@ -142,35 +145,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"fqdns": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.NoZeroValues,
-							},
-						},
-
-						"ip_addresses": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validate.IPv4Address,
-							},
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayBackendAddressPoolSchema(),
 				},
 				Set: applicationGatewayBackendAddressPool,
 			},
@@ -181,127 +156,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Required: true,
 				MinItems: 1,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"path": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"port": {
-							Type:         pluginsdk.TypeInt,
-							Required:     true,
-							ValidateFunc: validate.PortNumber,
-						},
-
-						"protocol": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(applicationgateways.ApplicationGatewayProtocolHTTP),
-								string(applicationgateways.ApplicationGatewayProtocolHTTPS),
-							}, false),
-						},
-
-						"cookie_based_affinity": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(applicationgateways.ApplicationGatewayCookieBasedAffinityEnabled),
-								string(applicationgateways.ApplicationGatewayCookieBasedAffinityDisabled),
-							}, false),
-						},
-
-						"affinity_cookie_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"host_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"pick_host_name_from_backend_address": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-
-						"request_timeout": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							Default:      30,
-							ValidateFunc: validation.IntBetween(1, 86400),
-						},
-
-						"authentication_certificate": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-									},
-
-									"id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-
-						"trusted_root_certificate_names": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringIsNotEmpty,
-							},
-						},
-
-						"connection_draining": {
-							Type:     pluginsdk.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"enabled": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-
-									"drain_timeout_sec": {
-										Type:         pluginsdk.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IntBetween(1, 3600),
-									},
-								},
-							},
-						},
-
-						"probe_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"probe_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayBackendHTTPSettingsSchema(),
 				},
 				Set: applicationGatewayBackendSettingsHash,
 			},
@@ -433,114 +288,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_ip_configuration_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_port_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"protocol": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(applicationgateways.ApplicationGatewayProtocolHTTP),
-								string(applicationgateways.ApplicationGatewayProtocolHTTPS),
-							}, false),
-						},
-
-						"host_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"host_names": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringIsNotEmpty,
-							},
-						},
-
-						"ssl_certificate_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"require_sni": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-						},
-
-						"frontend_ip_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"frontend_port_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"ssl_certificate_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"ssl_profile_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"custom_error_configuration": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"status_code": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice(applicationgateways.PossibleValuesForApplicationGatewayCustomErrorStatusCode(), false),
-									},
-
-									"custom_error_page_url": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-									},
-
-									"id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-
-						"firewall_policy_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: webapplicationfirewallpolicies.ValidateApplicationGatewayWebApplicationFirewallPolicyID,
-						},
-
-						"ssl_profile_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-					},
+					Schema: applicationGatewayHTTPListenerSchema(),
 				},
 				Set: applicationGatewayHttpListnerHash,
 			},
@@ -630,94 +378,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Required: true,
 				MinItems: 1,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"rule_type": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(applicationgateways.ApplicationGatewayRequestRoutingRuleTypeBasic),
-								string(applicationgateways.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
-							}, false),
-						},
-
-						"http_listener_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"backend_address_pool_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"backend_http_settings_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"url_path_map_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"redirect_configuration_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"rewrite_rule_set_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"priority": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(1, 20000),
-						},
-
-						"backend_address_pool_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"backend_http_settings_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"http_listener_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"url_path_map_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"redirect_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"rewrite_rule_set_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayRequestRoutingRuleSchema(),
 				},
 			},
 
@@ -881,121 +542,13 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
-							Sensitive:    true,
-						},
-
-						"key_vault_secret_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
-				},
-			},
-
-			// lintignore:XS003
-			"ssl_policy": sslProfileSchema(true),
-
-			// TODO 4.0: change this from enable_* to *_enabled
-			"enable_http2": {
-				Type:     pluginsdk.TypeBool,
-				Optional: true,
-			},
-
-			"force_firewall_policy_association": {
-				Type:     pluginsdk.TypeBool,
-				Optional: true,
-			},
-
-			// lintignore:S016,S023
-			"probe": {
-				Type:     pluginsdk.TypeSet,
-				Optional: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"protocol": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(applicationgateways.ApplicationGatewayProtocolHTTP),
-								string(applicationgateways.ApplicationGatewayProtocolHTTPS),
-							}, false),
-						},
-
-						"path": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"host": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"interval": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"timeout": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"unhealthy_threshold": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"port": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validate.PortNumber,
-						},
-
-						"pick_host_name_from_backend_http_settings": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-
-						"minimum_servers": {
-							Type:     pluginsdk.TypeInt,
-							Optional: true,
-							Default:  0,
+							Sensitive:    true,
 						},
 
-						// lintignore:XS003
-						"match": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"body": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-									},
-
-									"status_code": {
-										Type:     pluginsdk.TypeList,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-										},
-									},
-								},
-							},
+						"key_vault_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 						},
 
 						"id": {
@@ -1004,6 +557,35 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 						},
 					},
 				},
+			},
+
+			// lintignore:XS003
+			"ssl_policy": sslProfileSchema(true),
+
+			// TODO 4.0: change this from enable_* to *_enabled
+			"enable_http2": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"force_firewall_policy_association": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"standalone_sub_resources_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// lintignore:S016,S023
+			"probe": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: applicationGatewayProbeSchema(),
+				},
 				Set: applicationGatewayProbeHash,
 			},
 
@@ -1145,42 +727,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"data": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							Sensitive:    true,
-							StateFunc:    base64EncodedStateFunc,
-							ValidateFunc: validation.StringIsBase64,
-						},
-
-						"password": {
-							Type:      pluginsdk.TypeString,
-							Optional:  true,
-							Sensitive: true,
-						},
-
-						"key_vault_secret_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"public_cert_data": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewaySslCertificateSchema(),
 				},
 				Set: applicationGatewaySSLCertificate,
 			},
@@ -1504,40 +1051,526 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				},
 			},
 
-			"firewall_policy_id": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: webapplicationfirewallpolicies.ValidateApplicationGatewayWebApplicationFirewallPolicyID,
-			},
+			"firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: webapplicationfirewallpolicies.ValidateApplicationGatewayWebApplicationFirewallPolicyID,
+			},
+
+			"custom_error_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"status_code": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(applicationgateways.PossibleValuesForApplicationGatewayCustomErrorStatusCode(), false),
+						},
+
+						"custom_error_page_url": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": commonschema.Tags(),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(applicationGatewayCustomizeDiff),
+	}
+}
+
+func applicationGatewayBackendAddressPoolSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"fqdns": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+
+		"ip_addresses": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.IPv4Address,
+			},
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayBackendHTTPSettingsSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validate.PortNumber,
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(applicationgateways.ApplicationGatewayProtocolHTTP),
+				string(applicationgateways.ApplicationGatewayProtocolHTTPS),
+			}, false),
+		},
+
+		"cookie_based_affinity": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(applicationgateways.ApplicationGatewayCookieBasedAffinityEnabled),
+				string(applicationgateways.ApplicationGatewayCookieBasedAffinityDisabled),
+			}, false),
+		},
+
+		"affinity_cookie_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"host_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"pick_host_name_from_backend_address": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"request_timeout": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      30,
+			ValidateFunc: validation.IntBetween(1, 86400),
+		},
+
+		"authentication_certificate": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"trusted_root_certificate_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"connection_draining": {
+			Type:     pluginsdk.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"drain_timeout_sec": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 3600),
+					},
+				},
+			},
+		},
+
+		"probe_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"probe_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayHTTPListenerSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_ip_configuration_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_port_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(applicationgateways.ApplicationGatewayProtocolHTTP),
+				string(applicationgateways.ApplicationGatewayProtocolHTTPS),
+			}, false),
+		},
+
+		"host_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"host_names": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"ssl_certificate_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"require_sni": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"frontend_ip_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"frontend_port_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ssl_certificate_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ssl_profile_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"custom_error_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"status_code": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(applicationgateways.PossibleValuesForApplicationGatewayCustomErrorStatusCode(), false),
+					},
+
+					"custom_error_page_url": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: webapplicationfirewallpolicies.ValidateApplicationGatewayWebApplicationFirewallPolicyID,
+		},
+
+		"ssl_profile_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func applicationGatewayRequestRoutingRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"rule_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(applicationgateways.ApplicationGatewayRequestRoutingRuleTypeBasic),
+				string(applicationgateways.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
+			}, false),
+		},
+
+		"http_listener_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"backend_address_pool_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"backend_http_settings_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"url_path_map_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"redirect_configuration_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"rewrite_rule_set_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 20000),
+		},
+
+		"backend_address_pool_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"backend_http_settings_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"http_listener_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"url_path_map_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"redirect_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"rewrite_rule_set_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayProbeSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(applicationgateways.ApplicationGatewayProtocolHTTP),
+				string(applicationgateways.ApplicationGatewayProtocolHTTPS),
+			}, false),
+		},
+
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"host": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
 
-			"custom_error_configuration": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"status_code": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(applicationgateways.PossibleValuesForApplicationGatewayCustomErrorStatusCode(), false),
-						},
+		"interval": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
 
-						"custom_error_page_url": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
+		"timeout": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
 
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
+		"unhealthy_threshold": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.PortNumber,
+		},
+
+		"pick_host_name_from_backend_http_settings": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"minimum_servers": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  0,
+		},
+
+		// lintignore:XS003
+		"match": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"body": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"status_code": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
 						},
 					},
 				},
 			},
+		},
 
-			"tags": commonschema.Tags(),
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewaySslCertificateSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
 		},
 
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(applicationGatewayCustomizeDiff),
+		"data": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			StateFunc:    base64EncodedStateFunc,
+			ValidateFunc: validation.StringIsBase64,
+		},
+
+		"password": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+
+		"key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"public_cert_data": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -1728,6 +1761,9 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
 	existing, err := client.Get(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -1764,6 +1800,13 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		if err != nil {
 			return fmt.Errorf("expanding `request_routing_rule`: %+v", err)
 		}
+		if existing := payload.Properties.RequestRoutingRules; existing != nil {
+			for _, v := range *existing {
+				if !applicationGatewayItemIsManaged(d, "request_routing_rule", v.Name) {
+					*requestRoutingRules = append(*requestRoutingRules, v)
+				}
+			}
+		}
 		payload.Properties.RequestRoutingRules = requestRoutingRules
 	}
 
@@ -1790,6 +1833,13 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		if err != nil {
 			return fmt.Errorf("expanding `ssl_certificate`: %+v", err)
 		}
+		if existing := payload.Properties.SslCertificates; existing != nil {
+			for _, v := range *existing {
+				if !applicationGatewayItemIsManaged(d, "ssl_certificate", v.Name) {
+					*sslCertificates = append(*sslCertificates, v)
+				}
+			}
+		}
 
 		payload.Properties.SslCertificates = sslCertificates
 	}
@@ -1822,6 +1872,13 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		if err != nil {
 			return fmt.Errorf("fail to expand `http_listener`: %+v", err)
 		}
+		if existing := payload.Properties.HTTPListeners; existing != nil {
+			for _, v := range *existing {
+				if !applicationGatewayItemIsManaged(d, "http_listener", v.Name) {
+					*httpListeners = append(*httpListeners, v)
+				}
+			}
+		}
 
 		payload.Properties.HTTPListeners = httpListeners
 	}
@@ -1848,11 +1905,27 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	if d.HasChange("backend_address_pool") {
-		payload.Properties.BackendAddressPools = expandApplicationGatewayBackendAddressPools(d)
+		backendAddressPools := expandApplicationGatewayBackendAddressPools(d)
+		if existing := payload.Properties.BackendAddressPools; existing != nil {
+			for _, v := range *existing {
+				if !applicationGatewayItemIsManaged(d, "backend_address_pool", v.Name) {
+					*backendAddressPools = append(*backendAddressPools, v)
+				}
+			}
+		}
+		payload.Properties.BackendAddressPools = backendAddressPools
 	}
 
 	if d.HasChange("backend_http_settings") {
-		payload.Properties.BackendHTTPSettingsCollection = expandApplicationGatewayBackendHTTPSettings(d, id.ID())
+		backendHTTPSettings := expandApplicationGatewayBackendHTTPSettings(d, id.ID())
+		if existing := payload.Properties.BackendHTTPSettingsCollection; existing != nil {
+			for _, v := range *existing {
+				if !applicationGatewayItemIsManaged(d, "backend_http_settings", v.Name) {
+					*backendHTTPSettings = append(*backendHTTPSettings, v)
+				}
+			}
+		}
+		payload.Properties.BackendHTTPSettingsCollection = backendHTTPSettings
 	}
 
 	if d.HasChange("frontend_ip_configuration") {
@@ -1868,7 +1941,15 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	if d.HasChange("probe") {
-		payload.Properties.Probes = expandApplicationGatewayProbes(d)
+		probes := expandApplicationGatewayProbes(d)
+		if existing := payload.Properties.Probes; existing != nil {
+			for _, v := range *existing {
+				if !applicationGatewayItemIsManaged(d, "probe", v.Name) {
+					*probes = append(*probes, v)
+				}
+			}
+		}
+		payload.Properties.Probes = probes
 	}
 
 	if d.HasChange("sku") {
@@ -1977,6 +2058,15 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 	return resourceApplicationGatewayRead(d, meta)
 }
 
+// importApplicationGateway sets the default value for `standalone_sub_resources_enabled`, which isn't returned by the
+// API - as such all of the listeners, backend pools, backend http settings, probes, routing rules and ssl certificates
+// are read into the state on import, since which of these are managed by this resource can't be determined.
+func importApplicationGateway(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	d.Set("standalone_sub_resources_enabled", false)
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
 func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ApplicationGateways
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -2022,7 +2112,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `trusted_root_certificate`: %+v", err)
 			}
 
-			if setErr := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); setErr != nil {
+			if setErr := d.Set("backend_address_pool", applicationGatewayManagedItems(d, "backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools))); setErr != nil {
 				return fmt.Errorf("setting `backend_address_pool`: %+v", setErr)
 			}
 
@@ -2030,7 +2120,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			if err != nil {
 				return fmt.Errorf("flattening `backend_http_settings`: %+v", err)
 			}
-			if setErr := d.Set("backend_http_settings", backendHttpSettings); setErr != nil {
+			if setErr := d.Set("backend_http_settings", applicationGatewayManagedItems(d, "backend_http_settings", backendHttpSettings)); setErr != nil {
 				return fmt.Errorf("setting `backend_http_settings`: %+v", setErr)
 			}
 
@@ -2046,7 +2136,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			if err != nil {
 				return fmt.Errorf("flattening `http_listener`: %+v", err)
 			}
-			if setErr := d.Set("http_listener", httpListeners); setErr != nil {
+			if setErr := d.Set("http_listener", applicationGatewayManagedItems(d, "http_listener", httpListeners)); setErr != nil {
 				return fmt.Errorf("setting `http_listener`: %+v", setErr)
 			}
 
@@ -2078,7 +2168,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `private_link_configuration`: %+v", setErr)
 			}
 
-			if setErr := d.Set("probe", flattenApplicationGatewayProbes(props.Probes)); setErr != nil {
+			if setErr := d.Set("probe", applicationGatewayManagedItems(d, "probe", flattenApplicationGatewayProbes(props.Probes))); setErr != nil {
 				return fmt.Errorf("setting `probe`: %+v", setErr)
 			}
 
@@ -2086,7 +2176,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			if err != nil {
 				return fmt.Errorf("flattening `request_routing_rule`: %+v", err)
 			}
-			if setErr := d.Set("request_routing_rule", requestRoutingRules); setErr != nil {
+			if setErr := d.Set("request_routing_rule", applicationGatewayManagedItems(d, "request_routing_rule", requestRoutingRules)); setErr != nil {
 				return fmt.Errorf("setting `request_routing_rule`: %+v", setErr)
 			}
 
@@ -2111,7 +2201,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `autoscale_configuration`: %+v", setErr)
 			}
 
-			if setErr := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d)); setErr != nil {
+			if setErr := d.Set("ssl_certificate", applicationGatewayManagedItems(d, "ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d))); setErr != nil {
 				return fmt.Errorf("setting `ssl_certificate`: %+v", setErr)
 			}
 
@@ -2168,6 +2258,9 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.ApplicationGatewayName, applicationGatewayResourceName)
+
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
@@ -2175,6 +2268,76 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 	return nil
 }
 
+// applicationGatewayManagedItems filters the flattened items of the block `key` down to those managed by this resource
+// when `standalone_sub_resources_enabled` is set, since listeners, backend pools, backend http settings, probes, routing
+// rules and ssl certificates can then also be managed using their own resources (e.g. `azurerm_application_gateway_http_listener`).
+// The items managed by this resource are those within the state - otherwise all items are returned.
+func applicationGatewayManagedItems(d *pluginsdk.ResourceData, key string, input []interface{}) []interface{} {
+	if !d.Get("standalone_sub_resources_enabled").(bool) {
+		return input
+	}
+
+	managed := applicationGatewayItemNames(d.Get(key))
+
+	results := make([]interface{}, 0)
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := v["name"].(string); ok && managed[name] {
+			results = append(results, v)
+		}
+	}
+
+	return results
+}
+
+// applicationGatewayItemIsManaged returns whether the item of the block `key` is (or was) managed by this resource,
+// items which aren't must be retained when the block is updated. All items are managed by this resource unless
+// `standalone_sub_resources_enabled` is set - and only those in the configuration when it's first enabled, since
+// the state then contains all of the items.
+func applicationGatewayItemIsManaged(d *pluginsdk.ResourceData, key string, name *string) bool {
+	if !d.Get("standalone_sub_resources_enabled").(bool) {
+		return true
+	}
+
+	if name == nil {
+		return false
+	}
+
+	oldItems, newItems := d.GetChange(key)
+	if applicationGatewayItemNames(newItems)[*name] {
+		return true
+	}
+
+	oldEnabled, _ := d.GetChange("standalone_sub_resources_enabled")
+	return oldEnabled.(bool) && applicationGatewayItemNames(oldItems)[*name]
+}
+
+func applicationGatewayItemNames(input interface{}) map[string]bool {
+	names := make(map[string]bool)
+
+	set, ok := input.(*pluginsdk.Set)
+	if !ok || set == nil {
+		return names
+	}
+
+	for _, raw := range set.List() {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := v["name"].(string); ok && name != "" {
+			names[name] = true
+		}
+	}
+
+	return names
+}
+
 func expandApplicationGatewayAuthenticationCertificates(certs []interface{}) *[]applicationgateways.ApplicationGatewayAuthenticationCertificate {
 	results := make([]applicationgateways.ApplicationGatewayAuthenticationCertificate, 0)
 
@@ -2320,39 +2483,43 @@ func expandApplicationGatewayBackendAddressPools(d *pluginsdk.ResourceData) *[]a
 
 	for _, raw := range vs {
 		v := raw.(map[string]interface{})
-		backendAddresses := make([]applicationgateways.ApplicationGatewayBackendAddress, 0)
+		results = append(results, expandApplicationGatewayBackendAddressPool(v))
+	}
 
-		if fqdnsConfig, ok := v["fqdns"]; ok {
-			fqdns := fqdnsConfig.(*schema.Set).List()
-			for _, ip := range fqdns {
-				backendAddresses = append(backendAddresses, applicationgateways.ApplicationGatewayBackendAddress{
-					Fqdn: pointer.To(ip.(string)),
-				})
-			}
-		}
+	return &results
+}
 
-		if ipAddressesConfig, ok := v["ip_addresses"]; ok {
-			ipAddresses := ipAddressesConfig.(*schema.Set).List()
+func expandApplicationGatewayBackendAddressPool(v map[string]interface{}) applicationgateways.ApplicationGatewayBackendAddressPool {
+	backendAddresses := make([]applicationgateways.ApplicationGatewayBackendAddress, 0)
 
-			for _, ip := range ipAddresses {
-				backendAddresses = append(backendAddresses, applicationgateways.ApplicationGatewayBackendAddress{
-					IPAddress: pointer.To(ip.(string)),
-				})
-			}
+	if fqdnsConfig, ok := v["fqdns"]; ok {
+		fqdns := fqdnsConfig.(*schema.Set).List()
+		for _, ip := range fqdns {
+			backendAddresses = append(backendAddresses, applicationgateways.ApplicationGatewayBackendAddress{
+				Fqdn: pointer.To(ip.(string)),
+			})
 		}
+	}
 
-		name := v["name"].(string)
-		output := applicationgateways.ApplicationGatewayBackendAddressPool{
-			Name: pointer.To(name),
-			Properties: &applicationgateways.ApplicationGatewayBackendAddressPoolPropertiesFormat{
-				BackendAddresses: &backendAddresses,
-			},
+	if ipAddressesConfig, ok := v["ip_addresses"]; ok {
+		ipAddresses := ipAddressesConfig.(*schema.Set).List()
+
+		for _, ip := range ipAddresses {
+			backendAddresses = append(backendAddresses, applicationgateways.ApplicationGatewayBackendAddress{
+				IPAddress: pointer.To(ip.(string)),
+			})
 		}
+	}
 
-		results = append(results, output)
+	name := v["name"].(string)
+	output := applicationgateways.ApplicationGatewayBackendAddressPool{
+		Name: pointer.To(name),
+		Properties: &applicationgateways.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+			BackendAddresses: &backendAddresses,
+		},
 	}
 
-	return &results
+	return output
 }
 
 func flattenApplicationGatewayBackendAddressPools(input *[]applicationgateways.ApplicationGatewayBackendAddressPool) []interface{} {
@@ -2402,85 +2569,88 @@ func expandApplicationGatewayBackendHTTPSettings(d *pluginsdk.ResourceData, gate
 
 	for _, raw := range vs {
 		v := raw.(map[string]interface{})
+		results = append(results, expandApplicationGatewayBackendHTTPSetting(v, gatewayID))
+	}
 
-		name := v["name"].(string)
-		path := v["path"].(string)
-		port := int64(v["port"].(int))
-		protocol := v["protocol"].(string)
-		cookieBasedAffinity := v["cookie_based_affinity"].(string)
-		pickHostNameFromBackendAddress := v["pick_host_name_from_backend_address"].(bool)
-		requestTimeout := int64(v["request_timeout"].(int))
-
-		setting := applicationgateways.ApplicationGatewayBackendHTTPSettings{
-			Name: &name,
-			Properties: &applicationgateways.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
-				CookieBasedAffinity:            pointer.To(applicationgateways.ApplicationGatewayCookieBasedAffinity(cookieBasedAffinity)),
-				Path:                           pointer.To(path),
-				PickHostNameFromBackendAddress: pointer.To(pickHostNameFromBackendAddress),
-				Port:                           pointer.To(port),
-				Protocol:                       pointer.To(applicationgateways.ApplicationGatewayProtocol(protocol)),
-				RequestTimeout:                 pointer.To(requestTimeout),
-				ConnectionDraining:             expandApplicationGatewayConnectionDraining(v),
-			},
-		}
+	return &results
+}
 
-		hostName := v["host_name"].(string)
-		if hostName != "" {
-			setting.Properties.HostName = pointer.To(hostName)
-		}
+func expandApplicationGatewayBackendHTTPSetting(v map[string]interface{}, gatewayID string) applicationgateways.ApplicationGatewayBackendHTTPSettings {
+	name := v["name"].(string)
+	path := v["path"].(string)
+	port := int64(v["port"].(int))
+	protocol := v["protocol"].(string)
+	cookieBasedAffinity := v["cookie_based_affinity"].(string)
+	pickHostNameFromBackendAddress := v["pick_host_name_from_backend_address"].(bool)
+	requestTimeout := int64(v["request_timeout"].(int))
+
+	setting := applicationgateways.ApplicationGatewayBackendHTTPSettings{
+		Name: &name,
+		Properties: &applicationgateways.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
+			CookieBasedAffinity:            pointer.To(applicationgateways.ApplicationGatewayCookieBasedAffinity(cookieBasedAffinity)),
+			Path:                           pointer.To(path),
+			PickHostNameFromBackendAddress: pointer.To(pickHostNameFromBackendAddress),
+			Port:                           pointer.To(port),
+			Protocol:                       pointer.To(applicationgateways.ApplicationGatewayProtocol(protocol)),
+			RequestTimeout:                 pointer.To(requestTimeout),
+			ConnectionDraining:             expandApplicationGatewayConnectionDraining(v),
+		},
+	}
 
-		affinityCookieName := v["affinity_cookie_name"].(string)
-		if affinityCookieName != "" {
-			setting.Properties.AffinityCookieName = pointer.To(affinityCookieName)
-		}
+	hostName := v["host_name"].(string)
+	if hostName != "" {
+		setting.Properties.HostName = pointer.To(hostName)
+	}
 
-		if v["authentication_certificate"] != nil {
-			authCerts := v["authentication_certificate"].([]interface{})
-			authCertSubResources := make([]applicationgateways.SubResource, 0)
+	affinityCookieName := v["affinity_cookie_name"].(string)
+	if affinityCookieName != "" {
+		setting.Properties.AffinityCookieName = pointer.To(affinityCookieName)
+	}
 
-			for _, rawAuthCert := range authCerts {
-				authCert := rawAuthCert.(map[string]interface{})
-				authCertName := authCert["name"].(string)
-				authCertID := fmt.Sprintf("%s/authenticationCertificates/%s", gatewayID, authCertName)
-				authCertSubResource := applicationgateways.SubResource{
-					Id: pointer.To(authCertID),
-				}
+	if v["authentication_certificate"] != nil {
+		authCerts := v["authentication_certificate"].([]interface{})
+		authCertSubResources := make([]applicationgateways.SubResource, 0)
 
-				authCertSubResources = append(authCertSubResources, authCertSubResource)
+		for _, rawAuthCert := range authCerts {
+			authCert := rawAuthCert.(map[string]interface{})
+			authCertName := authCert["name"].(string)
+			authCertID := fmt.Sprintf("%s/authenticationCertificates/%s", gatewayID, authCertName)
+			authCertSubResource := applicationgateways.SubResource{
+				Id: pointer.To(authCertID),
 			}
 
-			setting.Properties.AuthenticationCertificates = &authCertSubResources
+			authCertSubResources = append(authCertSubResources, authCertSubResource)
 		}
 
-		if v["trusted_root_certificate_names"] != nil {
-			trustedRootCertNames := v["trusted_root_certificate_names"].([]interface{})
-			trustedRootCertSubResources := make([]applicationgateways.SubResource, 0)
+		setting.Properties.AuthenticationCertificates = &authCertSubResources
+	}
 
-			for _, rawTrustedRootCertName := range trustedRootCertNames {
-				trustedRootCertName := rawTrustedRootCertName.(string)
-				trustedRootCertID := fmt.Sprintf("%s/trustedRootCertificates/%s", gatewayID, trustedRootCertName)
-				trustedRootCertSubResource := applicationgateways.SubResource{
-					Id: pointer.To(trustedRootCertID),
-				}
+	if v["trusted_root_certificate_names"] != nil {
+		trustedRootCertNames := v["trusted_root_certificate_names"].([]interface{})
+		trustedRootCertSubResources := make([]applicationgateways.SubResource, 0)
 
-				trustedRootCertSubResources = append(trustedRootCertSubResources, trustedRootCertSubResource)
+		for _, rawTrustedRootCertName := range trustedRootCertNames {
+			trustedRootCertName := rawTrustedRootCertName.(string)
+			trustedRootCertID := fmt.Sprintf("%s/trustedRootCertificates/%s", gatewayID, trustedRootCertName)
+			trustedRootCertSubResource := applicationgateways.SubResource{
+				Id: pointer.To(trustedRootCertID),
 			}
 
-			setting.Properties.TrustedRootCertificates = &trustedRootCertSubResources
+			trustedRootCertSubResources = append(trustedRootCertSubResources, trustedRootCertSubResource)
 		}
 
-		probeName := v["probe_name"].(string)
-		if probeName != "" {
-			probeID := fmt.Sprintf("%s/probes/%s", gatewayID, probeName)
-			setting.Properties.Probe = &applicationgateways.SubResource{
-				Id: pointer.To(probeID),
-			}
-		}
+		setting.Properties.TrustedRootCertificates = &trustedRootCertSubResources
+	}
 
-		results = append(results, setting)
+	probeName := v["probe_name"].(string)
+	if probeName != "" {
+		probeID := fmt.Sprintf("%s/probes/%s", gatewayID, probeName)
+		setting.Properties.Probe = &applicationgateways.SubResource{
+			Id: pointer.To(probeID),
+		}
 	}
 
-	return &results
+	return setting
 }
 
 func flattenApplicationGatewayBackendHTTPSettings(input *[]applicationgateways.ApplicationGatewayBackendHTTPSettings) ([]interface{}, error) {
@@ -2695,74 +2865,82 @@ func expandApplicationGatewayHTTPListeners(d *pluginsdk.ResourceData, gatewayID
 
 	for _, raw := range vs {
 		v := raw.(map[string]interface{})
+		listener, err := expandApplicationGatewayHTTPListener(v, gatewayID)
+		if err != nil {
+			return nil, err
+		}
 
-		name := v["name"].(string)
-		frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
-		frontendPortName := v["frontend_port_name"].(string)
-		protocol := v["protocol"].(string)
-		requireSNI := v["require_sni"].(bool)
-		sslProfileName := v["ssl_profile_name"].(string)
-
-		frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
-		frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
-		firewallPolicyID := v["firewall_policy_id"].(string)
+		results = append(results, *listener)
+	}
 
-		customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+	return &results, nil
+}
 
-		listener := applicationgateways.ApplicationGatewayHTTPListener{
-			Name: pointer.To(name),
-			Properties: &applicationgateways.ApplicationGatewayHTTPListenerPropertiesFormat{
-				FrontendIPConfiguration: &applicationgateways.SubResource{
-					Id: pointer.To(frontendIPConfigID),
-				},
-				FrontendPort: &applicationgateways.SubResource{
-					Id: pointer.To(frontendPortID),
-				},
-				Protocol:                    pointer.To(applicationgateways.ApplicationGatewayProtocol(protocol)),
-				RequireServerNameIndication: pointer.To(requireSNI),
-				CustomErrorConfigurations:   customErrorConfigurations,
+func expandApplicationGatewayHTTPListener(v map[string]interface{}, gatewayID string) (*applicationgateways.ApplicationGatewayHTTPListener, error) {
+	name := v["name"].(string)
+	frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
+	frontendPortName := v["frontend_port_name"].(string)
+	protocol := v["protocol"].(string)
+	requireSNI := v["require_sni"].(bool)
+	sslProfileName := v["ssl_profile_name"].(string)
+
+	frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
+	frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
+	firewallPolicyID := v["firewall_policy_id"].(string)
+
+	customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+
+	listener := applicationgateways.ApplicationGatewayHTTPListener{
+		Name: pointer.To(name),
+		Properties: &applicationgateways.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration: &applicationgateways.SubResource{
+				Id: pointer.To(frontendIPConfigID),
 			},
-		}
+			FrontendPort: &applicationgateways.SubResource{
+				Id: pointer.To(frontendPortID),
+			},
+			Protocol:                    pointer.To(applicationgateways.ApplicationGatewayProtocol(protocol)),
+			RequireServerNameIndication: pointer.To(requireSNI),
+			CustomErrorConfigurations:   customErrorConfigurations,
+		},
+	}
 
-		host := v["host_name"].(string)
-		hosts := v["host_names"].(*pluginsdk.Set).List()
+	host := v["host_name"].(string)
+	hosts := v["host_names"].(*pluginsdk.Set).List()
 
-		if host != "" && len(hosts) > 0 {
-			return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
-		}
+	if host != "" && len(hosts) > 0 {
+		return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
+	}
 
-		if host != "" {
-			listener.Properties.HostName = &host
-		}
+	if host != "" {
+		listener.Properties.HostName = &host
+	}
 
-		if len(hosts) > 0 {
-			listener.Properties.HostNames = utils.ExpandStringSlice(hosts)
-		}
+	if len(hosts) > 0 {
+		listener.Properties.HostNames = utils.ExpandStringSlice(hosts)
+	}
 
-		if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
-			certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
-			listener.Properties.SslCertificate = &applicationgateways.SubResource{
-				Id: pointer.To(certID),
-			}
+	if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
+		certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
+		listener.Properties.SslCertificate = &applicationgateways.SubResource{
+			Id: pointer.To(certID),
 		}
+	}
 
-		if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
-			listener.Properties.FirewallPolicy = &applicationgateways.SubResource{
-				Id: pointer.To(firewallPolicyID),
-			}
+	if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
+		listener.Properties.FirewallPolicy = &applicationgateways.SubResource{
+			Id: pointer.To(firewallPolicyID),
 		}
+	}
 
-		if sslProfileName != "" && len(sslProfileName) > 0 {
-			sslProfileID := fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)
-			listener.Properties.SslProfile = &applicationgateways.SubResource{
-				Id: pointer.To(sslProfileID),
-			}
+	if sslProfileName != "" && len(sslProfileName) > 0 {
+		sslProfileID := fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)
+		listener.Properties.SslProfile = &applicationgateways.SubResource{
+			Id: pointer.To(sslProfileID),
 		}
-
-		results = append(results, listener)
 	}
 
-	return &results, nil
+	return &listener, nil
 }
 
 func flattenApplicationGatewayHTTPListeners(input *[]applicationgateways.ApplicationGatewayHTTPListener) ([]interface{}, error) {
@@ -3126,58 +3304,61 @@ func expandApplicationGatewayProbes(d *pluginsdk.ResourceData) *[]applicationgat
 
 	for _, raw := range vs {
 		v := raw.(map[string]interface{})
+		results = append(results, expandApplicationGatewayProbe(v))
+	}
 
-		host := v["host"].(string)
-		interval := int64(v["interval"].(int))
-		minServers := int64(v["minimum_servers"].(int))
-		name := v["name"].(string)
-		probePath := v["path"].(string)
-		protocol := v["protocol"].(string)
-		port := int64(v["port"].(int))
-		timeout := int64(v["timeout"].(int))
-		unhealthyThreshold := int64(v["unhealthy_threshold"].(int))
-		pickHostNameFromBackendHTTPSettings := v["pick_host_name_from_backend_http_settings"].(bool)
+	return &results
+}
 
-		output := applicationgateways.ApplicationGatewayProbe{
-			Name: pointer.To(name),
-			Properties: &applicationgateways.ApplicationGatewayProbePropertiesFormat{
-				Host:                                pointer.To(host),
-				Interval:                            pointer.To(interval),
-				MinServers:                          pointer.To(minServers),
-				Path:                                pointer.To(probePath),
-				Protocol:                            pointer.To(applicationgateways.ApplicationGatewayProtocol(protocol)),
-				Timeout:                             pointer.To(timeout),
-				UnhealthyThreshold:                  pointer.To(unhealthyThreshold),
-				PickHostNameFromBackendHTTPSettings: pointer.To(pickHostNameFromBackendHTTPSettings),
-			},
-		}
+func expandApplicationGatewayProbe(v map[string]interface{}) applicationgateways.ApplicationGatewayProbe {
+	host := v["host"].(string)
+	interval := int64(v["interval"].(int))
+	minServers := int64(v["minimum_servers"].(int))
+	name := v["name"].(string)
+	probePath := v["path"].(string)
+	protocol := v["protocol"].(string)
+	port := int64(v["port"].(int))
+	timeout := int64(v["timeout"].(int))
+	unhealthyThreshold := int64(v["unhealthy_threshold"].(int))
+	pickHostNameFromBackendHTTPSettings := v["pick_host_name_from_backend_http_settings"].(bool)
+
+	output := applicationgateways.ApplicationGatewayProbe{
+		Name: pointer.To(name),
+		Properties: &applicationgateways.ApplicationGatewayProbePropertiesFormat{
+			Host:                                pointer.To(host),
+			Interval:                            pointer.To(interval),
+			MinServers:                          pointer.To(minServers),
+			Path:                                pointer.To(probePath),
+			Protocol:                            pointer.To(applicationgateways.ApplicationGatewayProtocol(protocol)),
+			Timeout:                             pointer.To(timeout),
+			UnhealthyThreshold:                  pointer.To(unhealthyThreshold),
+			PickHostNameFromBackendHTTPSettings: pointer.To(pickHostNameFromBackendHTTPSettings),
+		},
+	}
 
-		matchConfigs := v["match"].([]interface{})
-		if len(matchConfigs) > 0 {
-			matchBody := ""
-			outputMatch := &applicationgateways.ApplicationGatewayProbeHealthResponseMatch{}
-			if matchConfigs[0] != nil {
-				match := matchConfigs[0].(map[string]interface{})
-				matchBody = match["body"].(string)
+	matchConfigs := v["match"].([]interface{})
+	if len(matchConfigs) > 0 {
+		matchBody := ""
+		outputMatch := &applicationgateways.ApplicationGatewayProbeHealthResponseMatch{}
+		if matchConfigs[0] != nil {
+			match := matchConfigs[0].(map[string]interface{})
+			matchBody = match["body"].(string)
 
-				statusCodes := make([]string, 0)
-				for _, statusCode := range match["status_code"].([]interface{}) {
-					statusCodes = append(statusCodes, statusCode.(string))
-				}
-				outputMatch.StatusCodes = &statusCodes
+			statusCodes := make([]string, 0)
+			for _, statusCode := range match["status_code"].([]interface{}) {
+				statusCodes = append(statusCodes, statusCode.(string))
 			}
-			outputMatch.Body = pointer.To(matchBody)
-			output.Properties.Match = outputMatch
-		}
-
-		if port != 0 {
-			output.Properties.Port = pointer.To(port)
+			outputMatch.StatusCodes = &statusCodes
 		}
+		outputMatch.Body = pointer.To(matchBody)
+		output.Properties.Match = outputMatch
+	}
 
-		results = append(results, output)
+	if port != 0 {
+		output.Properties.Port = pointer.To(port)
 	}
 
-	return &results
+	return output
 }
 
 func flattenApplicationGatewayProbes(input *[]applicationgateways.ApplicationGatewayProbe) []interface{} {
@@ -3370,86 +3551,97 @@ func expandApplicationGatewayRequestRoutingRules(d *pluginsdk.ResourceData, gate
 
 	for _, raw := range vs {
 		v := raw.(map[string]interface{})
-
-		name := v["name"].(string)
-		ruleType := v["rule_type"].(string)
-		httpListenerName := v["http_listener_name"].(string)
-		httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
-		backendAddressPoolName := v["backend_address_pool_name"].(string)
-		backendHTTPSettingsName := v["backend_http_settings_name"].(string)
-		redirectConfigName := v["redirect_configuration_name"].(string)
-		priority := int64(v["priority"].(int))
-
-		rule := applicationgateways.ApplicationGatewayRequestRoutingRule{
-			Name: pointer.To(name),
-			Properties: &applicationgateways.ApplicationGatewayRequestRoutingRulePropertiesFormat{
-				RuleType: pointer.To(applicationgateways.ApplicationGatewayRequestRoutingRuleType(ruleType)),
-				HTTPListener: &applicationgateways.SubResource{
-					Id: pointer.To(httpListenerID),
-				},
-			},
+		rule, err := expandApplicationGatewayRequestRoutingRule(v, gatewayID)
+		if err != nil {
+			return nil, err
 		}
 
-		if backendAddressPoolName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
+		if rule.Properties.Priority != nil {
+			priorityset = true
 		}
 
-		if backendHTTPSettingsName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
-		}
+		results = append(results, *rule)
+	}
 
-		if backendAddressPoolName != "" {
-			backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
-			rule.Properties.BackendAddressPool = &applicationgateways.SubResource{
-				Id: pointer.To(backendAddressPoolID),
+	if priorityset {
+		for _, rule := range results {
+			if rule.Properties.Priority == nil {
+				return nil, fmt.Errorf("If you wish to use rule priority, you will have to specify rule-priority field values for all the existing request routing rules.")
 			}
 		}
+	}
 
-		if backendHTTPSettingsName != "" {
-			backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
-			rule.Properties.BackendHTTPSettings = &applicationgateways.SubResource{
-				Id: pointer.To(backendHTTPSettingsID),
-			}
-		}
+	return &results, nil
+}
 
-		if redirectConfigName != "" {
-			redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
-			rule.Properties.RedirectConfiguration = &applicationgateways.SubResource{
-				Id: pointer.To(redirectConfigID),
-			}
-		}
+func expandApplicationGatewayRequestRoutingRule(v map[string]interface{}, gatewayID string) (*applicationgateways.ApplicationGatewayRequestRoutingRule, error) {
+	name := v["name"].(string)
+	ruleType := v["rule_type"].(string)
+	httpListenerName := v["http_listener_name"].(string)
+	httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
+	backendAddressPoolName := v["backend_address_pool_name"].(string)
+	backendHTTPSettingsName := v["backend_http_settings_name"].(string)
+	redirectConfigName := v["redirect_configuration_name"].(string)
+	priority := int64(v["priority"].(int))
+
+	rule := applicationgateways.ApplicationGatewayRequestRoutingRule{
+		Name: pointer.To(name),
+		Properties: &applicationgateways.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType: pointer.To(applicationgateways.ApplicationGatewayRequestRoutingRuleType(ruleType)),
+			HTTPListener: &applicationgateways.SubResource{
+				Id: pointer.To(httpListenerID),
+			},
+		},
+	}
 
-		if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
-			urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
-			rule.Properties.UrlPathMap = &applicationgateways.SubResource{
-				Id: pointer.To(urlPathMapID),
-			}
+	if backendAddressPoolName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
+	}
+
+	if backendHTTPSettingsName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
+	}
+
+	if backendAddressPoolName != "" {
+		backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
+		rule.Properties.BackendAddressPool = &applicationgateways.SubResource{
+			Id: pointer.To(backendAddressPoolID),
 		}
+	}
 
-		if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
-			rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
-			rule.Properties.RewriteRuleSet = &applicationgateways.SubResource{
-				Id: pointer.To(rewriteRuleSetID),
-			}
+	if backendHTTPSettingsName != "" {
+		backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
+		rule.Properties.BackendHTTPSettings = &applicationgateways.SubResource{
+			Id: pointer.To(backendHTTPSettingsID),
 		}
+	}
 
-		if priority != 0 {
-			rule.Properties.Priority = &priority
-			priorityset = true
+	if redirectConfigName != "" {
+		redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
+		rule.Properties.RedirectConfiguration = &applicationgateways.SubResource{
+			Id: pointer.To(redirectConfigID),
 		}
+	}
 
-		results = append(results, rule)
+	if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
+		urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
+		rule.Properties.UrlPathMap = &applicationgateways.SubResource{
+			Id: pointer.To(urlPathMapID),
+		}
 	}
 
-	if priorityset {
-		for _, rule := range results {
-			if rule.Properties.Priority == nil {
-				return nil, fmt.Errorf("If you wish to use rule priority, you will have to specify rule-priority field values for all the existing request routing rules.")
-			}
+	if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
+		rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
+		rule.Properties.RewriteRuleSet = &applicationgateways.SubResource{
+			Id: pointer.To(rewriteRuleSetID),
 		}
 	}
 
-	return &results, nil
+	if priority != 0 {
+		rule.Properties.Priority = &priority
+	}
+
+	return &rule, nil
 }
 
 func flattenApplicationGatewayRequestRoutingRules(input *[]applicationgateways.ApplicationGatewayRequestRoutingRule) ([]interface{}, error) {
@@ -3969,42 +4161,50 @@ func expandApplicationGatewaySslCertificates(d *pluginsdk.ResourceData) (*[]appl
 
 	for _, raw := range vs {
 		v := raw.(map[string]interface{})
+		certificate, err := expandApplicationGatewaySslCertificate(v)
+		if err != nil {
+			return nil, err
+		}
 
-		name := v["name"].(string)
-		data := v["data"].(string)
-		password := v["password"].(string)
-		kvsid := v["key_vault_secret_id"].(string)
-		cert := v["public_cert_data"].(string)
+		results = append(results, *certificate)
+	}
 
-		output := applicationgateways.ApplicationGatewaySslCertificate{
-			Name:       pointer.To(name),
-			Properties: &applicationgateways.ApplicationGatewaySslCertificatePropertiesFormat{},
-		}
+	return &results, nil
+}
 
-		// nolint gocritic
-		if data != "" && kvsid != "" {
-			return nil, fmt.Errorf("only one of `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
-		} else if data != "" {
-			// data must be base64 encoded
-			output.Properties.Data = pointer.To(utils.Base64EncodeIfNot(data))
+func expandApplicationGatewaySslCertificate(v map[string]interface{}) (*applicationgateways.ApplicationGatewaySslCertificate, error) {
+	name := v["name"].(string)
+	data := v["data"].(string)
+	password := v["password"].(string)
+	kvsid := v["key_vault_secret_id"].(string)
+	cert := v["public_cert_data"].(string)
 
-			output.Properties.Password = pointer.To(password)
-		} else if kvsid != "" {
-			if password != "" {
-				return nil, fmt.Errorf("only one of `key_vault_secret_id` or `password` must be specified for the `ssl_certificate` block %q", name)
-			}
+	output := applicationgateways.ApplicationGatewaySslCertificate{
+		Name:       pointer.To(name),
+		Properties: &applicationgateways.ApplicationGatewaySslCertificatePropertiesFormat{},
+	}
 
-			output.Properties.KeyVaultSecretId = pointer.To(kvsid)
-		} else if cert != "" {
-			output.Properties.PublicCertData = pointer.To(cert)
-		} else {
-			return nil, fmt.Errorf("either `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
+	// nolint gocritic
+	if data != "" && kvsid != "" {
+		return nil, fmt.Errorf("only one of `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
+	} else if data != "" {
+		// data must be base64 encoded
+		output.Properties.Data = pointer.To(utils.Base64EncodeIfNot(data))
+
+		output.Properties.Password = pointer.To(password)
+	} else if kvsid != "" {
+		if password != "" {
+			return nil, fmt.Errorf("only one of `key_vault_secret_id` or `password` must be specified for the `ssl_certificate` block %q", name)
 		}
 
-		results = append(results, output)
+		output.Properties.KeyVaultSecretId = pointer.To(kvsid)
+	} else if cert != "" {
+		output.Properties.PublicCertData = pointer.To(cert)
+	} else {
+		return nil, fmt.Errorf("either `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
 	}
 
-	return &results, nil
+	return &output, nil
 }
 
 func flattenApplicationGatewaySslCertificates(input *[]applicationgateways.ApplicationGatewaySslCertificate, d *pluginsdk.ResourceData) []interface{} {
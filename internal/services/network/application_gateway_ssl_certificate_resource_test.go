@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApplicationGatewaySslCertificateResource struct{}
+
+func TestAccApplicationGatewaySslCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_ssl_certificate", "test")
+	r := ApplicationGatewaySslCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("data", "password"),
+		{
+			// confirm that the Application Gateway doesn't take ownership of the SSL Certificate
+			Config:   r.basic(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccApplicationGatewaySslCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_ssl_certificate", "test")
+	r := ApplicationGatewaySslCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r ApplicationGatewaySslCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SslCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationGatewayId := applicationgateways.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	resp, err := clients.Network.ApplicationGateways.Get(ctx, applicationGatewayId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", applicationGatewayId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.SslCertificates != nil {
+		for _, v := range *model.Properties.SslCertificates {
+			if strings.EqualFold(pointer.From(v.Name), id.Name) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r ApplicationGatewaySslCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_ssl_certificate" "test" {
+  name                   = "acctest-sslcert-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  data                   = filebase64("testdata/application_gateway_test.pfx")
+  password               = "terraform"
+}
+`, ApplicationGatewayResource{}.standaloneSubResources(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewaySslCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_ssl_certificate" "import" {
+  name                   = azurerm_application_gateway_ssl_certificate.test.name
+  application_gateway_id = azurerm_application_gateway_ssl_certificate.test.application_gateway_id
+  data                   = filebase64("testdata/application_gateway_test.pfx")
+  password               = "terraform"
+}
+`, r.basic(data))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/applicationgateways"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// applicationGatewaySubResourceSchema returns the schema for a resource managing a single item of an Application Gateway
+// block (e.g. a `http_listener`) - based on the schema of the block, where the `id` of the item is the ID of the resource
+func applicationGatewaySubResourceSchema(input map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	output := map[string]*pluginsdk.Schema{
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: applicationgateways.ValidateApplicationGatewayID,
+		},
+	}
+
+	for k, v := range input {
+		if k == "id" {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	output["name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return output
+}
+
+// expandApplicationGatewaySubResource returns the configuration of the resource in the same shape as an item within the
+// Application Gateway block with the schema `input`, so that it can be expanded using the same functions
+func expandApplicationGatewaySubResource(d *pluginsdk.ResourceData, input map[string]*pluginsdk.Schema) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k := range input {
+		if k == "id" {
+			continue
+		}
+
+		output[k] = d.Get(k)
+	}
+
+	return output
+}
+
+// flattenApplicationGatewaySubResource sets the flattened item from an Application Gateway block into the state
+func flattenApplicationGatewaySubResource(d *pluginsdk.ResourceData, input map[string]interface{}) error {
+	for k, v := range input {
+		if k == "id" {
+			continue
+		}
+
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", k, err)
+		}
+	}
+
+	return nil
+}
+
+// retrieveApplicationGatewayForSubResource retrieves the Application Gateway which an item is being added to, updated
+// within or removed from - the caller is expected to hold the lock for the Application Gateway
+func retrieveApplicationGatewayForSubResource(ctx context.Context, client *applicationgateways.ApplicationGatewaysClient, id applicationgateways.ApplicationGatewayId) (*applicationgateways.ApplicationGateway, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+
+	if resp.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	return resp.Model, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+)
+
+// TODO: move this into `hashicorp/go-azure-sdk`
+// the Network Watcher and Network Interface diagnostic operations are long-running operations whose result is only
+// available from the `Location` URI once the operation has completed, however the generated SDK methods don't
+// expose this - as such we poll for completion and then retrieve the result into `model` ourselves.
+func networkDiagnosticOperationResult(ctx context.Context, c *resourcemanager.Client, initialResponse *http.Response, poller pollers.Poller, model interface{}) error {
+	if initialResponse == nil {
+		return fmt.Errorf("the initial response was nil")
+	}
+
+	// the operation completed synchronously, the result is in the initial response
+	if initialResponse.StatusCode == http.StatusOK {
+		resp := client.Response{
+			Response: initialResponse,
+		}
+		if err := resp.Unmarshal(model); err != nil {
+			return fmt.Errorf("unmarshalling result: %+v", err)
+		}
+		return nil
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling: %+v", err)
+	}
+
+	location := initialResponse.Header.Get("Location")
+	if location == "" {
+		return fmt.Errorf("the initial response didn't contain a `Location` header")
+	}
+	locationUri, err := url.Parse(location)
+	if err != nil {
+		return fmt.Errorf("parsing the `Location` header %q: %+v", location, err)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       locationUri.Path,
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request for result: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving result: %+v", err)
+	}
+
+	if err := resp.Unmarshal(model); err != nil {
+		return fmt.Errorf("unmarshalling result: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = NetworkInterfaceEffectiveRoutesDataSource{}
+
+type NetworkInterfaceEffectiveRoutesDataSource struct{}
+
+type NetworkInterfaceEffectiveRoutesDataSourceModel struct {
+	NetworkInterfaceId string                `tfschema:"network_interface_id"`
+	Routes             []EffectiveRouteModel `tfschema:"route"`
+}
+
+type EffectiveRouteModel struct {
+	Name                       string   `tfschema:"name"`
+	AddressPrefixes            []string `tfschema:"address_prefixes"`
+	BgpRoutePropagationEnabled bool     `tfschema:"bgp_route_propagation_enabled"`
+	NextHopIpAddresses         []string `tfschema:"next_hop_ip_addresses"`
+	NextHopType                string   `tfschema:"next_hop_type"`
+	Source                     string   `tfschema:"source"`
+	State                      string   `tfschema:"state"`
+}
+
+func (NetworkInterfaceEffectiveRoutesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+	}
+}
+
+func (NetworkInterfaceEffectiveRoutesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"route": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"address_prefixes": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"bgp_route_propagation_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"next_hop_ip_addresses": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"next_hop_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"source": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (NetworkInterfaceEffectiveRoutesDataSource) ModelObject() interface{} {
+	return &NetworkInterfaceEffectiveRoutesDataSourceModel{}
+}
+
+func (NetworkInterfaceEffectiveRoutesDataSource) ResourceType() string {
+	return "azurerm_network_interface_effective_routes"
+}
+
+func (NetworkInterfaceEffectiveRoutesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// the effective routes are calculated on demand, which can take a few minutes
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			var state NetworkInterfaceEffectiveRoutesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseNetworkInterfaceID(state.NetworkInterfaceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetEffectiveRouteTable(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving effective Route Table for %s: %+v", id, err)
+			}
+
+			var result effectiveRouteListResult
+			if err := networkDiagnosticOperationResult(ctx, client.Client, resp.HttpResponse, resp.Poller, &result); err != nil {
+				return fmt.Errorf("retrieving effective Route Table for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			state.Routes = flattenEffectiveRoutes(result.Value)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+type effectiveRouteListResult struct {
+	Value *[]networkinterfaces.EffectiveRoute `json:"value,omitempty"`
+}
+
+func flattenEffectiveRoutes(input *[]networkinterfaces.EffectiveRoute) []EffectiveRouteModel {
+	output := make([]EffectiveRouteModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, EffectiveRouteModel{
+			Name:                       pointer.From(v.Name),
+			AddressPrefixes:            pointer.From(v.AddressPrefix),
+			BgpRoutePropagationEnabled: !pointer.From(v.DisableBgpRoutePropagation),
+			NextHopIpAddresses:         pointer.From(v.NextHopIPAddress),
+			NextHopType:                string(pointer.From(v.NextHopType)),
+			Source:                     string(pointer.From(v.Source)),
+			State:                      string(pointer.From(v.State)),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = NetworkInterfaceEffectiveSecurityRulesDataSource{}
+
+type NetworkInterfaceEffectiveSecurityRulesDataSource struct{}
+
+type NetworkInterfaceEffectiveSecurityRulesDataSourceModel struct {
+	NetworkInterfaceId    string                               `tfschema:"network_interface_id"`
+	NetworkSecurityGroups []EffectiveNetworkSecurityGroupModel `tfschema:"network_security_group"`
+}
+
+type EffectiveNetworkSecurityGroupModel struct {
+	NetworkSecurityGroupId string                              `tfschema:"network_security_group_id"`
+	NetworkInterfaceId     string                              `tfschema:"network_interface_id"`
+	SubnetId               string                              `tfschema:"subnet_id"`
+	SecurityRules          []EffectiveNetworkSecurityRuleModel `tfschema:"security_rule"`
+}
+
+type EffectiveNetworkSecurityRuleModel struct {
+	Name                               string   `tfschema:"name"`
+	Access                             string   `tfschema:"access"`
+	Direction                          string   `tfschema:"direction"`
+	Priority                           int64    `tfschema:"priority"`
+	Protocol                           string   `tfschema:"protocol"`
+	SourceAddressPrefixes              []string `tfschema:"source_address_prefixes"`
+	SourcePortRanges                   []string `tfschema:"source_port_ranges"`
+	DestinationAddressPrefixes         []string `tfschema:"destination_address_prefixes"`
+	DestinationPortRanges              []string `tfschema:"destination_port_ranges"`
+	ExpandedSourceAddressPrefixes      []string `tfschema:"expanded_source_address_prefixes"`
+	ExpandedDestinationAddressPrefixes []string `tfschema:"expanded_destination_address_prefixes"`
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+	}
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_security_group": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"network_security_group_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"network_interface_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"subnet_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"security_rule": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"access": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"direction": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"priority": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"protocol": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"source_address_prefixes": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+
+								"source_port_ranges": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+
+								"destination_address_prefixes": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+
+								"destination_port_ranges": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+
+								"expanded_source_address_prefixes": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+
+								"expanded_destination_address_prefixes": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) ModelObject() interface{} {
+	return &NetworkInterfaceEffectiveSecurityRulesDataSourceModel{}
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) ResourceType() string {
+	return "azurerm_network_interface_effective_security_rules"
+}
+
+func (NetworkInterfaceEffectiveSecurityRulesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// the effective security rules are calculated on demand, which can take a few minutes
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			var state NetworkInterfaceEffectiveSecurityRulesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseNetworkInterfaceID(state.NetworkInterfaceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListEffectiveNetworkSecurityGroups(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("listing effective Network Security Groups for %s: %+v", id, err)
+			}
+
+			var result effectiveNetworkSecurityGroupListResult
+			if err := networkDiagnosticOperationResult(ctx, client.Client, resp.HttpResponse, resp.Poller, &result); err != nil {
+				return fmt.Errorf("retrieving effective Network Security Groups for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			state.NetworkSecurityGroups = flattenEffectiveNetworkSecurityGroups(result.Value)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+type effectiveNetworkSecurityGroupListResult struct {
+	Value *[]networkinterfaces.EffectiveNetworkSecurityGroup `json:"value,omitempty"`
+}
+
+func flattenEffectiveNetworkSecurityGroups(input *[]networkinterfaces.EffectiveNetworkSecurityGroup) []EffectiveNetworkSecurityGroupModel {
+	output := make([]EffectiveNetworkSecurityGroupModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		group := EffectiveNetworkSecurityGroupModel{
+			SecurityRules: flattenEffectiveNetworkSecurityRules(v.EffectiveSecurityRules),
+		}
+
+		if v.NetworkSecurityGroup != nil {
+			group.NetworkSecurityGroupId = pointer.From(v.NetworkSecurityGroup.Id)
+		}
+
+		if association := v.Association; association != nil {
+			if association.NetworkInterface != nil {
+				group.NetworkInterfaceId = pointer.From(association.NetworkInterface.Id)
+			}
+			if association.Subnet != nil {
+				group.SubnetId = pointer.From(association.Subnet.Id)
+			}
+		}
+
+		output = append(output, group)
+	}
+
+	return output
+}
+
+func flattenEffectiveNetworkSecurityRules(input *[]networkinterfaces.EffectiveNetworkSecurityRule) []EffectiveNetworkSecurityRuleModel {
+	output := make([]EffectiveNetworkSecurityRuleModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, EffectiveNetworkSecurityRuleModel{
+			Name:                               pointer.From(v.Name),
+			Access:                             string(pointer.From(v.Access)),
+			Direction:                          string(pointer.From(v.Direction)),
+			Priority:                           pointer.From(v.Priority),
+			Protocol:                           string(pointer.From(v.Protocol)),
+			SourceAddressPrefixes:              flattenEffectiveSecurityRuleValues(v.SourceAddressPrefix, v.SourceAddressPrefixes),
+			SourcePortRanges:                   flattenEffectiveSecurityRuleValues(v.SourcePortRange, v.SourcePortRanges),
+			DestinationAddressPrefixes:         flattenEffectiveSecurityRuleValues(v.DestinationAddressPrefix, v.DestinationAddressPrefixes),
+			DestinationPortRanges:              flattenEffectiveSecurityRuleValues(v.DestinationPortRange, v.DestinationPortRanges),
+			ExpandedSourceAddressPrefixes:      pointer.From(v.ExpandedSourceAddressPrefix),
+			ExpandedDestinationAddressPrefixes: pointer.From(v.ExpandedDestinationAddressPrefix),
+		})
+	}
+
+	return output
+}
+
+// the API returns either a single value or a list of values depending on how the rule was defined, so these are combined
+func flattenEffectiveSecurityRuleValues(single *string, multiple *[]string) []string {
+	output := make([]string, 0)
+	if single != nil && *single != "" {
+		output = append(output, *single)
+	}
+	if multiple != nil {
+		output = append(output, *multiple...)
+	}
+
+	return output
+}
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkwatchers"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = NetworkWatcherConnectivityCheckDataSource{}
+
+type NetworkWatcherConnectivityCheckDataSource struct{}
+
+type NetworkWatcherConnectivityCheckDataSourceModel struct {
+	NetworkWatcherId   string                              `tfschema:"network_watcher_id"`
+	Source             []ConnectivityCheckSourceModel      `tfschema:"source"`
+	Destination        []ConnectivityCheckDestinationModel `tfschema:"destination"`
+	Protocol           string                              `tfschema:"protocol"`
+	PreferredIPVersion string                              `tfschema:"preferred_ip_version"`
+	ConnectionStatus   string                              `tfschema:"connection_status"`
+	AvgLatencyInMs     int64                               `tfschema:"avg_latency_in_ms"`
+	MinLatencyInMs     int64                               `tfschema:"min_latency_in_ms"`
+	MaxLatencyInMs     int64                               `tfschema:"max_latency_in_ms"`
+	ProbesSent         int64                               `tfschema:"probes_sent"`
+	ProbesFailed       int64                               `tfschema:"probes_failed"`
+	Hops               []ConnectivityCheckHopModel         `tfschema:"hop"`
+}
+
+type ConnectivityCheckSourceModel struct {
+	ResourceId string `tfschema:"resource_id"`
+	Port       int64  `tfschema:"port"`
+}
+
+type ConnectivityCheckDestinationModel struct {
+	ResourceId string `tfschema:"resource_id"`
+	Address    string `tfschema:"address"`
+	Port       int64  `tfschema:"port"`
+}
+
+type ConnectivityCheckHopModel struct {
+	Id         string                        `tfschema:"id"`
+	Type       string                        `tfschema:"type"`
+	Address    string                        `tfschema:"address"`
+	ResourceId string                        `tfschema:"resource_id"`
+	NextHopIds []string                      `tfschema:"next_hop_ids"`
+	Issues     []ConnectivityCheckIssueModel `tfschema:"issue"`
+}
+
+type ConnectivityCheckIssueModel struct {
+	Origin   string `tfschema:"origin"`
+	Severity string `tfschema:"severity"`
+	Type     string `tfschema:"type"`
+}
+
+func (NetworkWatcherConnectivityCheckDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_watcher_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkwatchers.ValidateNetworkWatcherID,
+		},
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+
+					"port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+				},
+			},
+		},
+
+		"destination": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: azure.ValidateResourceID,
+						ExactlyOneOf: []string{"destination.0.resource_id", "destination.0.address"},
+					},
+
+					"address": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"destination.0.resource_id", "destination.0.address"},
+					},
+
+					"port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+				},
+			},
+		},
+
+		"protocol": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(networkwatchers.PossibleValuesForProtocol(), false),
+		},
+
+		"preferred_ip_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(networkwatchers.PossibleValuesForIPVersion(), false),
+		},
+	}
+}
+
+func (NetworkWatcherConnectivityCheckDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"connection_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"avg_latency_in_ms": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"min_latency_in_ms": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"max_latency_in_ms": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"probes_sent": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"probes_failed": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"hop": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"address": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"resource_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"next_hop_ids": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"issue": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"origin": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"severity": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"type": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (NetworkWatcherConnectivityCheckDataSource) ModelObject() interface{} {
+	return &NetworkWatcherConnectivityCheckDataSourceModel{}
+}
+
+func (NetworkWatcherConnectivityCheckDataSource) ResourceType() string {
+	return "azurerm_network_watcher_connectivity_check"
+}
+
+func (NetworkWatcherConnectivityCheckDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// the connectivity check sends a number of probes from the source, which can take a few minutes
+		Timeout: 15 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkWatchers
+
+			var state NetworkWatcherConnectivityCheckDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := networkwatchers.ParseNetworkWatcherID(state.NetworkWatcherId)
+			if err != nil {
+				return err
+			}
+
+			input := networkwatchers.ConnectivityParameters{
+				Source:      expandConnectivityCheckSource(state.Source),
+				Destination: expandConnectivityCheckDestination(state.Destination),
+			}
+			if state.Protocol != "" {
+				input.Protocol = pointer.To(networkwatchers.Protocol(state.Protocol))
+			}
+			if state.PreferredIPVersion != "" {
+				input.PreferredIPVersion = pointer.To(networkwatchers.IPVersion(state.PreferredIPVersion))
+			}
+
+			resp, err := client.CheckConnectivity(ctx, *id, input)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("checking connectivity using %s: %+v", id, err)
+			}
+
+			var result networkwatchers.ConnectivityInformation
+			if err := networkDiagnosticOperationResult(ctx, client.Client, resp.HttpResponse, resp.Poller, &result); err != nil {
+				return fmt.Errorf("retrieving the connectivity check result from %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			state.ConnectionStatus = string(pointer.From(result.ConnectionStatus))
+			state.AvgLatencyInMs = pointer.From(result.AvgLatencyInMs)
+			state.MinLatencyInMs = pointer.From(result.MinLatencyInMs)
+			state.MaxLatencyInMs = pointer.From(result.MaxLatencyInMs)
+			state.ProbesSent = pointer.From(result.ProbesSent)
+			state.ProbesFailed = pointer.From(result.ProbesFailed)
+			state.Hops = flattenConnectivityCheckHops(result.Hops)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func expandConnectivityCheckSource(input []ConnectivityCheckSourceModel) networkwatchers.ConnectivitySource {
+	output := networkwatchers.ConnectivitySource{}
+	if len(input) == 0 {
+		return output
+	}
+
+	output.ResourceId = input[0].ResourceId
+	if input[0].Port != 0 {
+		output.Port = pointer.To(input[0].Port)
+	}
+
+	return output
+}
+
+func expandConnectivityCheckDestination(input []ConnectivityCheckDestinationModel) networkwatchers.ConnectivityDestination {
+	output := networkwatchers.ConnectivityDestination{}
+	if len(input) == 0 {
+		return output
+	}
+
+	if v := input[0].ResourceId; v != "" {
+		output.ResourceId = pointer.To(v)
+	}
+	if v := input[0].Address; v != "" {
+		output.Address = pointer.To(v)
+	}
+	if v := input[0].Port; v != 0 {
+		output.Port = pointer.To(v)
+	}
+
+	return output
+}
+
+func flattenConnectivityCheckHops(input *[]networkwatchers.ConnectivityHop) []ConnectivityCheckHopModel {
+	output := make([]ConnectivityCheckHopModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		issues := make([]ConnectivityCheckIssueModel, 0)
+		if v.Issues != nil {
+			for _, issue := range *v.Issues {
+				issues = append(issues, ConnectivityCheckIssueModel{
+					Origin:   string(pointer.From(issue.Origin)),
+					Severity: string(pointer.From(issue.Severity)),
+					Type:     string(pointer.From(issue.Type)),
+				})
+			}
+		}
+
+		output = append(output, ConnectivityCheckHopModel{
+			Id:         pointer.From(v.Id),
+			Type:       pointer.From(v.Type),
+			Address:    pointer.From(v.Address),
+			ResourceId: pointer.From(v.ResourceId),
+			NextHopIds: pointer.From(v.NextHopIds),
+			Issues:     issues,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherConnectivityCheckDataSource struct{}
+
+func testAccDataSourceNetworkWatcherConnectivityCheck_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_connectivity_check", "test")
+	r := NetworkWatcherConnectivityCheckDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("connection_status").Exists(),
+				check.That(data.ResourceName).Key("probes_sent").Exists(),
+				check.That(data.ResourceName).Key("hop.#").Exists(),
+			),
+		},
+	})
+}
+
+func (r NetworkWatcherConnectivityCheckDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_extension" "test" {
+  name                       = "network-watcher"
+  virtual_machine_id         = azurerm_virtual_machine.test.id
+  publisher                  = "Microsoft.Azure.NetworkWatcher"
+  type                       = "NetworkWatcherAgentLinux"
+  type_handler_version       = "1.4"
+  auto_upgrade_minor_version = true
+}
+
+data "azurerm_network_watcher_connectivity_check" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+  protocol           = "Tcp"
+
+  source {
+    resource_id = azurerm_virtual_machine.test.id
+  }
+
+  destination {
+    address = "www.bing.com"
+    port    = 443
+  }
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, NetworkWatcherNextHopDataSource{}.template(data))
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkwatchers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = NetworkWatcherIPFlowVerifyDataSource{}
+
+type NetworkWatcherIPFlowVerifyDataSource struct{}
+
+type NetworkWatcherIPFlowVerifyDataSourceModel struct {
+	NetworkWatcherId   string `tfschema:"network_watcher_id"`
+	TargetResourceId   string `tfschema:"target_resource_id"`
+	NetworkInterfaceId string `tfschema:"network_interface_id"`
+	Direction          string `tfschema:"direction"`
+	Protocol           string `tfschema:"protocol"`
+	LocalIPAddress     string `tfschema:"local_ip_address"`
+	LocalPort          string `tfschema:"local_port"`
+	RemoteIPAddress    string `tfschema:"remote_ip_address"`
+	RemotePort         string `tfschema:"remote_port"`
+	Access             string `tfschema:"access"`
+	RuleName           string `tfschema:"rule_name"`
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_watcher_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkwatchers.ValidateNetworkWatcherID,
+		},
+
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateVirtualMachineID,
+		},
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(networkwatchers.PossibleValuesForDirection(), false),
+		},
+
+		"protocol": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(networkwatchers.PossibleValuesForIPFlowProtocol(), false),
+		},
+
+		"local_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"local_port": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validateNetworkWatcherIPFlowPort,
+		},
+
+		"remote_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"remote_port": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validateNetworkWatcherIPFlowPort,
+		},
+
+		"network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+	}
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"access": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"rule_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) ModelObject() interface{} {
+	return &NetworkWatcherIPFlowVerifyDataSourceModel{}
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) ResourceType() string {
+	return "azurerm_network_watcher_ip_flow_verify"
+}
+
+func (NetworkWatcherIPFlowVerifyDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkWatchers
+
+			var state NetworkWatcherIPFlowVerifyDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := networkwatchers.ParseNetworkWatcherID(state.NetworkWatcherId)
+			if err != nil {
+				return err
+			}
+
+			input := networkwatchers.VerificationIPFlowParameters{
+				Direction:        networkwatchers.Direction(state.Direction),
+				LocalIPAddress:   state.LocalIPAddress,
+				LocalPort:        state.LocalPort,
+				Protocol:         networkwatchers.IPFlowProtocol(state.Protocol),
+				RemoteIPAddress:  state.RemoteIPAddress,
+				RemotePort:       state.RemotePort,
+				TargetResourceId: state.TargetResourceId,
+			}
+			if state.NetworkInterfaceId != "" {
+				input.TargetNicResourceId = pointer.To(state.NetworkInterfaceId)
+			}
+
+			resp, err := client.VerifyIPFlow(ctx, *id, input)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("verifying IP flow using %s: %+v", id, err)
+			}
+
+			var result networkwatchers.VerificationIPFlowResult
+			if err := networkDiagnosticOperationResult(ctx, client.Client, resp.HttpResponse, resp.Poller, &result); err != nil {
+				return fmt.Errorf("retrieving the IP flow verification result from %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			state.Access = string(pointer.From(result.Access))
+			state.RuleName = pointer.From(result.RuleName)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func validateNetworkWatcherIPFlowPort(i interface{}, k string) (warnings []string, errors []error) {
+	return validation.StringMatch(regexp.MustCompile(`^(\*|[0-9]{1,5})$`), "must be a single port number or `*`")(i, k)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherIPFlowVerifyDataSource struct{}
+
+func testAccDataSourceNetworkWatcherIPFlowVerify_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_ip_flow_verify", "test")
+	r := NetworkWatcherIPFlowVerifyDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Deny"),
+				check.That(data.ResourceName).Key("rule_name").HasValue("securityRules/deny-ssh"),
+				check.That("data.azurerm_network_watcher_ip_flow_verify.allowed").Key("access").HasValue("Allow"),
+			),
+		},
+	})
+}
+
+func (r NetworkWatcherIPFlowVerifyDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_ip_flow_verify" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+  target_resource_id = azurerm_virtual_machine.test.id
+  direction          = "Inbound"
+  protocol           = "TCP"
+  local_ip_address   = "10.0.2.4"
+  local_port         = "22"
+  remote_ip_address  = "10.0.3.4"
+  remote_port        = "*"
+}
+
+data "azurerm_network_watcher_ip_flow_verify" "allowed" {
+  network_watcher_id   = azurerm_network_watcher.test.id
+  target_resource_id   = azurerm_virtual_machine.test.id
+  network_interface_id = azurerm_network_interface.test.id
+  direction            = "Inbound"
+  protocol             = "TCP"
+  local_ip_address     = "10.0.2.4"
+  local_port           = "22"
+  remote_ip_address    = "10.0.4.4"
+  remote_port          = "*"
+}
+`, NetworkWatcherNextHopDataSource{}.template(data))
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkwatchers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = NetworkWatcherNextHopDataSource{}
+
+type NetworkWatcherNextHopDataSource struct{}
+
+type NetworkWatcherNextHopDataSourceModel struct {
+	NetworkWatcherId     string `tfschema:"network_watcher_id"`
+	TargetResourceId     string `tfschema:"target_resource_id"`
+	NetworkInterfaceId   string `tfschema:"network_interface_id"`
+	SourceIPAddress      string `tfschema:"source_ip_address"`
+	DestinationIPAddress string `tfschema:"destination_ip_address"`
+	NextHopIPAddress     string `tfschema:"next_hop_ip_address"`
+	NextHopType          string `tfschema:"next_hop_type"`
+	RouteTableId         string `tfschema:"route_table_id"`
+}
+
+func (NetworkWatcherNextHopDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_watcher_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkwatchers.ValidateNetworkWatcherID,
+		},
+
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateVirtualMachineID,
+		},
+
+		"source_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"destination_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+	}
+}
+
+func (NetworkWatcherNextHopDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"next_hop_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"next_hop_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"route_table_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (NetworkWatcherNextHopDataSource) ModelObject() interface{} {
+	return &NetworkWatcherNextHopDataSourceModel{}
+}
+
+func (NetworkWatcherNextHopDataSource) ResourceType() string {
+	return "azurerm_network_watcher_next_hop"
+}
+
+func (NetworkWatcherNextHopDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkWatchers
+
+			var state NetworkWatcherNextHopDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := networkwatchers.ParseNetworkWatcherID(state.NetworkWatcherId)
+			if err != nil {
+				return err
+			}
+
+			input := networkwatchers.NextHopParameters{
+				DestinationIPAddress: state.DestinationIPAddress,
+				SourceIPAddress:      state.SourceIPAddress,
+				TargetResourceId:     state.TargetResourceId,
+			}
+			if state.NetworkInterfaceId != "" {
+				input.TargetNicResourceId = pointer.To(state.NetworkInterfaceId)
+			}
+
+			resp, err := client.GetNextHop(ctx, *id, input)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving the next hop using %s: %+v", id, err)
+			}
+
+			var result networkwatchers.NextHopResult
+			if err := networkDiagnosticOperationResult(ctx, client.Client, resp.HttpResponse, resp.Poller, &result); err != nil {
+				return fmt.Errorf("retrieving the next hop result from %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			state.NextHopIPAddress = pointer.From(result.NextHopIPAddress)
+			state.NextHopType = string(pointer.From(result.NextHopType))
+			state.RouteTableId = pointer.From(result.RouteTableId)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
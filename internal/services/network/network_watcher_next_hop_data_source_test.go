@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherNextHopDataSource struct{}
+
+func testAccDataSourceNetworkWatcherNextHop_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_next_hop", "test")
+	r := NetworkWatcherNextHopDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("next_hop_type").HasValue("VirtualAppliance"),
+				check.That(data.ResourceName).Key("next_hop_ip_address").HasValue("10.0.2.100"),
+				check.That(data.ResourceName).Key("route_table_id").Exists(),
+			),
+		},
+	})
+}
+
+func (r NetworkWatcherNextHopDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_next_hop" "test" {
+  network_watcher_id     = azurerm_network_watcher.test.id
+  target_resource_id     = azurerm_virtual_machine.test.id
+  source_ip_address      = "10.0.2.4"
+  destination_ip_address = "10.1.0.4"
+}
+`, r.template(data))
+}
+
+func (NetworkWatcherNextHopDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_watcher" "test" {
+  name                = "acctestnw-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, NetworkInterfaceEffectiveRoutesDataSource{}.template(data), data.RandomInteger)
+}
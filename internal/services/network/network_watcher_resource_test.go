@@ -38,6 +38,11 @@ func TestAccNetworkWatcher(t *testing.T) {
 		"DataSource": {
 			"basic": testAccDataSourceNetworkWatcher_basic,
 		},
+		"Diagnostics": {
+			"connectivityCheck": testAccDataSourceNetworkWatcherConnectivityCheck_basic,
+			"ipFlowVerify":      testAccDataSourceNetworkWatcherIPFlowVerify_basic,
+			"nextHop":           testAccDataSourceNetworkWatcherNextHop_basic,
+		},
 		"ConnectionMonitor": {
 			"addressBasic":                   testAccNetworkConnectionMonitor_addressBasic,
 			"addressComplete":                testAccNetworkConnectionMonitor_addressComplete,
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/privatelink"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = PrivateEndpointDnsZoneNamesDataSource{}
+
+type PrivateEndpointDnsZoneNamesDataSource struct{}
+
+type PrivateEndpointDnsZoneNamesDataSourceModel struct {
+	ResourceType                string   `tfschema:"resource_type"`
+	PrivateConnectionResourceId string   `tfschema:"private_connection_resource_id"`
+	SubresourceNames            []string `tfschema:"subresource_names"`
+	Location                    string   `tfschema:"location"`
+	DnsZoneNames                []string `tfschema:"dns_zone_names"`
+	Environment                 string   `tfschema:"environment"`
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"resource_type", "private_connection_resource_id"},
+		},
+
+		"private_connection_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateResourceID,
+			ExactlyOneOf: []string{"resource_type", "private_connection_resource_id"},
+		},
+
+		"subresource_names": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.PrivateLinkSubResourceName,
+			},
+		},
+
+		"location": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_zone_names": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"environment": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) ModelObject() interface{} {
+	return &PrivateEndpointDnsZoneNamesDataSourceModel{}
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) ResourceType() string {
+	return "azurerm_private_endpoint_dns_zone_names"
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state PrivateEndpointDnsZoneNamesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			cloud, err := privatelink.CloudForEnvironment(metadata.Client.Account.Environment.Name)
+			if err != nil {
+				return err
+			}
+
+			resourceType := state.ResourceType
+			if state.PrivateConnectionResourceId != "" {
+				resourceType, err = privatelink.ResourceTypeFromID(state.PrivateConnectionResourceId)
+				if err != nil {
+					return err
+				}
+			}
+
+			dnsZoneNames, err := privatelink.DnsZoneNames(cloud, resourceType, state.SubresourceNames, location.Normalize(state.Location))
+			if err != nil {
+				return err
+			}
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s-%s-%s", cloud, strings.ToLower(resourceType), strings.ToLower(strings.Join(state.SubresourceNames, ","))))
+
+			state.ResourceType = resourceType
+			state.DnsZoneNames = dnsZoneNames
+			state.Environment = string(cloud)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
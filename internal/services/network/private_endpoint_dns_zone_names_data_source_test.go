@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PrivateEndpointDnsZoneNamesDataSource struct{}
+
+func TestAccDataSourcePrivateEndpointDnsZoneNames_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_zone_names", "test")
+	r := PrivateEndpointDnsZoneNamesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("environment").HasValue("public"),
+				check.That(data.ResourceName).Key("dns_zone_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("dns_zone_names.0").HasValue("privatelink.blob.core.windows.net"),
+				check.That(data.ResourceName).Key("dns_zone_names.1").HasValue("privatelink.dfs.core.windows.net"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourcePrivateEndpointDnsZoneNames_privateConnectionResourceId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_zone_names", "test")
+	r := PrivateEndpointDnsZoneNamesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.privateConnectionResourceId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource_type").HasValue("Microsoft.ContainerRegistry/registries"),
+				check.That(data.ResourceName).Key("dns_zone_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("dns_zone_names.0").HasValue("privatelink.azurecr.io"),
+				check.That(data.ResourceName).Key("dns_zone_names.1").HasValue(fmt.Sprintf("%s.data.privatelink.azurecr.io", data.Locations.Primary)),
+			),
+		},
+	})
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_dns_zone_names" "test" {
+  resource_type     = "Microsoft.Storage/storageAccounts"
+  subresource_names = ["blob", "blob_secondary", "dfs"]
+}
+`
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) privateConnectionResourceId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_dns_zone_names" "test" {
+  private_connection_resource_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ContainerRegistry/registries/example"
+  subresource_names              = ["registry"]
+  location                       = "%s"
+}
+`, data.Locations.Primary)
+}
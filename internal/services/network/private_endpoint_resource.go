@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-03-01/redis"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2024-03-01/signalr"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/privatelink"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -115,11 +116,19 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 						},
 						"private_dns_zone_ids": {
 							Type:     pluginsdk.TypeList,
-							Required: true,
+							Optional: true,
+							Computed: true,
 							Elem: &pluginsdk.Schema{
 								Type:         pluginsdk.TypeString,
 								ValidateFunc: privatezones.ValidatePrivateDnsZoneID,
 							},
+							ExactlyOneOf: []string{"private_dns_zone_group.0.private_dns_zone_ids", "private_dns_zone_group.0.private_dns_zone_resource_group_id"},
+						},
+						"private_dns_zone_resource_group_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: commonids.ValidateResourceGroupID,
+							ExactlyOneOf: []string{"private_dns_zone_group.0.private_dns_zone_ids", "private_dns_zone_group.0.private_dns_zone_resource_group_id"},
 						},
 					},
 				},
@@ -315,7 +324,10 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_private_endpoint", id.ID())
 	}
 
-	privateDnsZoneGroup := d.Get("private_dns_zone_group").([]interface{})
+	privateDnsZoneGroup, err := expandPrivateEndpointPrivateDnsZoneGroup(d, meta.(*clients.Client).Account.Environment.Name)
+	if err != nil {
+		return fmt.Errorf("expanding `private_dns_zone_group`: %+v", err)
+	}
 
 	parameters := privateendpoints.PrivateEndpoint{
 		Location: pointer.To(location.Normalize(d.Get("location").(string))),
@@ -475,7 +487,10 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	applicationSecurityGroupAssociation := existing.Model.Properties.ApplicationSecurityGroups
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	privateDnsZoneGroup := d.Get("private_dns_zone_group").([]interface{})
+	privateDnsZoneGroup, err := expandPrivateEndpointPrivateDnsZoneGroup(d, meta.(*clients.Client).Account.Environment.Name)
+	if err != nil {
+		return fmt.Errorf("expanding `private_dns_zone_group`: %+v", err)
+	}
 	privateServiceConnections := d.Get("private_service_connection").([]interface{})
 	ipConfigurations := d.Get("ip_configuration").([]interface{})
 	subnetId := d.Get("subnet_id").(string)
@@ -558,6 +573,11 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 					idHasBeenChanged = true
 				}
 			}
+
+			// the Private DNS Zones are only known once they've been looked up, so the Private DNS Zone Group is recreated
+			if groupRaw["private_dns_zone_resource_group_id"].(string) != "" {
+				idHasBeenChanged = true
+			}
 		}
 
 		needToRemove := newDnsZoneName == ""
@@ -683,6 +703,9 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 					continue
 				}
 
+				// `private_dns_zone_resource_group_id` isn't returned by the API, so is retained from the config/state
+				flattened.DnsZoneGroup["private_dns_zone_resource_group_id"] = d.Get("private_dns_zone_group.0.private_dns_zone_resource_group_id").(string)
+
 				privateDnsZoneConfigs = append(privateDnsZoneConfigs, flattened.DnsZoneConfig...)
 				privateDnsZoneGroups = append(privateDnsZoneGroups, flattened.DnsZoneGroup)
 			}
@@ -935,6 +958,67 @@ func flattenPrivateLinkEndpointServiceConnection(serviceConnections *[]privateen
 	return results
 }
 
+// expandPrivateEndpointPrivateDnsZoneGroup returns the `private_dns_zone_group` block - when `private_dns_zone_resource_group_id`
+// is specified the IDs of the Private DNS Zones within that Resource Group are determined from the resource type and
+// subresources of the Private Service Connection, for the Cloud Environment `environment`
+func expandPrivateEndpointPrivateDnsZoneGroup(d *pluginsdk.ResourceData, environment string) ([]interface{}, error) {
+	input := d.Get("private_dns_zone_group").([]interface{})
+	if len(input) == 0 || input[0] == nil {
+		return input, nil
+	}
+
+	group := input[0].(map[string]interface{})
+	resourceGroupId := group["private_dns_zone_resource_group_id"].(string)
+	if resourceGroupId == "" {
+		return input, nil
+	}
+
+	id, err := commonids.ParseResourceGroupID(resourceGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	connections := d.Get("private_service_connection").([]interface{})
+	if len(connections) == 0 || connections[0] == nil {
+		return nil, fmt.Errorf("a `private_service_connection` block must be specified when using `private_dns_zone_resource_group_id`")
+	}
+	connection := connections[0].(map[string]interface{})
+
+	privateConnectionResourceId := connection["private_connection_resource_id"].(string)
+	if privateConnectionResourceId == "" {
+		return nil, fmt.Errorf("`private_dns_zone_resource_group_id` can only be used when the Private Endpoint is connected to a resource using `private_connection_resource_id`")
+	}
+
+	resourceType, err := privatelink.ResourceTypeFromID(privateConnectionResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	cloud, err := privatelink.CloudForEnvironment(environment)
+	if err != nil {
+		return nil, err
+	}
+
+	subresourceNames := *utils.ExpandStringSlice(connection["subresource_names"].([]interface{}))
+	dnsZoneNames, err := privatelink.DnsZoneNames(cloud, resourceType, subresourceNames, d.Get("location").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	privateDnsZoneIds := make([]interface{}, 0, len(dnsZoneNames))
+	for _, name := range dnsZoneNames {
+		privateDnsZoneIds = append(privateDnsZoneIds, privatezones.NewPrivateDnsZoneID(id.SubscriptionId, id.ResourceGroupName, name).ID())
+	}
+
+	output := make(map[string]interface{})
+	for k, v := range group {
+		output[k] = v
+	}
+	output["private_dns_zone_ids"] = privateDnsZoneIds
+
+	return []interface{}{output}, nil
+}
+
 func createPrivateDnsZoneGroupForPrivateEndpoint(ctx context.Context, client *privatednszonegroups.PrivateDnsZoneGroupsClient, id privateendpoints.PrivateEndpointId, inputRaw []interface{}) error {
 	if len(inputRaw) != 1 {
 		return fmt.Errorf("expected a single Private DNS Zone Groups but got %d", len(inputRaw))
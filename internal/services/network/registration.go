@@ -39,6 +39,13 @@ func (r Registration) DataSources() []sdk.DataSource {
 		ManagerConnectivityConfigurationDataSource{},
 		VPNServerConfigurationDataSource{},
 		VirtualNetworkPeeringDataSource{},
+		VirtualNetworkAvailablePrefixesDataSource{},
+		NetworkInterfaceEffectiveRoutesDataSource{},
+		NetworkInterfaceEffectiveSecurityRulesDataSource{},
+		NetworkWatcherConnectivityCheckDataSource{},
+		NetworkWatcherIPFlowVerifyDataSource{},
+		NetworkWatcherNextHopDataSource{},
+		PrivateEndpointDnsZoneNamesDataSource{},
 	}
 }
 
@@ -106,26 +113,32 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	resources := map[string]*pluginsdk.Resource{
-		"azurerm_application_gateway":                      resourceApplicationGateway(),
-		"azurerm_application_security_group":               resourceApplicationSecurityGroup(),
-		"azurerm_bastion_host":                             resourceBastionHost(),
-		"azurerm_express_route_circuit_connection":         resourceExpressRouteCircuitConnection(),
-		"azurerm_express_route_circuit_authorization":      resourceExpressRouteCircuitAuthorization(),
-		"azurerm_express_route_circuit_peering":            resourceExpressRouteCircuitPeering(),
-		"azurerm_express_route_circuit":                    resourceExpressRouteCircuit(),
-		"azurerm_express_route_connection":                 resourceExpressRouteConnection(),
-		"azurerm_express_route_gateway":                    resourceExpressRouteGateway(),
-		"azurerm_express_route_port_authorization":         resourceExpressRoutePortAuthorization(),
-		"azurerm_express_route_port":                       resourceArmExpressRoutePort(),
-		"azurerm_ip_group":                                 resourceIpGroup(),
-		"azurerm_ip_group_cidr":                            resourceIpGroupCidr(),
-		"azurerm_local_network_gateway":                    resourceLocalNetworkGateway(),
-		"azurerm_nat_gateway":                              resourceNatGateway(),
-		"azurerm_nat_gateway_public_ip_association":        resourceNATGatewayPublicIpAssociation(),
-		"azurerm_nat_gateway_public_ip_prefix_association": resourceNATGatewayPublicIpPrefixAssociation(),
-		"azurerm_network_connection_monitor":               resourceNetworkConnectionMonitor(),
-		"azurerm_network_ddos_protection_plan":             resourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                        resourceNetworkInterface(),
+		"azurerm_application_gateway":                       resourceApplicationGateway(),
+		"azurerm_application_gateway_backend_address_pool":  resourceApplicationGatewayBackendAddressPool(),
+		"azurerm_application_gateway_backend_http_settings": resourceApplicationGatewayBackendHTTPSettings(),
+		"azurerm_application_gateway_http_listener":         resourceApplicationGatewayHTTPListener(),
+		"azurerm_application_gateway_probe":                 resourceApplicationGatewayProbe(),
+		"azurerm_application_gateway_request_routing_rule":  resourceApplicationGatewayRequestRoutingRule(),
+		"azurerm_application_gateway_ssl_certificate":       resourceApplicationGatewaySslCertificate(),
+		"azurerm_application_security_group":                resourceApplicationSecurityGroup(),
+		"azurerm_bastion_host":                              resourceBastionHost(),
+		"azurerm_express_route_circuit_connection":          resourceExpressRouteCircuitConnection(),
+		"azurerm_express_route_circuit_authorization":       resourceExpressRouteCircuitAuthorization(),
+		"azurerm_express_route_circuit_peering":             resourceExpressRouteCircuitPeering(),
+		"azurerm_express_route_circuit":                     resourceExpressRouteCircuit(),
+		"azurerm_express_route_connection":                  resourceExpressRouteConnection(),
+		"azurerm_express_route_gateway":                     resourceExpressRouteGateway(),
+		"azurerm_express_route_port_authorization":          resourceExpressRoutePortAuthorization(),
+		"azurerm_express_route_port":                        resourceArmExpressRoutePort(),
+		"azurerm_ip_group":                                  resourceIpGroup(),
+		"azurerm_ip_group_cidr":                             resourceIpGroupCidr(),
+		"azurerm_local_network_gateway":                     resourceLocalNetworkGateway(),
+		"azurerm_nat_gateway":                               resourceNatGateway(),
+		"azurerm_nat_gateway_public_ip_association":         resourceNATGatewayPublicIpAssociation(),
+		"azurerm_nat_gateway_public_ip_prefix_association":  resourceNATGatewayPublicIpPrefixAssociation(),
+		"azurerm_network_connection_monitor":                resourceNetworkConnectionMonitor(),
+		"azurerm_network_ddos_protection_plan":              resourceNetworkDDoSProtectionPlan(),
+		"azurerm_network_interface":                         resourceNetworkInterface(),
 
 		"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 		"azurerm_network_interface_application_security_group_association":               resourceNetworkInterfaceApplicationSecurityGroupAssociation(),
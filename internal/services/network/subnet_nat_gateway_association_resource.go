@@ -71,12 +71,12 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(gatewayId.NatGatewayName, natGatewayResourceName)
-	defer locks.UnlockByName(gatewayId.NatGatewayName, natGatewayResourceName)
-	locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(subnetId.SubnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetId.SubnetName, SubnetResourceName)
+	lockRequests := []locks.Request{
+		locks.SharedByID(gatewayId.ID()),
+		locks.ExclusiveByID(subnetId.ID()),
+	}
+	locks.ByHierarchy(lockRequests...)
+	defer locks.UnlockByHierarchy(lockRequests...)
 
 	subnet, err := client.Get(ctx, *subnetId, subnets.DefaultGetOperationOptions())
 	if err != nil {
@@ -216,10 +216,12 @@ func resourceSubnetNatGatewayAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(gatewayId.NatGatewayName, natGatewayResourceName)
-	defer locks.UnlockByName(gatewayId.NatGatewayName, natGatewayResourceName)
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	lockRequests := []locks.Request{
+		locks.SharedByID(gatewayId.ID()),
+		locks.ExclusiveByID(id.ID()),
+	}
+	locks.ByHierarchy(lockRequests...)
+	defer locks.UnlockByHierarchy(lockRequests...)
 
 	subnet, err = client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
 	if err != nil {
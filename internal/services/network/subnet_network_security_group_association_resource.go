@@ -71,14 +71,12 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
-
-	locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
-
-	locks.ByName(subnetId.SubnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetId.SubnetName, SubnetResourceName)
+	lockRequests := []locks.Request{
+		locks.SharedByID(networkSecurityGroupId.ID()),
+		locks.ExclusiveByID(subnetId.ID()),
+	}
+	locks.ByHierarchy(lockRequests...)
+	defer locks.UnlockByHierarchy(lockRequests...)
 
 	subnet, err := client.Get(ctx, *subnetId, subnets.DefaultGetOperationOptions())
 	if err != nil {
@@ -223,14 +221,12 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
-
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-
-	locks.ByName(id.SubnetName, SubnetResourceName)
-	defer locks.UnlockByName(id.SubnetName, SubnetResourceName)
+	lockRequests := []locks.Request{
+		locks.SharedByID(networkSecurityGroupId.ID()),
+		locks.ExclusiveByID(id.ID()),
+	}
+	locks.ByHierarchy(lockRequests...)
+	defer locks.UnlockByHierarchy(lockRequests...)
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
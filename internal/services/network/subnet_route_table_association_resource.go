@@ -73,11 +73,12 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(routeTableId.RouteTableName, routeTableResourceName)
-	defer locks.UnlockByName(routeTableId.RouteTableName, routeTableResourceName)
-
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	lockRequests := []locks.Request{
+		locks.SharedByID(routeTableId.ID()),
+		locks.ExclusiveByID(id.ID()),
+	}
+	locks.ByHierarchy(lockRequests...)
+	defer locks.UnlockByHierarchy(lockRequests...)
 
 	subnet, err := client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
 	if err != nil {
@@ -222,11 +223,12 @@ func resourceSubnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.RouteTableName, routeTableResourceName)
-	defer locks.UnlockByName(parsedRouteTableId.RouteTableName, routeTableResourceName)
-
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	lockRequests := []locks.Request{
+		locks.SharedByID(parsedRouteTableId.ID()),
+		locks.ExclusiveByID(id.ID()),
+	}
+	locks.ByHierarchy(lockRequests...)
+	defer locks.UnlockByHierarchy(lockRequests...)
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, *id, subnets.DefaultGetOperationOptions())
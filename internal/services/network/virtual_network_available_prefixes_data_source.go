@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/cidr"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = VirtualNetworkAvailablePrefixesDataSource{}
+
+type VirtualNetworkAvailablePrefixesDataSource struct{}
+
+type VirtualNetworkAvailablePrefixesDataSourceModel struct {
+	VirtualNetworkId string   `tfschema:"virtual_network_id"`
+	PrefixLengths    []int64  `tfschema:"prefix_lengths"`
+	ReservedPrefixes []string `tfschema:"reserved_prefixes"`
+	AddressPrefixes  []string `tfschema:"address_prefixes"`
+	AddressSpace     []string `tfschema:"address_space"`
+	UsedPrefixes     []string `tfschema:"used_prefixes"`
+}
+
+func (VirtualNetworkAvailablePrefixesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateVirtualNetworkID,
+		},
+
+		"prefix_lengths": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeInt,
+				ValidateFunc: validation.IntBetween(0, 128),
+			},
+		},
+
+		"reserved_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.CIDRNetworkAddress,
+			},
+		},
+	}
+}
+
+func (VirtualNetworkAvailablePrefixesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"address_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"address_space": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"used_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (VirtualNetworkAvailablePrefixesDataSource) ModelObject() interface{} {
+	return &VirtualNetworkAvailablePrefixesDataSourceModel{}
+}
+
+func (VirtualNetworkAvailablePrefixesDataSource) ResourceType() string {
+	return "azurerm_virtual_network_available_prefixes"
+}
+
+func (VirtualNetworkAvailablePrefixesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualNetworks
+
+			var state VirtualNetworkAvailablePrefixesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseVirtualNetworkID(state.VirtualNetworkId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, virtualnetworks.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			addressSpace := make([]string, 0)
+			usedPrefixes := make([]string, 0)
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.AddressSpace != nil {
+						addressSpace = pointer.From(props.AddressSpace.AddressPrefixes)
+					}
+					usedPrefixes = flattenVirtualNetworkSubnetAddressPrefixes(props.Subnets)
+				}
+			}
+
+			addressSpacePrefixes, err := cidr.ParseAll(addressSpace)
+			if err != nil {
+				return fmt.Errorf("parsing the address space of %s: %+v", id, err)
+			}
+
+			// the network address is used since Azure normalises subnet prefixes, however this saves failing on an unexpected value
+			unavailable := make([]netip.Prefix, 0)
+			for _, v := range usedPrefixes {
+				prefix, err := netip.ParsePrefix(v)
+				if err != nil {
+					return fmt.Errorf("parsing the subnet address prefix %q within %s: %+v", v, id, err)
+				}
+				unavailable = append(unavailable, prefix.Masked())
+			}
+
+			reserved, err := cidr.ParseAll(state.ReservedPrefixes)
+			if err != nil {
+				return fmt.Errorf("parsing `reserved_prefixes`: %+v", err)
+			}
+			unavailable = append(unavailable, reserved...)
+
+			prefixLengths := make([]int, 0, len(state.PrefixLengths))
+			for _, v := range state.PrefixLengths {
+				prefixLengths = append(prefixLengths, int(v))
+			}
+
+			allocated, err := cidr.Allocate(addressSpacePrefixes, unavailable, prefixLengths)
+			if err != nil {
+				return fmt.Errorf("allocating prefixes within %s: %+v", id, err)
+			}
+
+			addressPrefixes := make([]string, 0, len(allocated))
+			for _, v := range allocated {
+				addressPrefixes = append(addressPrefixes, v.String())
+			}
+
+			metadata.SetID(id)
+
+			state.AddressPrefixes = addressPrefixes
+			state.AddressSpace = addressSpace
+			state.UsedPrefixes = usedPrefixes
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenVirtualNetworkSubnetAddressPrefixes(input *[]virtualnetworks.Subnet) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, subnet := range *input {
+		props := subnet.Properties
+		if props == nil {
+			continue
+		}
+
+		if props.AddressPrefixes != nil && len(*props.AddressPrefixes) > 0 {
+			output = append(output, *props.AddressPrefixes...)
+			continue
+		}
+
+		if props.AddressPrefix != nil && *props.AddressPrefix != "" {
+			output = append(output, *props.AddressPrefix)
+		}
+	}
+
+	return output
+}
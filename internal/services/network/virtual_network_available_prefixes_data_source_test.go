@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualNetworkAvailablePrefixesDataSource struct{}
+
+func TestAccDataSourceVirtualNetworkAvailablePrefixes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_available_prefixes", "test")
+	r := VirtualNetworkAvailablePrefixesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_space.#").HasValue("1"),
+				check.That(data.ResourceName).Key("used_prefixes.#").HasValue("2"),
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("3"),
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.3.0/24"),
+				check.That(data.ResourceName).Key("address_prefixes.1").HasValue("10.0.1.16/28"),
+				check.That(data.ResourceName).Key("address_prefixes.2").HasValue("10.0.1.128/26"),
+			),
+		},
+	})
+}
+
+func (r VirtualNetworkAvailablePrefixesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "first" {
+  name                 = "first"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/24"]
+}
+
+resource "azurerm_subnet" "second" {
+  name                 = "second"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/28"]
+}
+
+data "azurerm_virtual_network_available_prefixes" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  prefix_lengths     = [24, 28, 26]
+  reserved_prefixes  = ["10.0.2.0/24", "10.0.1.64/26"]
+
+  depends_on = [azurerm_subnet.first, azurerm_subnet.second]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
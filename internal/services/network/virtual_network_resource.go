@@ -335,14 +335,11 @@ func resourceVirtualNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return tf.ImportAsExistsError("azurerm_virtual_network", id.ID())
 	}
 
-	vnetProperties, routeTables, err := expandVirtualNetworkProperties(ctx, *client, id, d)
+	vnetProperties, err := expandVirtualNetworkProperties(ctx, *client, id, d)
 	if err != nil {
 		return err
 	}
 
-	locks.MultipleByName(routeTables, routeTableResourceName)
-	defer locks.UnlockMultipleByName(routeTables, routeTableResourceName)
-
 	vnet := virtualnetworks.VirtualNetwork{
 		Name:             pointer.To(id.VirtualNetworkName),
 		ExtendedLocation: expandEdgeZoneModel(d.Get("edge_zone").(string)),
@@ -355,23 +352,12 @@ func resourceVirtualNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		vnet.Properties.FlowTimeoutInMinutes = pointer.To(int64(v.(int)))
 	}
 
-	networkSecurityGroupNames := make([]string, 0)
-	for _, subnet := range *vnet.Properties.Subnets {
-		if subnet.Properties != nil && subnet.Properties.NetworkSecurityGroup != nil {
-			parsedNsgID, err := networksecuritygroups.ParseNetworkSecurityGroupID(*subnet.Properties.NetworkSecurityGroup.Id)
-			if err != nil {
-				return err
-			}
-
-			networkSecurityGroupName := parsedNsgID.NetworkSecurityGroupName
-			if !utils.SliceContainsValue(networkSecurityGroupNames, networkSecurityGroupName) {
-				networkSecurityGroupNames = append(networkSecurityGroupNames, networkSecurityGroupName)
-			}
-		}
+	lockRequests, err := virtualNetworkLockRequests(id, vnet.Properties.Subnets)
+	if err != nil {
+		return err
 	}
-
-	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
-	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
+	locks.ByHierarchy(lockRequests...)
+	defer locks.UnlockByHierarchy(lockRequests...)
 
 	if err := client.CreateOrUpdateThenPoll(ctx, id, vnet); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -564,14 +550,11 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	if d.HasChange("subnet") {
-		subnets, routeTables, err := expandVirtualNetworkSubnets(ctx, *client, d.Get("subnet").(*pluginsdk.Set).List(), *id)
+		subnets, err := expandVirtualNetworkSubnets(ctx, *client, d.Get("subnet").(*pluginsdk.Set).List(), *id)
 		if err != nil {
 			return fmt.Errorf("expanding `subnet`: %+v", err)
 		}
 		payload.Properties.Subnets = subnets
-
-		locks.MultipleByName(routeTables, routeTableResourceName)
-		defer locks.UnlockMultipleByName(routeTables, routeTableResourceName)
 	}
 
 	if d.HasChange("private_endpoint_vnet_policies") {
@@ -582,25 +565,16 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
-	networkSecurityGroupNames := make([]string, 0)
-	if payload.Properties != nil && payload.Properties.Subnets != nil {
-		for _, subnet := range *payload.Properties.Subnets {
-			if subnet.Properties != nil && subnet.Properties.NetworkSecurityGroup != nil && subnet.Properties.NetworkSecurityGroup.Id != nil {
-				parsedNsgID, err := networksecuritygroups.ParseNetworkSecurityGroupID(*subnet.Properties.NetworkSecurityGroup.Id)
-				if err != nil {
-					return err
-				}
-
-				networkSecurityGroupName := parsedNsgID.NetworkSecurityGroupName
-				if !utils.SliceContainsValue(networkSecurityGroupNames, networkSecurityGroupName) {
-					networkSecurityGroupNames = append(networkSecurityGroupNames, networkSecurityGroupName)
-				}
-			}
-		}
+	var subnetsToLock *[]virtualnetworks.Subnet
+	if payload.Properties != nil {
+		subnetsToLock = payload.Properties.Subnets
 	}
-
-	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
-	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
+	lockRequests, err := virtualNetworkLockRequests(*id, subnetsToLock)
+	if err != nil {
+		return err
+	}
+	locks.ByHierarchy(lockRequests...)
+	defer locks.UnlockByHierarchy(lockRequests...)
 
 	if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
@@ -632,16 +606,16 @@ func resourceVirtualNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	nsgNames, routeTableNames, err := expandResourcesForLocking(d)
+	subnets, err := expandVirtualNetworkSubnetsForLocking(d)
 	if err != nil {
-		return fmt.Errorf("parsing Network Security Group ID's: %+v", err)
+		return err
 	}
-
-	locks.MultipleByName(&nsgNames, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&nsgNames, VirtualNetworkResourceName)
-
-	locks.MultipleByName(&routeTableNames, routeTableResourceName)
-	defer locks.UnlockMultipleByName(&routeTableNames, routeTableResourceName)
+	lockRequests, err := virtualNetworkLockRequests(*id, subnets)
+	if err != nil {
+		return fmt.Errorf("parsing Network Security Group and Route Table ID's: %+v", err)
+	}
+	locks.ByHierarchy(lockRequests...)
+	defer locks.UnlockByHierarchy(lockRequests...)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -685,12 +659,11 @@ func expandVirtualNetworkEncryption(input []interface{}) *virtualnetworks.Virtua
 	}
 }
 
-func expandVirtualNetworkSubnets(ctx context.Context, client virtualnetworks.VirtualNetworksClient, input []interface{}, id commonids.VirtualNetworkId) (*[]virtualnetworks.Subnet, *[]string, error) {
+func expandVirtualNetworkSubnets(ctx context.Context, client virtualnetworks.VirtualNetworksClient, input []interface{}, id commonids.VirtualNetworkId) (*[]virtualnetworks.Subnet, error) {
 	subnets := make([]virtualnetworks.Subnet, 0)
-	routeTables := make([]string, 0)
 
 	if len(input) == 0 {
-		return &subnets, &routeTables, nil
+		return &subnets, nil
 	}
 
 	for _, subnetRaw := range input {
@@ -705,7 +678,7 @@ func expandVirtualNetworkSubnets(ctx context.Context, client virtualnetworks.Vir
 		// do a GET on subnet properties from the server before setting them
 		subnetObj, err := getExistingSubnet(ctx, client, id, name)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		log.Printf("[INFO] Completed GET of Subnet props")
 
@@ -741,11 +714,8 @@ func expandVirtualNetworkSubnets(ctx context.Context, client virtualnetworks.Vir
 		if routeTableId := subnet["route_table_id"].(string); routeTableId != "" {
 			id, err := routetables.ParseRouteTableID(routeTableId)
 			if err != nil {
-				return nil, nil, err
+				return nil, err
 			}
-
-			// Collecting a list of route tables to lock on outside of this function
-			routeTables = append(routeTables, id.RouteTableName)
 			subnetObj.Properties.RouteTable = &virtualnetworks.RouteTable{
 				Id: pointer.To(id.ID()),
 			}
@@ -767,12 +737,11 @@ func expandVirtualNetworkSubnets(ctx context.Context, client virtualnetworks.Vir
 		subnets = append(subnets, *subnetObj)
 	}
 
-	return &subnets, &routeTables, nil
+	return &subnets, nil
 }
 
-func expandVirtualNetworkProperties(ctx context.Context, client virtualnetworks.VirtualNetworksClient, id commonids.VirtualNetworkId, d *pluginsdk.ResourceData) (*virtualnetworks.VirtualNetworkPropertiesFormat, *[]string, error) {
+func expandVirtualNetworkProperties(ctx context.Context, client virtualnetworks.VirtualNetworksClient, id commonids.VirtualNetworkId, d *pluginsdk.ResourceData) (*virtualnetworks.VirtualNetworkPropertiesFormat, error) {
 	subnets := make([]virtualnetworks.Subnet, 0)
-	routeTables := make([]string, 0)
 	if subs := d.Get("subnet").(*pluginsdk.Set); subs.Len() > 0 {
 		for _, subnet := range subs.List() {
 			subnet := subnet.(map[string]interface{})
@@ -783,7 +752,7 @@ func expandVirtualNetworkProperties(ctx context.Context, client virtualnetworks.
 			// do a GET on subnet properties from the server before setting them
 			subnetObj, err := getExistingSubnet(ctx, client, id, name)
 			if err != nil {
-				return nil, nil, err
+				return nil, err
 			}
 			log.Printf("[INFO] Completed GET of Subnet props")
 
@@ -817,11 +786,8 @@ func expandVirtualNetworkProperties(ctx context.Context, client virtualnetworks.
 			if routeTableId := subnet["route_table_id"].(string); routeTableId != "" {
 				id, err := routetables.ParseRouteTableID(routeTableId)
 				if err != nil {
-					return nil, nil, err
+					return nil, err
 				}
-
-				// Collecting a list of route tables to lock on outside of this function
-				routeTables = append(routeTables, id.RouteTableName)
 				subnetObj.Properties.RouteTable = &virtualnetworks.RouteTable{
 					Id: pointer.To(id.ID()),
 				}
@@ -894,7 +860,7 @@ func expandVirtualNetworkProperties(ctx context.Context, client virtualnetworks.
 		properties.BgpCommunities = &virtualnetworks.VirtualNetworkBgpCommunities{VirtualNetworkCommunity: v.(string)}
 	}
 
-	return properties, &routeTables, nil
+	return properties, nil
 }
 
 func expandVirtualNetworkIPAddressPool(input []interface{}) *[]virtualnetworks.IPamPoolPrefixAllocation {
@@ -1082,46 +1048,74 @@ func getExistingSubnet(ctx context.Context, client virtualnetworks.VirtualNetwor
 	return pointer.To(virtualnetworks.Subnet{}), nil
 }
 
-func expandResourcesForLocking(d *pluginsdk.ResourceData) ([]string, []string, error) {
-	nsgNames := make([]string, 0)
-	routeTableNames := make([]string, 0)
+// expandVirtualNetworkSubnetsForLocking returns the Network Security Group and Route Table associated with each
+// inline `subnet`, which need to be locked when deleting the Virtual Network
+func expandVirtualNetworkSubnetsForLocking(d *pluginsdk.ResourceData) (*[]virtualnetworks.Subnet, error) {
+	subnets := make([]virtualnetworks.Subnet, 0)
 
 	if v, ok := d.GetOk("subnet"); ok {
-		subnets := v.(*pluginsdk.Set).List()
-		for _, subnet := range subnets {
-			subnet, ok := subnet.(map[string]interface{})
+		for _, subnetRaw := range v.(*pluginsdk.Set).List() {
+			subnet, ok := subnetRaw.(map[string]interface{})
 			if !ok {
-				return nil, nil, fmt.Errorf("[ERROR] Subnet should be a Hash - was '%+v'", subnet)
+				return nil, fmt.Errorf("[ERROR] Subnet should be a Hash - was '%+v'", subnetRaw)
 			}
 
-			networkSecurityGroupId := subnet["security_group"].(string)
-			if networkSecurityGroupId != "" {
-				parsedNsgID, err := networksecuritygroups.ParseNetworkSecurityGroupID(networkSecurityGroupId)
-				if err != nil {
-					return nil, nil, err
+			props := virtualnetworks.SubnetPropertiesFormat{}
+			if networkSecurityGroupId := subnet["security_group"].(string); networkSecurityGroupId != "" {
+				props.NetworkSecurityGroup = &virtualnetworks.NetworkSecurityGroup{
+					Id: pointer.To(networkSecurityGroupId),
 				}
-
-				networkSecurityGroupName := parsedNsgID.NetworkSecurityGroupName
-				if !utils.SliceContainsValue(nsgNames, networkSecurityGroupName) {
-					nsgNames = append(nsgNames, networkSecurityGroupName)
+			}
+			if routeTableId := subnet["route_table_id"].(string); routeTableId != "" {
+				props.RouteTable = &virtualnetworks.RouteTable{
+					Id: pointer.To(routeTableId),
 				}
 			}
 
-			routeTableId := subnet["route_table_id"].(string)
-			if routeTableId != "" {
-				parsedRouteTableID, err := routetables.ParseRouteTableID(routeTableId)
-				if err != nil {
-					return nil, nil, err
-				}
-				routeTableName := parsedRouteTableID.RouteTableName
-				if !utils.SliceContainsValue(routeTableNames, routeTableName) {
-					routeTableNames = append(routeTableNames, routeTableName)
-				}
+			subnets = append(subnets, virtualnetworks.Subnet{
+				Properties: &props,
+			})
+		}
+	}
+
+	return &subnets, nil
+}
+
+// virtualNetworkLockRequests returns the locks required to create, update or delete the Virtual Network `id` - which
+// is an exclusive lock on the Virtual Network itself (and so all of its Subnets) and a shared lock on any Network
+// Security Groups and Route Tables associated with the inline `subnet` blocks
+func virtualNetworkLockRequests(id commonids.VirtualNetworkId, subnets *[]virtualnetworks.Subnet) ([]locks.Request, error) {
+	requests := []locks.Request{
+		locks.ExclusiveByID(id.ID()),
+	}
+
+	if subnets == nil {
+		return requests, nil
+	}
+
+	for _, subnet := range *subnets {
+		if subnet.Properties == nil {
+			continue
+		}
+
+		if nsg := subnet.Properties.NetworkSecurityGroup; nsg != nil && nsg.Id != nil {
+			nsgId, err := networksecuritygroups.ParseNetworkSecurityGroupIDInsensitively(*nsg.Id)
+			if err != nil {
+				return nil, err
+			}
+			requests = append(requests, locks.SharedByID(nsgId.ID()))
+		}
+
+		if routeTable := subnet.Properties.RouteTable; routeTable != nil && routeTable.Id != nil {
+			routeTableId, err := routetables.ParseRouteTableIDInsensitively(*routeTable.Id)
+			if err != nil {
+				return nil, err
 			}
+			requests = append(requests, locks.SharedByID(routeTableId.ID()))
 		}
 	}
 
-	return nsgNames, routeTableNames, nil
+	return requests, nil
 }
 
 func expandVirtualNetworkSubnetServiceEndpointPolicies(input []interface{}) *[]virtualnetworks.ServiceEndpointPolicy {
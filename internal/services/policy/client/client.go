@@ -9,12 +9,14 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/guestconfiguration/2020-06-25/guestconfigurationassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/policyinsights/2021-10-01/remediations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/policyinsights/2024-10-01/checkpolicyrestrictions"
 	assignments "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
 	AssignmentsClient                   *assignments.PolicyAssignmentsClient
+	CheckPolicyRestrictionsClient       *checkpolicyrestrictions.CheckPolicyRestrictionsClient
 	DefinitionsClient                   *policy.DefinitionsClient
 	ExemptionsClient                    *policy.ExemptionsClient
 	GuestConfigurationAssignmentsClient *guestconfigurationassignments.GuestConfigurationAssignmentsClient
@@ -29,6 +31,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(assignmentsClient.Client, o.Authorizers.ResourceManager)
 
+	checkPolicyRestrictionsClient, err := checkpolicyrestrictions.NewCheckPolicyRestrictionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building CheckPolicyRestrictions client: %+v", err)
+	}
+	o.Configure(checkPolicyRestrictionsClient.Client, o.Authorizers.ResourceManager)
+
 	definitionsClient := policy.NewDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&definitionsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -52,6 +60,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 
 	return &Client{
 		AssignmentsClient:                   assignmentsClient,
+		CheckPolicyRestrictionsClient:       checkPolicyRestrictionsClient,
 		DefinitionsClient:                   &definitionsClient,
 		ExemptionsClient:                    &exemptionsClient,
 		GuestConfigurationAssignmentsClient: guestConfigurationAssignmentsClient,
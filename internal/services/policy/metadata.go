@@ -4,37 +4,13 @@
 package policy
 
 import (
-	"encoding/json"
-	"reflect"
-
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-func metadataDiffSuppressFunc(_, old, new string, _ *pluginsdk.ResourceData) bool {
-	var oldPolicyAssignmentsMetadata map[string]interface{}
-	errOld := json.Unmarshal([]byte(old), &oldPolicyAssignmentsMetadata)
-	if errOld != nil {
-		return false
-	}
-
-	var newPolicyAssignmentsMetadata map[string]interface{}
-	if new != "" {
-		errNew := json.Unmarshal([]byte(new), &newPolicyAssignmentsMetadata)
-		if errNew != nil {
-			return false
-		}
-	}
-
-	// Ignore the following keys if they're found in the metadata JSON
-	ignoreKeys := [5]string{"assignedBy", "createdBy", "createdOn", "updatedBy", "updatedOn"}
-	for _, key := range ignoreKeys {
-		delete(oldPolicyAssignmentsMetadata, key)
-		delete(newPolicyAssignmentsMetadata, key)
-	}
-
-	return reflect.DeepEqual(oldPolicyAssignmentsMetadata, newPolicyAssignmentsMetadata)
-}
+// metadataIgnoredPaths are the fields which are added to the metadata by ARM and so shouldn't be diffed
+var metadataIgnoredPaths = []string{"assignedBy", "createdBy", "createdOn", "updatedBy", "updatedOn"}
 
 func metadataSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
@@ -42,6 +18,6 @@ func metadataSchema() *pluginsdk.Schema {
 		Optional:         true,
 		Computed:         true,
 		ValidateFunc:     validation.StringIsJSON,
-		DiffSuppressFunc: metadataDiffSuppressFunc,
+		DiffSuppressFunc: suppress.JsonDiffIgnoringPaths(metadataIgnoredPaths...),
 	}
 }
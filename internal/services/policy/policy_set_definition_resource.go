@@ -9,7 +9,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"reflect"
 	"strconv"
 	"time"
 
@@ -22,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -93,14 +93,14 @@ func resourcePolicySetDefinitionSchema() map[string]*pluginsdk.Schema {
 			Optional:         true,
 			Computed:         true,
 			ValidateFunc:     validation.StringIsJSON,
-			DiffSuppressFunc: policySetDefinitionsMetadataDiffSuppressFunc,
+			DiffSuppressFunc: suppress.JsonDiffIgnoringPaths("createdBy", "createdOn", "updatedBy", "updatedOn"),
 		},
 
 		"parameters": {
 			Type:             pluginsdk.TypeString,
 			Optional:         true,
 			ValidateFunc:     validation.StringIsJSON,
-			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			DiffSuppressFunc: suppress.JsonDiff,
 		},
 
 		// lintignore: S013
@@ -119,7 +119,7 @@ func resourcePolicySetDefinitionSchema() map[string]*pluginsdk.Schema {
 						Type:             pluginsdk.TypeString,
 						Optional:         true,
 						ValidateFunc:     validation.StringIsJSON,
-						DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+						DiffSuppressFunc: suppress.JsonDiff,
 					},
 
 					"reference_id": {
@@ -181,29 +181,6 @@ func resourcePolicySetDefinitionSchema() map[string]*pluginsdk.Schema {
 	}
 }
 
-func policySetDefinitionsMetadataDiffSuppressFunc(_, old, new string, _ *pluginsdk.ResourceData) bool {
-	var oldPolicySetDefinitionsMetadata map[string]interface{}
-	errOld := json.Unmarshal([]byte(old), &oldPolicySetDefinitionsMetadata)
-	if errOld != nil {
-		return false
-	}
-
-	var newPolicySetDefinitionsMetadata map[string]interface{}
-	errNew := json.Unmarshal([]byte(new), &newPolicySetDefinitionsMetadata)
-	if errNew != nil {
-		return false
-	}
-
-	// Ignore the following keys if they're found in the metadata JSON
-	ignoreKeys := [4]string{"createdBy", "createdOn", "updatedBy", "updatedOn"}
-	for _, key := range ignoreKeys {
-		delete(oldPolicySetDefinitionsMetadata, key)
-		delete(newPolicySetDefinitionsMetadata, key)
-	}
-
-	return reflect.DeepEqual(oldPolicySetDefinitionsMetadata, newPolicySetDefinitionsMetadata)
-}
-
 type DefinitionReferenceInOldApiVersion struct {
 	// PolicyDefinitionID - The ID of the policy definition or policy set definition.
 	PolicyDefinitionID *string `json:"policyDefinitionId,omitempty"`
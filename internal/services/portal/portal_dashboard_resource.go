@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/portal/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -57,10 +58,11 @@ func resourcePortalDashboard() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 
 			"dashboard_properties": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validate.DashboardProperties,
-				StateFunc:    utils.NormalizeJson,
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validate.DashboardProperties,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppress.JsonDiff,
 			},
 		},
 	}
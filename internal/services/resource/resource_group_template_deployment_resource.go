@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -71,7 +72,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 					"template_content",
 					"template_spec_version_id",
 				},
-				StateFunc: utils.NormalizeJson,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppress.JsonDiff,
 			},
 
 			"template_spec_version_id": {
@@ -92,10 +94,11 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			},
 
 			"parameters_content": {
-				Type:      pluginsdk.TypeString,
-				Optional:  true,
-				Computed:  true,
-				StateFunc: utils.NormalizeJson,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: suppress.JsonDiff,
 			},
 
 			"tags": tags.Schema(),
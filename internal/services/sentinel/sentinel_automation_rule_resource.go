@@ -82,12 +82,9 @@ func resourceSentinelAutomationRule() *pluginsdk.Resource {
 		"condition_json": {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
-			// We can't use the pluginsdk.SuppressJsonDiff here as the "condition_json" is always an array, while that function assume its input is an object.
-			// Once https://github.com/hashicorp/terraform-plugin-sdk/pull/1102 is merged, we can switch to pluginsdk.SuppressJsonDiff.
-			DiffSuppressFunc: func(_, old, new string, _ *pluginsdk.ResourceData) bool {
-				return utils.NormalizeJson(old) == utils.NormalizeJson(new)
-			},
-			ValidateFunc: validation.StringIsJSON,
+			// NOTE: `condition_json` is always an array, which `suppress.JsonDiff` supports unlike `pluginsdk.SuppressJsonDiff`
+			DiffSuppressFunc: suppress.JsonDiff,
+			ValidateFunc:     validation.StringIsJSON,
 		},
 
 		"action_incident": {
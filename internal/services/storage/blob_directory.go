@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+const blobDirectoryDefaultContentType = "application/octet-stream"
+
+// BlobDirectorySync synchronises the files within a local directory into a prefix within a Storage Container
+type BlobDirectorySync struct {
+	BlobsClient      *blobs.Client
+	ContainersClient shim.StorageContainerWrapper
+
+	AccountName   string
+	ContainerName string
+	Prefix        string
+
+	SourceDirectory  string
+	IncludePatterns  []string
+	ExcludePatterns  []string
+	ContentTypes     map[string]string
+	CacheControl     string
+	DeleteExtraneous bool
+	Parallelism      int
+}
+
+type blobDirectoryEntry struct {
+	// Name is the path of the file relative to the Source Directory, using forward slashes
+	Name         string
+	CacheControl string
+	ContentMD5   string
+	ContentType  string
+
+	// Source is the path to the file on disk, this is only set for local entries
+	Source string
+}
+
+// matches returns whether the Blob properties tracked within the manifest are the same for both entries
+func (e blobDirectoryEntry) matches(other blobDirectoryEntry) bool {
+	return e.Name == other.Name && e.CacheControl == other.CacheControl && e.ContentMD5 == other.ContentMD5 && e.ContentType == other.ContentType
+}
+
+type blobDirectoryManifest map[string]blobDirectoryEntry
+
+// Hash returns a SHA256 hash of the entries within the manifest, which is independent of the order they were found in
+func (m blobDirectoryManifest) Hash() string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		entry := m[name]
+		fmt.Fprintf(hash, "%s\x00%s\x00%s\x00%s\n", entry.Name, entry.ContentMD5, entry.ContentType, entry.CacheControl)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// blobNamePrefix returns the prefix applied to the name of each Blob
+func (s BlobDirectorySync) blobNamePrefix() string {
+	if s.Prefix == "" {
+		return ""
+	}
+	return s.Prefix + "/"
+}
+
+// LocalManifest walks the Source Directory and returns the files matching the include/exclude patterns, when
+// `withContents` is set the MD5 of each file is also calculated
+func (s BlobDirectorySync) LocalManifest(withContents bool) (blobDirectoryManifest, error) {
+	manifest := make(blobDirectoryManifest)
+
+	err := filepath.WalkDir(s.SourceDirectory, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(s.SourceDirectory, filePath)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(relativePath)
+		if !s.matches(name) {
+			return nil
+		}
+
+		item := blobDirectoryEntry{
+			Name:         name,
+			CacheControl: s.CacheControl,
+			ContentType:  s.contentTypeFor(name),
+			Source:       filePath,
+		}
+		if withContents {
+			contentMD5, err := blobDirectoryFileMD5(filePath)
+			if err != nil {
+				return fmt.Errorf("calculating the MD5 of %q: %+v", filePath, err)
+			}
+			item.ContentMD5 = contentMD5
+		}
+		manifest[name] = item
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking the directory %q: %+v", s.SourceDirectory, err)
+	}
+
+	return manifest, nil
+}
+
+// RemoteManifest returns the Blobs within the Prefix matching the include/exclude patterns
+func (s BlobDirectorySync) RemoteManifest(ctx context.Context) (blobDirectoryManifest, error) {
+	prefix := s.blobNamePrefix()
+	items, err := s.ContainersClient.ListBlobs(ctx, s.ContainerName, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("listing Blobs within Container %q with the prefix %q: %+v", s.ContainerName, prefix, err)
+	}
+
+	manifest := make(blobDirectoryManifest)
+	for _, blob := range *items {
+		name := strings.TrimPrefix(blob.Name, prefix)
+		if name == "" || !s.matches(name) {
+			continue
+		}
+
+		// Azure returns a Base64 encoded representation of the MD5 sum, whereas the manifest uses hex
+		contentMD5 := ""
+		if blob.ContentMD5 != "" {
+			if contentMD5, err = convertBase64ToHexEncoding(blob.ContentMD5); err != nil {
+				return nil, err
+			}
+		}
+
+		manifest[name] = blobDirectoryEntry{
+			Name:         name,
+			CacheControl: blob.CacheControl,
+			ContentMD5:   contentMD5,
+			ContentType:  blob.ContentType,
+		}
+	}
+
+	return manifest, nil
+}
+
+// Sync uploads any new or changed files into the Container and, when enabled, removes any extraneous Blobs -
+// returning the manifest of the local files which now exist within the Container
+func (s BlobDirectorySync) Sync(ctx context.Context) (blobDirectoryManifest, error) {
+	local, err := s.LocalManifest(true)
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := s.RemoteManifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uploads := make([]blobDirectoryEntry, 0)
+	for name, entry := range local {
+		if existing, ok := remote[name]; ok && existing.matches(entry) {
+			continue
+		}
+		uploads = append(uploads, entry)
+	}
+
+	deletions := make([]string, 0)
+	if s.DeleteExtraneous {
+		for name := range remote {
+			if _, ok := local[name]; !ok {
+				deletions = append(deletions, name)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Uploading %d and deleting %d Blob(s) within Container %q with the prefix %q..", len(uploads), len(deletions), s.ContainerName, s.blobNamePrefix())
+	if err := s.forEach(len(uploads), func(i int) error {
+		return s.upload(ctx, uploads[i])
+	}); err != nil {
+		return nil, err
+	}
+	if err := s.forEach(len(deletions), func(i int) error {
+		return s.delete(ctx, deletions[i])
+	}); err != nil {
+		return nil, err
+	}
+
+	return local, nil
+}
+
+// Delete removes the Blobs synchronised from the Source Directory - when extraneous Blobs are deleted all Blobs
+// within the Prefix matching the include/exclude patterns are removed, otherwise only those for the local files are
+func (s BlobDirectorySync) Delete(ctx context.Context) error {
+	remote, err := s.RemoteManifest(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !s.DeleteExtraneous {
+		local, err := s.LocalManifest(false)
+		if err != nil {
+			// without the local files it's not possible to determine which Blobs were synchronised, so none are removed
+			log.Printf("[WARN] Unable to read the local files, no Blobs within Container %q with the prefix %q will be deleted: %+v", s.ContainerName, s.blobNamePrefix(), err)
+			return nil
+		}
+
+		for name := range remote {
+			if _, ok := local[name]; !ok {
+				delete(remote, name)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(remote))
+	for name := range remote {
+		names = append(names, name)
+	}
+
+	log.Printf("[DEBUG] Deleting %d Blob(s) within Container %q with the prefix %q..", len(names), s.ContainerName, s.blobNamePrefix())
+	return s.forEach(len(names), func(i int) error {
+		return s.delete(ctx, names[i])
+	})
+}
+
+func (s BlobDirectorySync) upload(ctx context.Context, entry blobDirectoryEntry) error {
+	contentMD5, err := convertHexToBase64Encoding(entry.ContentMD5)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(entry.Source)
+	if err != nil {
+		return fmt.Errorf("retrieving information for %q: %+v", entry.Source, err)
+	}
+
+	name := s.blobNamePrefix() + entry.Name
+	input := BlobUpload{
+		Client:        s.BlobsClient,
+		AccountName:   s.AccountName,
+		BlobName:      name,
+		ContainerName: s.ContainerName,
+
+		BlobType:     "block",
+		CacheControl: entry.CacheControl,
+		ContentType:  entry.ContentType,
+	}
+
+	// empty files can't be uploaded with any content, so the properties are set once the empty Blob exists
+	if info.Size() == 0 {
+		if err := input.Create(ctx); err != nil {
+			return fmt.Errorf("creating the empty Blob %q: %+v", name, err)
+		}
+
+		props := blobs.SetPropertiesInput{
+			CacheControl: pointer.To(entry.CacheControl),
+			ContentMD5:   pointer.To(contentMD5),
+			ContentType:  pointer.To(entry.ContentType),
+		}
+		if _, err := s.BlobsClient.SetProperties(ctx, s.ContainerName, name, props); err != nil {
+			return fmt.Errorf("setting the Properties for Blob %q: %+v", name, err)
+		}
+
+		return nil
+	}
+
+	input.ContentMD5 = contentMD5
+	input.Source = entry.Source
+	if err := input.Create(ctx); err != nil {
+		return fmt.Errorf("uploading %q to Blob %q: %+v", entry.Source, name, err)
+	}
+
+	return nil
+}
+
+func (s BlobDirectorySync) delete(ctx context.Context, name string) error {
+	blobName := s.blobNamePrefix() + name
+	input := blobs.DeleteInput{
+		DeleteSnapshots: true,
+	}
+	if resp, err := s.BlobsClient.Delete(ctx, s.ContainerName, blobName, input); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("deleting Blob %q: %+v", blobName, err)
+	}
+
+	return nil
+}
+
+// forEach runs `f` for each index up to `count` using at most `Parallelism` workers, returning the first error
+func (s BlobDirectorySync) forEach(count int, f func(i int) error) error {
+	workerCount := s.Parallelism
+	if workerCount < 1 {
+		workerCount = 1
+	}
+
+	indexes := make(chan int, count)
+	for i := 0; i < count; i++ {
+		indexes <- i
+	}
+	close(indexes)
+
+	errors := make(chan error, count)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				if err := f(index); err != nil {
+					errors <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return <-errors
+	}
+
+	return nil
+}
+
+// matches returns whether the relative `name` is included by the include/exclude patterns
+func (s BlobDirectorySync) matches(name string) bool {
+	if len(s.IncludePatterns) > 0 && !blobDirectoryPatternsMatch(s.IncludePatterns, name) {
+		return false
+	}
+
+	return !blobDirectoryPatternsMatch(s.ExcludePatterns, name)
+}
+
+// contentTypeFor returns the Content Type for the file `name`, using any explicitly configured Content Type for
+// the file extension before falling back to the well-known Content Type for the extension
+func (s BlobDirectorySync) contentTypeFor(name string) string {
+	extension := strings.ToLower(path.Ext(name))
+	if extension == "" {
+		return blobDirectoryDefaultContentType
+	}
+
+	for k, v := range s.ContentTypes {
+		if strings.EqualFold(strings.TrimPrefix(k, "."), strings.TrimPrefix(extension, ".")) {
+			return v
+		}
+	}
+
+	if v := mime.TypeByExtension(extension); v != "" {
+		return v
+	}
+
+	return blobDirectoryDefaultContentType
+}
+
+func blobDirectoryPatternsMatch(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if blobDirectoryPatternMatches(pattern, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// blobDirectoryPatternMatches matches the slash separated `name` against `pattern` - patterns without a slash are
+// matched against the file name alone, and a `**` segment matches any number of directories
+func blobDirectoryPatternMatches(pattern, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		matched, _ := path.Match(pattern, path.Base(name))
+		return matched
+	}
+
+	return blobDirectorySegmentsMatch(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func blobDirectorySegmentsMatch(patterns, segments []string) bool {
+	if len(patterns) == 0 {
+		return len(segments) == 0
+	}
+
+	if patterns[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if blobDirectorySegmentsMatch(patterns[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	if matched, _ := path.Match(patterns[0], segments[0]); !matched {
+		return false
+	}
+
+	return blobDirectorySegmentsMatch(patterns[1:], segments[1:])
+}
+
+func validateBlobDirectoryPattern(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+		return
+	}
+
+	for _, segment := range strings.Split(v, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid pattern %q: %+v", k, v, err))
+			return
+		}
+	}
+
+	return
+}
+
+func blobDirectoryFileMD5(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+)
+
+// fakeBlobContainer is a minimal in-memory stand-in for the Blob Storage API (in the style of Azurite), supporting
+// only the operations required to synchronise a directory
+type fakeBlobContainer struct {
+	mu      sync.Mutex
+	name    string
+	blobs   map[string]fakeBlob
+	uploads int
+}
+
+type fakeBlob struct {
+	CacheControl string
+	Content      []byte
+	ContentMD5   string
+	ContentType  string
+}
+
+type fakeBlobListResult struct {
+	XMLName    xml.Name           `xml:"EnumerationResults"`
+	NextMarker string             `xml:"NextMarker,omitempty"`
+	Blobs      []fakeBlobListItem `xml:"Blobs>Blob"`
+}
+
+type fakeBlobListItem struct {
+	Name         string `xml:"Name"`
+	CacheControl string `xml:"Properties>Cache-Control"`
+	ContentMD5   string `xml:"Properties>Content-MD5"`
+	ContentType  string `xml:"Properties>Content-Type"`
+}
+
+func (f *fakeBlobContainer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if path == f.name && r.Method == http.MethodGet && r.URL.Query().Get("comp") == "list" {
+		prefix := r.URL.Query().Get("prefix")
+		names := make([]string, 0)
+		for name := range f.blobs {
+			if strings.HasPrefix(name, prefix) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		// return a single Blob per page to exercise the pagination
+		result := fakeBlobListResult{}
+		marker := r.URL.Query().Get("marker")
+		for i, name := range names {
+			if name < marker {
+				continue
+			}
+			blob := f.blobs[name]
+			result.Blobs = append(result.Blobs, fakeBlobListItem{
+				Name:         name,
+				CacheControl: blob.CacheControl,
+				ContentMD5:   blob.ContentMD5,
+				ContentType:  blob.ContentType,
+			})
+			if i+1 < len(names) {
+				result.NextMarker = names[i+1]
+			}
+			break
+		}
+
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusOK)
+		_ = xml.NewEncoder(w).Encode(result)
+		return
+	}
+
+	name := strings.TrimPrefix(path, f.name+"/")
+	switch r.Method {
+	case http.MethodPut:
+		if r.URL.Query().Get("comp") == "properties" {
+			blob, ok := f.blobs[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			blob.CacheControl = r.Header.Get("x-ms-blob-cache-control")
+			blob.ContentMD5 = r.Header.Get("x-ms-blob-content-md5")
+			blob.ContentType = r.Header.Get("x-ms-blob-content-type")
+			f.blobs[name] = blob
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// like the Blob Storage API, the MD5 is only calculated when there's content to upload
+		contentMD5 := ""
+		if len(content) > 0 {
+			hash := md5.Sum(content)
+			contentMD5 = base64.StdEncoding.EncodeToString(hash[:])
+			if v := r.Header.Get("x-ms-blob-content-md5"); v != "" && v != contentMD5 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+
+		f.blobs[name] = fakeBlob{
+			CacheControl: r.Header.Get("x-ms-blob-cache-control"),
+			Content:      content,
+			ContentMD5:   contentMD5,
+			ContentType:  r.Header.Get("x-ms-blob-content-type"),
+		}
+		f.uploads++
+		w.WriteHeader(http.StatusCreated)
+
+	case http.MethodDelete:
+		if _, ok := f.blobs[name]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeBlobContainer) names() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	names := make([]string, 0, len(f.blobs))
+	for name := range f.blobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func writeBlobDirectoryTestFiles(t *testing.T, directory string, files map[string]string) {
+	for name, content := range files {
+		filePath := filepath.Join(directory, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+}
+
+func TestBlobDirectorySync(t *testing.T) {
+	container := &fakeBlobContainer{
+		name: "site",
+		blobs: map[string]fakeBlob{
+			"web/stale.html":  {ContentType: "text/html"},
+			"other/keep.html": {ContentType: "text/html"},
+		},
+	}
+	server := httptest.NewServer(container)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	blobsClient, err := blobs.NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building Blobs Client: %+v", err)
+	}
+	containersClient, err := containers.NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building Containers Client: %+v", err)
+	}
+
+	directory := t.TempDir()
+	writeBlobDirectoryTestFiles(t, directory, map[string]string{
+		"index.html":        "<html></html>",
+		"css/site.css":      "body {}",
+		"js/app.js":         "console.log('hello')",
+		"js/app.js.map":     "{}",
+		"images/README.txt": "",
+		"data/model.bin":    "binary",
+	})
+
+	syncer := BlobDirectorySync{
+		BlobsClient:      blobsClient,
+		ContainersClient: shim.NewDataPlaneStorageContainerWrapper(containersClient),
+		ContainerName:    "site",
+		Prefix:           "web",
+		SourceDirectory:  directory,
+		ExcludePatterns:  []string{"*.map"},
+		ContentTypes: map[string]string{
+			".bin": "application/x-model",
+		},
+		CacheControl:     "max-age=60",
+		DeleteExtraneous: true,
+		Parallelism:      4,
+	}
+
+	local, err := syncer.Sync(ctx)
+	if err != nil {
+		t.Fatalf("synchronising: %+v", err)
+	}
+
+	expected := []string{
+		"other/keep.html",
+		"web/css/site.css",
+		"web/data/model.bin",
+		"web/images/README.txt",
+		"web/index.html",
+		"web/js/app.js",
+	}
+	if actual := container.names(); strings.Join(actual, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected the Blobs %q but got %q", expected, actual)
+	}
+
+	if v := container.blobs["web/data/model.bin"].ContentType; v != "application/x-model" {
+		t.Fatalf("expected the configured Content Type but got %q", v)
+	}
+	if v := container.blobs["web/index.html"].ContentType; !strings.HasPrefix(v, "text/html") {
+		t.Fatalf("expected a detected Content Type of `text/html` but got %q", v)
+	}
+	if v := container.blobs["web/index.html"].CacheControl; v != "max-age=60" {
+		t.Fatalf("expected the Cache Control `max-age=60` but got %q", v)
+	}
+
+	remote, err := syncer.RemoteManifest(ctx)
+	if err != nil {
+		t.Fatalf("retrieving the remote manifest: %+v", err)
+	}
+	if local.Hash() != remote.Hash() {
+		t.Fatalf("expected the local and remote manifests to match after synchronising")
+	}
+
+	// synchronising again should only upload the files which have changed
+	container.uploads = 0
+	writeBlobDirectoryTestFiles(t, directory, map[string]string{
+		"index.html": "<html><body></body></html>",
+	})
+	if _, err := syncer.Sync(ctx); err != nil {
+		t.Fatalf("synchronising: %+v", err)
+	}
+	if container.uploads != 1 {
+		t.Fatalf("expected 1 Blob to be uploaded but got %d", container.uploads)
+	}
+
+	// only the Blobs for the local files should be deleted when extraneous Blobs aren't
+	container.blobs["web/uploaded.html"] = fakeBlob{ContentType: "text/html"}
+	syncer.DeleteExtraneous = false
+	if err := syncer.Delete(ctx); err != nil {
+		t.Fatalf("deleting: %+v", err)
+	}
+	if actual := container.names(); strings.Join(actual, ",") != "other/keep.html,web/uploaded.html" {
+		t.Fatalf("expected the Blobs %q but got %q", "other/keep.html,web/uploaded.html", actual)
+	}
+
+	syncer.DeleteExtraneous = true
+	if err := syncer.Delete(ctx); err != nil {
+		t.Fatalf("deleting: %+v", err)
+	}
+	if actual := container.names(); strings.Join(actual, ",") != "other/keep.html" {
+		t.Fatalf("expected only the Blob outside of the prefix to remain but got %q", actual)
+	}
+}
+
+func TestBlobDirectoryPatternMatches(t *testing.T) {
+	cases := []struct {
+		Pattern string
+		Name    string
+		Matches bool
+	}{
+		{
+			Pattern: "*.html",
+			Name:    "index.html",
+			Matches: true,
+		},
+		{
+			Pattern: "*.html",
+			Name:    "docs/index.html",
+			Matches: true,
+		},
+		{
+			Pattern: "docs/*.html",
+			Name:    "docs/index.html",
+			Matches: true,
+		},
+		{
+			Pattern: "docs/*.html",
+			Name:    "docs/api/index.html",
+			Matches: false,
+		},
+		{
+			Pattern: "docs/**/*.html",
+			Name:    "docs/index.html",
+			Matches: true,
+		},
+		{
+			Pattern: "docs/**/*.html",
+			Name:    "docs/api/v1/index.html",
+			Matches: true,
+		},
+		{
+			Pattern: "**/node_modules/**",
+			Name:    "app/node_modules/left-pad/index.js",
+			Matches: true,
+		},
+		{
+			Pattern: "**/node_modules/**",
+			Name:    "app/modules/index.js",
+			Matches: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q against %q", tc.Name, tc.Pattern)
+
+		if actual := blobDirectoryPatternMatches(tc.Pattern, tc.Name); actual != tc.Matches {
+			t.Fatalf("expected %t but got %t", tc.Matches, actual)
+		}
+	}
+}
@@ -37,6 +37,13 @@ func (Client) DataPlaneOperationSupportingAnyAuthMethod() DataPlaneOperation {
 	}
 }
 
+func (Client) DataPlaneOperationSupportingOnlyAadAuth() DataPlaneOperation {
+	return DataPlaneOperation{
+		SupportsAadAuthentication:       true,
+		SupportsSharedKeyAuthentication: false,
+	}
+}
+
 func (Client) DataPlaneOperationSupportingOnlySharedKeyAuth() DataPlaneOperation {
 	return DataPlaneOperation{
 		SupportsAadAuthentication:       false,
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/datalakestore/paths"
+)
+
+// TODO: move this into Giovanni
+
+type DataLakeGen2AccessControlRecursiveMode string
+
+const (
+	DataLakeGen2AccessControlRecursiveModeModify DataLakeGen2AccessControlRecursiveMode = "modify"
+	DataLakeGen2AccessControlRecursiveModeRemove DataLakeGen2AccessControlRecursiveMode = "remove"
+	DataLakeGen2AccessControlRecursiveModeSet    DataLakeGen2AccessControlRecursiveMode = "set"
+)
+
+// dataLakeGen2AccessControlRecursiveMaxReportedFailures is the number of failed entries included in the error returned
+const dataLakeGen2AccessControlRecursiveMaxReportedFailures = 10
+
+type DataLakeGen2AccessControlRecursiveInput struct {
+	ACL  string
+	Mode DataLakeGen2AccessControlRecursiveMode
+
+	// BatchSize is the maximum number of files and directories updated by each request
+	BatchSize int
+
+	// ContinueOnFailure continues applying the ACL when the ACL can't be applied to some files or directories
+	ContinueOnFailure bool
+}
+
+type DataLakeGen2AccessControlRecursiveResult struct {
+	DirectoriesSuccessful int64                                       `json:"directoriesSuccessful"`
+	FilesSuccessful       int64                                       `json:"filesSuccessful"`
+	FailureCount          int64                                       `json:"failureCount"`
+	FailedEntries         []DataLakeGen2AccessControlRecursiveFailure `json:"failedEntries"`
+}
+
+type DataLakeGen2AccessControlRecursiveFailure struct {
+	ErrorMessage string `json:"errorMessage"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+}
+
+// setDataLakeGen2AccessControlRecursive applies the ACL to the path and everything beneath it, following the
+// continuation token until every batch has been processed - returning an error describing any failures
+func setDataLakeGen2AccessControlRecursive(ctx context.Context, pathsClient *paths.Client, fileSystemName, path string, input DataLakeGen2AccessControlRecursiveInput) (*DataLakeGen2AccessControlRecursiveResult, error) {
+	if fileSystemName == "" {
+		return nil, fmt.Errorf("`fileSystemName` cannot be an empty string")
+	}
+	if input.BatchSize < 1 || input.BatchSize > 2000 {
+		return nil, fmt.Errorf("`input.BatchSize` must be between 1 and 2000")
+	}
+
+	result := DataLakeGen2AccessControlRecursiveResult{
+		FailedEntries: make([]DataLakeGen2AccessControlRecursiveFailure, 0),
+	}
+
+	var continuation *string
+	for {
+		batch, next, err := setDataLakeGen2AccessControlRecursiveBatch(ctx, pathsClient, fileSystemName, path, input, continuation)
+		if err != nil {
+			return nil, err
+		}
+
+		result.DirectoriesSuccessful += batch.DirectoriesSuccessful
+		result.FilesSuccessful += batch.FilesSuccessful
+		result.FailureCount += batch.FailureCount
+		result.FailedEntries = append(result.FailedEntries, batch.FailedEntries...)
+		log.Printf("[DEBUG] Applied the ACL to %d directories and %d files with %d failures so far", result.DirectoriesSuccessful, result.FilesSuccessful, result.FailureCount)
+
+		// without the force flag the operation stops at the first failure, so there's no need to continue
+		if next == nil || (batch.FailureCount > 0 && !input.ContinueOnFailure) {
+			break
+		}
+		continuation = next
+	}
+
+	if result.FailureCount > 0 {
+		return &result, fmt.Errorf("the ACL could not be applied to %d files or directories: %s", result.FailureCount, formatDataLakeGen2AccessControlRecursiveFailures(result.FailedEntries))
+	}
+
+	return &result, nil
+}
+
+func formatDataLakeGen2AccessControlRecursiveFailures(input []DataLakeGen2AccessControlRecursiveFailure) string {
+	failures := make([]string, 0)
+	for i, v := range input {
+		if i == dataLakeGen2AccessControlRecursiveMaxReportedFailures {
+			failures = append(failures, fmt.Sprintf("and %d more", len(input)-i))
+			break
+		}
+		failures = append(failures, fmt.Sprintf("%s %q: %s", v.Type, v.Name, v.ErrorMessage))
+	}
+
+	return strings.Join(failures, ", ")
+}
+
+func setDataLakeGen2AccessControlRecursiveBatch(ctx context.Context, pathsClient *paths.Client, fileSystemName, path string, input DataLakeGen2AccessControlRecursiveInput, continuation *string) (*DataLakeGen2AccessControlRecursiveResult, *string, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPatch,
+		OptionsObject: setAccessControlRecursiveOptions{
+			input:        input,
+			continuation: continuation,
+		},
+		Path: fmt.Sprintf("/%s/%s", fileSystemName, path),
+	}
+
+	req, err := pathsClient.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("executing request: %+v", err)
+	}
+
+	var result DataLakeGen2AccessControlRecursiveResult
+	if err := resp.Unmarshal(&result); err != nil {
+		return nil, nil, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	var next *string
+	if v := resp.Header.Get("x-ms-continuation"); v != "" {
+		next = &v
+	}
+
+	return &result, next, nil
+}
+
+var _ client.Options = setAccessControlRecursiveOptions{}
+
+type setAccessControlRecursiveOptions struct {
+	input        DataLakeGen2AccessControlRecursiveInput
+	continuation *string
+}
+
+func (o setAccessControlRecursiveOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-acl", o.input.ACL)
+	return headers
+}
+
+func (o setAccessControlRecursiveOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o setAccessControlRecursiveOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("action", "setAccessControlRecursive")
+	out.Append("mode", string(o.input.Mode))
+	out.Append("maxRecords", strconv.Itoa(o.input.BatchSize))
+	if o.input.ContinueOnFailure {
+		out.Append("forceFlag", "true")
+	}
+	if o.continuation != nil {
+		out.Append("continuation", *o.continuation)
+	}
+	return out
+}
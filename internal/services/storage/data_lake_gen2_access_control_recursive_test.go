@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackofallops/giovanni/storage/2023-11-03/datalakestore/paths"
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
+)
+
+func TestSetDataLakeGen2AccessControlRecursive(t *testing.T) {
+	cases := []struct {
+		Name              string
+		ContinueOnFailure bool
+		// Batches is the result of each batch, which are returned in order
+		Batches          []DataLakeGen2AccessControlRecursiveResult
+		ExpectedRequests int
+		ExpectedError    string
+	}{
+		{
+			Name: "Single Batch",
+			Batches: []DataLakeGen2AccessControlRecursiveResult{
+				{DirectoriesSuccessful: 1, FilesSuccessful: 2},
+			},
+			ExpectedRequests: 1,
+		},
+		{
+			Name: "Multiple Batches",
+			Batches: []DataLakeGen2AccessControlRecursiveResult{
+				{DirectoriesSuccessful: 2},
+				{FilesSuccessful: 2},
+				{FilesSuccessful: 1},
+			},
+			ExpectedRequests: 3,
+		},
+		{
+			Name: "Failure Stops",
+			Batches: []DataLakeGen2AccessControlRecursiveResult{
+				{
+					DirectoriesSuccessful: 1,
+					FailureCount:          1,
+					FailedEntries: []DataLakeGen2AccessControlRecursiveFailure{
+						{Name: "dir/file.txt", Type: "FILE", ErrorMessage: "This request is not authorized to perform this operation."},
+					},
+				},
+				{FilesSuccessful: 1},
+			},
+			ExpectedRequests: 1,
+			ExpectedError:    `the ACL could not be applied to 1 files or directories: FILE "dir/file.txt": This request is not authorized`,
+		},
+		{
+			Name:              "Failure Continues",
+			ContinueOnFailure: true,
+			Batches: []DataLakeGen2AccessControlRecursiveResult{
+				{
+					DirectoriesSuccessful: 1,
+					FailureCount:          1,
+					FailedEntries: []DataLakeGen2AccessControlRecursiveFailure{
+						{Name: "dir/file.txt", Type: "FILE", ErrorMessage: "This request is not authorized to perform this operation."},
+					},
+				},
+				{FilesSuccessful: 1},
+			},
+			ExpectedRequests: 2,
+			ExpectedError:    "the ACL could not be applied to 1 files or directories",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			if r.Method != http.MethodPatch || r.URL.Path != "/filesystem/some/path" || query.Get("action") != "setAccessControlRecursive" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if query.Get("mode") != "modify" || query.Get("maxRecords") != "2" || r.Header.Get("x-ms-acl") != "user:00000000-0000-0000-0000-000000000000:r-x" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if force := query.Get("forceFlag") == "true"; force != tc.ContinueOnFailure {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			// the continuation token is the index of the batch to return
+			index := 0
+			if v := query.Get("continuation"); v != "" {
+				index, _ = strconv.Atoi(v)
+			}
+			requests++
+
+			if index+1 < len(tc.Batches) {
+				w.Header().Set("x-ms-continuation", fmt.Sprintf("%d", index+1))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(tc.Batches[index])
+		}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+		client, err := paths.NewWithBaseUri(server.URL)
+		if err != nil {
+			t.Fatalf("building client: %+v", err)
+		}
+
+		input := DataLakeGen2AccessControlRecursiveInput{
+			ACL:               "user:00000000-0000-0000-0000-000000000000:r-x",
+			Mode:              DataLakeGen2AccessControlRecursiveModeModify,
+			BatchSize:         2,
+			ContinueOnFailure: tc.ContinueOnFailure,
+		}
+		result, err := setDataLakeGen2AccessControlRecursive(ctx, client, "filesystem", "some/path", input)
+		cancel()
+		server.Close()
+
+		if requests != tc.ExpectedRequests {
+			t.Fatalf("expected %d requests but got %d", tc.ExpectedRequests, requests)
+		}
+
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			expected := DataLakeGen2AccessControlRecursiveResult{}
+			for _, batch := range tc.Batches {
+				expected.DirectoriesSuccessful += batch.DirectoriesSuccessful
+				expected.FilesSuccessful += batch.FilesSuccessful
+			}
+			if result.DirectoriesSuccessful != expected.DirectoriesSuccessful || result.FilesSuccessful != expected.FilesSuccessful {
+				t.Fatalf("expected %d directories and %d files but got %d and %d", expected.DirectoriesSuccessful, expected.FilesSuccessful, result.DirectoriesSuccessful, result.FilesSuccessful)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error containing %q", tc.ExpectedError)
+		}
+		if !strings.Contains(err.Error(), tc.ExpectedError) {
+			t.Fatalf("expected an error containing %q but got %q", tc.ExpectedError, err.Error())
+		}
+	}
+}
+
+func TestFlattenDataLakeGen2RecursiveAces(t *testing.T) {
+	acl, err := accesscontrol.ParseACL("user::rwx,user:11111111-1111-1111-1111-111111111111:r-x,user:22222222-2222-2222-2222-222222222222:rwx,group::r-x,mask::rwx,other::---,default:user:11111111-1111-1111-1111-111111111111:r--")
+	if err != nil {
+		t.Fatalf("parsing ACL: %+v", err)
+	}
+
+	configured := []DataLakeGen2RecursiveAce{
+		{Scope: "access", Type: "user", Id: "11111111-1111-1111-1111-111111111111", Permissions: "r-x"},
+		{Scope: "default", Type: "user", Id: "11111111-1111-1111-1111-111111111111", Permissions: "r-x"},
+		{Scope: "access", Type: "other", Permissions: "---"},
+	}
+
+	cases := map[DataLakeGen2AccessControlRecursiveMode][]string{
+		DataLakeGen2AccessControlRecursiveModeModify: {
+			"access:user:11111111-1111-1111-1111-111111111111:r-x",
+			"access:other::---",
+			"default:user:11111111-1111-1111-1111-111111111111:r--",
+		},
+		DataLakeGen2AccessControlRecursiveModeSet: {
+			"access:user:11111111-1111-1111-1111-111111111111:r-x",
+			"access:user:22222222-2222-2222-2222-222222222222:rwx",
+			"access:other::---",
+			"default:user:11111111-1111-1111-1111-111111111111:r--",
+		},
+	}
+
+	for mode, expected := range cases {
+		t.Logf("[DEBUG] Testing %q", mode)
+
+		actual := make([]string, 0)
+		for _, v := range flattenDataLakeGen2RecursiveAces(acl, configured, mode) {
+			actual = append(actual, fmt.Sprintf("%s:%s:%s:%s", v.Scope, v.Type, v.Id, v.Permissions))
+		}
+
+		if strings.Join(actual, ",") != strings.Join(expected, ",") {
+			t.Fatalf("expected %q but got %q", expected, actual)
+		}
+	}
+
+	imported := make([]string, 0)
+	for _, v := range flattenDataLakeGen2RecursiveAces(acl, nil, DataLakeGen2AccessControlRecursiveModeModify) {
+		imported = append(imported, fmt.Sprintf("%s:%s:%s:%s", v.Scope, v.Type, v.Id, v.Permissions))
+	}
+	if expected := "access:user:11111111-1111-1111-1111-111111111111:r-x,access:user:22222222-2222-2222-2222-222222222222:rwx,default:user:11111111-1111-1111-1111-111111111111:r--"; strings.Join(imported, ",") != expected {
+		t.Fatalf("expected the named entries to be imported but got %q", imported)
+	}
+
+	if actual := dataLakeGen2RecursiveAceRemovals(configured, configured[1:]); actual != "user:11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected the access entry to be removed but got %q", actual)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// storageAccountPreflightValidation validates the SKU, Kind and the commonly policy-governed properties of a
+// Storage Account during the plan, when the `preflight_validation` feature is enabled
+func storageAccountPreflightValidation(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client) error {
+	if !preflight.ValuesKnown(d, "name", "resource_group_name", "location", "account_kind", "account_tier", "account_replication_type") {
+		return nil
+	}
+
+	publicNetworkAccess := storageaccounts.PublicNetworkAccessDisabled
+	if d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = storageaccounts.PublicNetworkAccessEnabled
+	}
+
+	properties := map[string]interface{}{
+		"allowBlobPublicAccess":    d.Get("allow_nested_items_to_be_public").(bool),
+		"allowSharedKeyAccess":     d.Get("shared_access_key_enabled").(bool),
+		"isHnsEnabled":             d.Get("is_hns_enabled").(bool),
+		"minimumTlsVersion":        d.Get("min_tls_version").(string),
+		"publicNetworkAccess":      string(publicNetworkAccess),
+		"supportsHttpsTrafficOnly": d.Get("https_traffic_only_enabled").(bool),
+	}
+	if v := d.Get("access_tier").(string); v != "" {
+		properties["accessTier"] = v
+	}
+
+	resource := preflight.Resource{
+		Type:       "Microsoft.Storage/storageAccounts",
+		ApiVersion: "2023-05-01",
+		Name:       d.Get("name").(string),
+		Location:   d.Get("location").(string),
+		Kind:       d.Get("account_kind").(string),
+		Sku: map[string]interface{}{
+			"name": fmt.Sprintf("%s_%s", d.Get("account_tier").(string), d.Get("account_replication_type").(string)),
+		},
+		Properties: properties,
+	}
+	if d.NewValueKnown("tags") {
+		resource.Tags = d.Get("tags").(map[string]interface{})
+	}
+
+	return preflight.ValidateResource(ctx, client, d.Get("resource_group_name").(string), resource)
+}
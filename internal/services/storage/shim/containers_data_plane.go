@@ -69,6 +69,42 @@ func (w DataPlaneStorageContainerWrapper) Get(ctx context.Context, containerName
 	}, nil
 }
 
+func (w DataPlaneStorageContainerWrapper) ListBlobs(ctx context.Context, containerName string, prefix string) (*[]StorageContainerBlob, error) {
+	input := containers.ListBlobsInput{
+		MaxResults: pointer.To(5000),
+	}
+	if prefix != "" {
+		input.Prefix = pointer.To(prefix)
+	}
+
+	result := make([]StorageContainerBlob, 0)
+	for {
+		resp, err := w.client.ListBlobs(ctx, containerName, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range resp.Blobs.Blobs {
+			blob := StorageContainerBlob{
+				Name: v.Name,
+			}
+			if props := v.Properties; props != nil {
+				blob.CacheControl = pointer.From(props.CacheControl)
+				blob.ContentMD5 = pointer.From(props.ContentMD5)
+				blob.ContentType = pointer.From(props.ContentType)
+			}
+			result = append(result, blob)
+		}
+
+		if resp.NextMarker == nil || *resp.NextMarker == "" {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+
+	return &result, nil
+}
+
 func (w DataPlaneStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, containerName string, level containers.AccessLevel) error {
 	input := containers.SetAccessControlInput{
 		AccessLevel: level,
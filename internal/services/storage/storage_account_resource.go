@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
 	keyVaultsClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -1169,6 +1170,7 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				}
 				return false
 			}),
+			preflight.CustomizeDiff(storageAccountPreflightValidation, "location", "account_kind", "account_tier", "account_replication_type", "access_tier", "min_tls_version", "https_traffic_only_enabled", "allow_nested_items_to_be_public", "shared_access_key_enabled", "public_network_access_enabled", "tags"),
 		),
 	}
 
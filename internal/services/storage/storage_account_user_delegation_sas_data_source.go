@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageAccountUserDelegationSasDataSource struct{}
+
+var _ sdk.DataSource = StorageAccountUserDelegationSasDataSource{}
+
+type StorageAccountUserDelegationSasDataSourceModel struct {
+	StorageAccountId string                                       `tfschema:"storage_account_id"`
+	ContainerName    string                                       `tfschema:"container_name"`
+	BlobName         string                                       `tfschema:"blob_name"`
+	DirectoryPath    string                                       `tfschema:"directory_path"`
+	Permissions      []StorageAccountUserDelegationSasPermissions `tfschema:"permissions"`
+	Start            string                                       `tfschema:"start"`
+	Expiry           string                                       `tfschema:"expiry"`
+	IPAddress        string                                       `tfschema:"ip_address"`
+	HttpsOnly        bool                                         `tfschema:"https_only"`
+	Sas              string                                       `tfschema:"sas"`
+}
+
+type StorageAccountUserDelegationSasPermissions struct {
+	Read        bool `tfschema:"read"`
+	Add         bool `tfschema:"add"`
+	Create      bool `tfschema:"create"`
+	Write       bool `tfschema:"write"`
+	Delete      bool `tfschema:"delete"`
+	List        bool `tfschema:"list"`
+	Tags        bool `tfschema:"tags"`
+	Move        bool `tfschema:"move"`
+	Execute     bool `tfschema:"execute"`
+	Ownership   bool `tfschema:"ownership"`
+	Permissions bool `tfschema:"permissions"`
+}
+
+func (r StorageAccountUserDelegationSasDataSource) ResourceType() string {
+	return "azurerm_storage_account_user_delegation_sas"
+}
+
+func (r StorageAccountUserDelegationSasDataSource) ModelObject() interface{} {
+	return &StorageAccountUserDelegationSasDataSourceModel{}
+}
+
+func (r StorageAccountUserDelegationSasDataSource) Arguments() map[string]*pluginsdk.Schema {
+	permission := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: storageValidate.StorageContainerName,
+		},
+
+		"blob_name": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"directory_path"},
+		},
+
+		"directory_path": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"blob_name"},
+		},
+
+		"permissions": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"read":        permission(),
+					"add":         permission(),
+					"create":      permission(),
+					"write":       permission(),
+					"delete":      permission(),
+					"list":        permission(),
+					"tags":        permission(),
+					"move":        permission(),
+					"execute":     permission(),
+					"ownership":   permission(),
+					"permissions": permission(),
+				},
+			},
+		},
+
+		"start": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"expiry": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"ip_address": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: storageValidate.SharedAccessSignatureIP,
+		},
+
+		"https_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r StorageAccountUserDelegationSasDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"sas": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r StorageAccountUserDelegationSasDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model StorageAccountUserDelegationSasDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.GetAccount(ctx, *accountId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating %s", accountId)
+			}
+
+			input, err := expandStorageAccountUserDelegationSasInput(model)
+			if err != nil {
+				return err
+			}
+
+			sas, err := buildUserDelegationSas(ctx, storageClient, *account, *input)
+			if err != nil {
+				return fmt.Errorf("generating User Delegation SAS for %s: %+v", accountId, err)
+			}
+
+			model.Sas = pointer.From(sas)
+			tokenHash := sha256.Sum256([]byte(model.Sas))
+			metadata.ResourceData.SetId(hex.EncodeToString(tokenHash[:]))
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func expandStorageAccountUserDelegationSasInput(model StorageAccountUserDelegationSasDataSourceModel) (*UserDelegationSasInput, error) {
+	input := UserDelegationSasInput{
+		ContainerName: model.ContainerName,
+		BlobName:      model.BlobName,
+		DirectoryPath: model.DirectoryPath,
+		IPAddress:     model.IPAddress,
+		HttpsOnly:     model.HttpsOnly,
+	}
+
+	if len(model.Permissions) > 0 {
+		permissions := model.Permissions[0]
+		input.Permissions = UserDelegationSasPermissions{
+			Read:        permissions.Read,
+			Add:         permissions.Add,
+			Create:      permissions.Create,
+			Write:       permissions.Write,
+			Delete:      permissions.Delete,
+			List:        permissions.List,
+			Tags:        permissions.Tags,
+			Move:        permissions.Move,
+			Execute:     permissions.Execute,
+			Ownership:   permissions.Ownership,
+			Permissions: permissions.Permissions,
+		}
+	}
+
+	if model.Start != "" {
+		start, err := time.Parse(time.RFC3339, model.Start)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `start`: %+v", err)
+		}
+		input.Start = &start
+	}
+
+	expiry, err := time.Parse(time.RFC3339, model.Expiry)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `expiry`: %+v", err)
+	}
+	input.Expiry = expiry
+
+	return &input, nil
+}
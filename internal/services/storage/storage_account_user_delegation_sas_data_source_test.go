@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageAccountUserDelegationSasDataSource struct{}
+
+func TestAccDataSourceStorageAccountUserDelegationSas_container(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_account_user_delegation_sas", "test")
+	d := StorageAccountUserDelegationSasDataSource{}
+	expiry := time.Now().UTC().Add(time.Hour * 4).Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.container(data, expiry),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("https_only").HasValue("true"),
+				check.That(data.ResourceName).Key("expiry").HasValue(expiry),
+				check.That(data.ResourceName).Key("permissions.0.read").HasValue("true"),
+				check.That(data.ResourceName).Key("permissions.0.list").HasValue("true"),
+				check.That(data.ResourceName).Key("sas").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageAccountUserDelegationSas_directory(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_account_user_delegation_sas", "test")
+	d := StorageAccountUserDelegationSasDataSource{}
+	utcNow := time.Now().UTC()
+	start := utcNow.Format(time.RFC3339)
+	expiry := utcNow.Add(time.Hour * 4).Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.directory(data, start, expiry),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("start").HasValue(start),
+				check.That(data.ResourceName).Key("ip_address").HasValue("168.1.5.65-168.1.5.70"),
+				check.That(data.ResourceName).Key("sas").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (d StorageAccountUserDelegationSasDataSource) container(data acceptance.TestData, expiry string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_account_user_delegation_sas" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  container_name     = azurerm_storage_container.test.name
+  expiry             = "%s"
+
+  permissions {
+    read = true
+    list = true
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, d.template(data, false), expiry)
+}
+
+func (d StorageAccountUserDelegationSasDataSource) directory(data acceptance.TestData, start, expiry string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_account_user_delegation_sas" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  container_name     = azurerm_storage_container.test.name
+  directory_path     = "raw/sales"
+  ip_address         = "168.1.5.65-168.1.5.70"
+  start              = "%s"
+  expiry             = "%s"
+
+  permissions {
+    read    = true
+    list    = true
+    execute = true
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, d.template(data, true), start, expiry)
+}
+
+func (d StorageAccountUserDelegationSasDataSource) template(data acceptance.TestData, isHnsEnabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+  storage_use_azuread = true
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                            = "acctestsads%[3]s"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  account_tier                    = "Standard"
+  account_replication_type        = "LRS"
+  is_hns_enabled                  = %[4]t
+  shared_access_key_enabled       = false
+  default_to_oauth_authentication = true
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "sas-test"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, isHnsEnabled)
+}
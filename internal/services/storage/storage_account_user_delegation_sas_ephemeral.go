@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.EphemeralResource = &StorageAccountUserDelegationSasEphemeralResource{}
+
+func NewStorageAccountUserDelegationSasEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountUserDelegationSasEphemeralResource{}
+}
+
+type StorageAccountUserDelegationSasEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountUserDelegationSasEphemeralResourceModel struct {
+	StorageAccountId types.String                                          `tfsdk:"storage_account_id"`
+	ContainerName    types.String                                          `tfsdk:"container_name"`
+	BlobName         types.String                                          `tfsdk:"blob_name"`
+	DirectoryPath    types.String                                          `tfsdk:"directory_path"`
+	Permissions      []StorageAccountUserDelegationSasEphemeralPermissions `tfsdk:"permissions"`
+	Start            types.String                                          `tfsdk:"start"`
+	Expiry           types.String                                          `tfsdk:"expiry"`
+	IPAddress        types.String                                          `tfsdk:"ip_address"`
+	HttpsOnly        types.Bool                                            `tfsdk:"https_only"`
+	Sas              types.String                                          `tfsdk:"sas"`
+}
+
+type StorageAccountUserDelegationSasEphemeralPermissions struct {
+	Read        types.Bool `tfsdk:"read"`
+	Add         types.Bool `tfsdk:"add"`
+	Create      types.Bool `tfsdk:"create"`
+	Write       types.Bool `tfsdk:"write"`
+	Delete      types.Bool `tfsdk:"delete"`
+	List        types.Bool `tfsdk:"list"`
+	Tags        types.Bool `tfsdk:"tags"`
+	Move        types.Bool `tfsdk:"move"`
+	Execute     types.Bool `tfsdk:"execute"`
+	Ownership   types.Bool `tfsdk:"ownership"`
+	Permissions types.Bool `tfsdk:"permissions"`
+}
+
+func (e *StorageAccountUserDelegationSasEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_storage_account_user_delegation_sas"
+}
+
+func (e *StorageAccountUserDelegationSasEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *StorageAccountUserDelegationSasEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	permissions := make(map[string]schema.Attribute)
+	for _, v := range []string{"read", "add", "create", "write", "delete", "list", "tags", "move", "execute", "ownership", "permissions"} {
+		permissions[v] = schema.BoolAttribute{
+			Optional: true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"storage_account_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateStorageAccountID,
+					},
+				},
+			},
+
+			"container_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: storageValidate.StorageContainerName,
+					},
+				},
+			},
+
+			"blob_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+					stringvalidator.ConflictsWith(path.MatchRoot("directory_path")),
+				},
+			},
+
+			"directory_path": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"start": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.IsRFC3339Time,
+					},
+				},
+			},
+
+			"expiry": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.IsRFC3339Time,
+					},
+				},
+			},
+
+			"ip_address": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: storageValidate.SharedAccessSignatureIP,
+					},
+				},
+			},
+
+			"https_only": schema.BoolAttribute{
+				Optional: true,
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"permissions": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: permissions,
+				},
+			},
+		},
+	}
+}
+
+func (e *StorageAccountUserDelegationSasEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	storageClient := e.Client.Storage
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data StorageAccountUserDelegationSasEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	accountId, err := commonids.ParseStorageAccountID(data.StorageAccountId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	account, err := storageClient.GetAccount(ctx, *accountId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", accountId), err)
+		return
+	}
+	if account == nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("locating %s", accountId), "the Storage Account was not found")
+		return
+	}
+
+	// the Framework doesn't support defaults for Ephemeral Resources, so map this onto the Data Source model to share the expansion
+	model := StorageAccountUserDelegationSasDataSourceModel{
+		StorageAccountId: data.StorageAccountId.ValueString(),
+		ContainerName:    data.ContainerName.ValueString(),
+		BlobName:         data.BlobName.ValueString(),
+		DirectoryPath:    data.DirectoryPath.ValueString(),
+		Start:            data.Start.ValueString(),
+		Expiry:           data.Expiry.ValueString(),
+		IPAddress:        data.IPAddress.ValueString(),
+		HttpsOnly:        data.HttpsOnly.IsNull() || data.HttpsOnly.ValueBool(),
+	}
+	for _, v := range data.Permissions {
+		model.Permissions = append(model.Permissions, StorageAccountUserDelegationSasPermissions{
+			Read:        v.Read.ValueBool(),
+			Add:         v.Add.ValueBool(),
+			Create:      v.Create.ValueBool(),
+			Write:       v.Write.ValueBool(),
+			Delete:      v.Delete.ValueBool(),
+			List:        v.List.ValueBool(),
+			Tags:        v.Tags.ValueBool(),
+			Move:        v.Move.ValueBool(),
+			Execute:     v.Execute.ValueBool(),
+			Ownership:   v.Ownership.ValueBool(),
+			Permissions: v.Permissions.ValueBool(),
+		})
+	}
+
+	input, err := expandStorageAccountUserDelegationSasInput(model)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	sas, err := buildUserDelegationSas(ctx, storageClient, *account, *input)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("generating User Delegation SAS for %s", accountId), err)
+		return
+	}
+
+	data.Sas = types.StringValue(pointer.From(sas))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type StorageAccountUserDelegationSasEphemeral struct{}
+
+func TestAccEphemeralStorageAccountUserDelegationSas_blob(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_storage_account_user_delegation_sas", "test")
+	r := StorageAccountUserDelegationSasEphemeral{}
+	expiry := time.Now().UTC().Add(time.Hour * 4).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.blob(data, expiry),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("sas"), knownvalue.StringRegexp(regexp.MustCompile(`^\?.*sr=b.*`))),
+				},
+			},
+		},
+	})
+}
+
+func (r StorageAccountUserDelegationSasEphemeral) blob(data acceptance.TestData, expiry string) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_storage_account_user_delegation_sas" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  container_name     = azurerm_storage_container.test.name
+  blob_name          = "example.txt"
+  expiry             = "%s"
+
+  permissions {
+    read  = true
+    write = true
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_storage_account_user_delegation_sas.test
+}
+
+resource "echo" "test" {}
+`, StorageAccountUserDelegationSasDataSource{}.template(data, false), expiry)
+}
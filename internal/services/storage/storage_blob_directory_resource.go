@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/accounts"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+type StorageBlobDirectoryResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = StorageBlobDirectoryResource{}
+	_ sdk.ResourceWithCustomizeDiff = StorageBlobDirectoryResource{}
+)
+
+type StorageBlobDirectoryResourceModel struct {
+	StorageAccountName      string            `tfschema:"storage_account_name"`
+	StorageContainerName    string            `tfschema:"storage_container_name"`
+	Prefix                  string            `tfschema:"prefix"`
+	SourceDirectory         string            `tfschema:"source_directory"`
+	IncludePatterns         []string          `tfschema:"include_patterns"`
+	ExcludePatterns         []string          `tfschema:"exclude_patterns"`
+	ContentTypes            map[string]string `tfschema:"content_types"`
+	CacheControl            string            `tfschema:"cache_control"`
+	DeleteExtraneousEnabled bool              `tfschema:"delete_extraneous_enabled"`
+	Parallelism             int64             `tfschema:"parallelism"`
+	ManifestHash            string            `tfschema:"manifest_hash"`
+}
+
+func (r StorageBlobDirectoryResource) ResourceType() string {
+	return "azurerm_storage_blob_directory"
+}
+
+func (r StorageBlobDirectoryResource) ModelObject() interface{} {
+	return &StorageBlobDirectoryResourceModel{}
+}
+
+func (r StorageBlobDirectoryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.IsURLWithHTTPS
+}
+
+func (r StorageBlobDirectoryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageAccountName,
+		},
+
+		"storage_container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageContainerName,
+		},
+
+		"source_directory": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"prefix": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringIsNotEmpty,
+				validation.StringDoesNotContainAny("\\"),
+				validation.StringMatch(regexp.MustCompile(`^[^/](.*[^/])?$`), "`prefix` cannot start or end with a `/`"),
+			),
+		},
+
+		"include_patterns": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validateBlobDirectoryPattern,
+			},
+		},
+
+		"exclude_patterns": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validateBlobDirectoryPattern,
+			},
+		},
+
+		"content_types": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"cache_control": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"delete_extraneous_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"parallelism": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      8,
+			ValidateFunc: validation.IntBetween(1, 64),
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"manifest_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			var config StorageBlobDirectoryResourceModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the directory may not exist until apply time, for example when it's generated by another resource
+			if !diff.NewValueKnown("source_directory") || config.SourceDirectory == "" {
+				return diff.SetNewComputed("manifest_hash")
+			}
+
+			local, err := expandBlobDirectorySync(config).LocalManifest(true)
+			if err != nil {
+				return err
+			}
+
+			if hash := local.Hash(); hash != config.ManifestHash {
+				return diff.SetNew("manifest_hash", hash)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId := accounts.AccountId{
+				AccountName:   model.StorageAccountName,
+				DomainSuffix:  storageClient.StorageDomainSuffix,
+				SubDomainType: accounts.BlobSubDomainType,
+			}
+			syncer := expandBlobDirectorySync(model)
+			id := blobs.NewBlobID(accountId, model.StorageContainerName, syncer.blobNamePrefix())
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, model.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", model.StorageAccountName, id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q", model.StorageAccountName)
+			}
+
+			if syncer.BlobsClient, err = storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod()); err != nil {
+				return fmt.Errorf("building Blobs Client: %+v", err)
+			}
+			if syncer.ContainersClient, err = storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod()); err != nil {
+				return fmt.Errorf("building Containers Client: %+v", err)
+			}
+
+			log.Printf("[DEBUG] Synchronising %q into %s..", model.SourceDirectory, id)
+			manifest, err := syncer.Sync(ctx)
+			if err != nil {
+				return fmt.Errorf("synchronising %q into %s: %+v", model.SourceDirectory, id, err)
+			}
+
+			metadata.SetID(id)
+
+			model.ManifestHash = manifest.Hash()
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := blobs.ParseBlobID(metadata.ResourceData.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			var state StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.StorageAccountName = id.AccountId.AccountName
+			state.StorageContainerName = id.ContainerName
+			state.Prefix = strings.TrimSuffix(id.BlobName, "/")
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				log.Printf("[DEBUG] Unable to locate Storage Account %q for %s - assuming removed & removing from state!", id.AccountId.AccountName, id)
+				return metadata.MarkAsGone(id)
+			}
+
+			syncer := expandBlobDirectorySync(state)
+			if syncer.ContainersClient, err = storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod()); err != nil {
+				return fmt.Errorf("building Containers Client: %+v", err)
+			}
+
+			exists, err := syncer.ContainersClient.Exists(ctx, id.ContainerName)
+			if err != nil {
+				return fmt.Errorf("checking for the existence of Container %q for %s: %+v", id.ContainerName, id, err)
+			}
+			if exists != nil && !*exists {
+				log.Printf("[DEBUG] Unable to locate Container %q for %s - assuming removed & removing from state!", id.ContainerName, id)
+				return metadata.MarkAsGone(id)
+			}
+
+			remote, err := syncer.RemoteManifest(ctx)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// extraneous Blobs are only tracked when they'd be removed, otherwise only the Blobs for the local files are considered
+			if !state.DeleteExtraneousEnabled && state.SourceDirectory != "" {
+				local, err := syncer.LocalManifest(false)
+				if err != nil {
+					log.Printf("[DEBUG] Unable to read the local files for %s, all Blobs within the prefix will be tracked: %+v", id, err)
+				} else {
+					for name := range remote {
+						if _, ok := local[name]; !ok {
+							delete(remote, name)
+						}
+					}
+				}
+			}
+
+			state.ManifestHash = remote.Hash()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := blobs.ParseBlobID(metadata.ResourceData.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			var model StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q", id.AccountId.AccountName)
+			}
+
+			syncer := expandBlobDirectorySync(model)
+			if syncer.BlobsClient, err = storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod()); err != nil {
+				return fmt.Errorf("building Blobs Client: %+v", err)
+			}
+			if syncer.ContainersClient, err = storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod()); err != nil {
+				return fmt.Errorf("building Containers Client: %+v", err)
+			}
+
+			log.Printf("[DEBUG] Synchronising %q into %s..", model.SourceDirectory, id)
+			manifest, err := syncer.Sync(ctx)
+			if err != nil {
+				return fmt.Errorf("synchronising %q into %s: %+v", model.SourceDirectory, id, err)
+			}
+
+			model.ManifestHash = manifest.Hash()
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageBlobDirectoryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := blobs.ParseBlobID(metadata.ResourceData.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			var model StorageBlobDirectoryResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q", id.AccountId.AccountName)
+			}
+
+			syncer := expandBlobDirectorySync(model)
+			if syncer.BlobsClient, err = storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod()); err != nil {
+				return fmt.Errorf("building Blobs Client: %+v", err)
+			}
+			if syncer.ContainersClient, err = storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod()); err != nil {
+				return fmt.Errorf("building Containers Client: %+v", err)
+			}
+
+			if err := syncer.Delete(ctx); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandBlobDirectorySync(model StorageBlobDirectoryResourceModel) BlobDirectorySync {
+	return BlobDirectorySync{
+		AccountName:      model.StorageAccountName,
+		ContainerName:    model.StorageContainerName,
+		Prefix:           model.Prefix,
+		SourceDirectory:  model.SourceDirectory,
+		IncludePatterns:  model.IncludePatterns,
+		ExcludePatterns:  model.ExcludePatterns,
+		ContentTypes:     model.ContentTypes,
+		CacheControl:     model.CacheControl,
+		DeleteExtraneous: model.DeleteExtraneousEnabled,
+		Parallelism:      int(model.Parallelism),
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+type StorageBlobDirectoryResource struct{}
+
+func TestAccStorageBlobDirectory_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+	directory := r.sourceDirectory(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, directory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("manifest_hash").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("source_directory", "parallelism"),
+	})
+}
+
+func TestAccStorageBlobDirectory_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+	directory := r.sourceDirectory(t, map[string]string{
+		"index.html":     "<html></html>",
+		"css/site.css":   "body {}",
+		"js/app.js":      "console.log('hello')",
+		"js/app.js.map":  "{}",
+		"data/model.bin": "binary",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, directory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobNames("site/css/site.css", "site/data/model.bin", "site/index.html", "site/js/app.js")),
+			),
+		},
+		data.ImportStep("source_directory", "parallelism", "cache_control", "content_types", "delete_extraneous_enabled", "exclude_patterns"),
+	})
+}
+
+func TestAccStorageBlobDirectory_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+	directory := r.sourceDirectory(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, directory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobNames("site/css/site.css", "site/index.html")),
+			),
+		},
+		{
+			PreConfig: func() {
+				if err := os.Remove(filepath.Join(directory, "css", "site.css")); err != nil {
+					t.Fatalf("removing file: %+v", err)
+				}
+				if err := os.WriteFile(filepath.Join(directory, "about.html"), []byte("<html>about</html>"), 0o644); err != nil {
+					t.Fatalf("writing file: %+v", err)
+				}
+			},
+			Config: r.complete(data, directory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobNames("site/about.html", "site/index.html")),
+			),
+		},
+	})
+}
+
+func (r StorageBlobDirectoryResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := blobs.ParseBlobID(state.ID, client.Storage.StorageDomainSuffix)
+	if err != nil {
+		return nil, err
+	}
+	account, err := client.Storage.FindAccount(ctx, client.Account.SubscriptionId, id.AccountId.AccountName)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Account %q for %s", id.AccountId.AccountName, id)
+	}
+	containersClient, err := client.Storage.ContainersDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Containers Client: %+v", err)
+	}
+	items, err := containersClient.ListBlobs(ctx, id.ContainerName, id.BlobName)
+	if err != nil {
+		return nil, fmt.Errorf("listing Blobs for %s: %+v", id, err)
+	}
+	return pointer.To(len(*items) > 0), nil
+}
+
+func (r StorageBlobDirectoryResource) blobNames(expected ...string) func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		id, err := blobs.ParseBlobID(state.ID, clients.Storage.StorageDomainSuffix)
+		if err != nil {
+			return err
+		}
+		account, err := clients.Storage.FindAccount(ctx, clients.Account.SubscriptionId, id.AccountId.AccountName)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return fmt.Errorf("unable to locate Account %q for %s", id.AccountId.AccountName, id)
+		}
+		containersClient, err := clients.Storage.ContainersDataPlaneClient(ctx, *account, clients.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+		if err != nil {
+			return fmt.Errorf("building Containers Client: %+v", err)
+		}
+		items, err := containersClient.ListBlobs(ctx, id.ContainerName, id.BlobName)
+		if err != nil {
+			return fmt.Errorf("listing Blobs for %s: %+v", id, err)
+		}
+
+		actual := make([]string, 0)
+		for _, item := range *items {
+			actual = append(actual, item.Name)
+		}
+		if strings.Join(actual, ",") != strings.Join(expected, ",") {
+			return fmt.Errorf("expected the Blobs %q but got %q", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func (r StorageBlobDirectoryResource) sourceDirectory(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	for name, content := range files {
+		filePath := filepath.Join(directory, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+	return directory
+}
+
+func (r StorageBlobDirectoryResource) basic(data acceptance.TestData, directory string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_storage_blob_directory" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  source_directory       = %q
+}
+`, r.template(data), directory)
+}
+
+func (r StorageBlobDirectoryResource) complete(data acceptance.TestData, directory string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_storage_blob_directory" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  source_directory       = %q
+  prefix                 = "site"
+
+  exclude_patterns = [
+    "*.map",
+  ]
+
+  content_types = {
+    ".bin" = "application/x-model"
+  }
+
+  cache_control             = "max-age=60"
+  delete_extraneous_enabled = true
+  parallelism               = 4
+}
+`, r.template(data), directory)
+}
+
+func (r StorageBlobDirectoryResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,496 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/accounts"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/datalakestore/paths"
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
+)
+
+type StorageDataLakeGen2PathRecursiveAclResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageDataLakeGen2PathRecursiveAclResource{}
+
+type StorageDataLakeGen2PathRecursiveAclResourceModel struct {
+	StorageAccountId         string                     `tfschema:"storage_account_id"`
+	FileSystemName           string                     `tfschema:"filesystem_name"`
+	Path                     string                     `tfschema:"path"`
+	Mode                     string                     `tfschema:"mode"`
+	Ace                      []DataLakeGen2RecursiveAce `tfschema:"ace"`
+	BatchSize                int64                      `tfschema:"batch_size"`
+	ContinueOnFailureEnabled bool                       `tfschema:"continue_on_failure_enabled"`
+}
+
+type DataLakeGen2RecursiveAce struct {
+	Scope       string `tfschema:"scope"`
+	Type        string `tfschema:"type"`
+	Id          string `tfschema:"id"`
+	Permissions string `tfschema:"permissions"`
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) ResourceType() string {
+	return "azurerm_storage_data_lake_gen2_path_recursive_acl"
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) ModelObject() interface{} {
+	return &StorageDataLakeGen2PathRecursiveAclResourceModel{}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.IsURLWithHTTPS
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"filesystem_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateStorageDataLakeGen2FileSystemName,
+		},
+
+		"ace": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"scope": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"default", "access"}, false),
+						Default:      "access",
+					},
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"user", "group", "mask", "other"}, false),
+					},
+					"id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+					"permissions": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ADLSAccessControlPermissions,
+					},
+				},
+			},
+		},
+
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(DataLakeGen2AccessControlRecursiveModeModify),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(DataLakeGen2AccessControlRecursiveModeModify),
+				string(DataLakeGen2AccessControlRecursiveModeSet),
+			}, false),
+		},
+
+		"batch_size": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      2000,
+			ValidateFunc: validation.IntBetween(1, 2000),
+		},
+
+		"continue_on_failure_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StorageDataLakeGen2PathRecursiveAclResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountResourceManagerId, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, accountResourceManagerId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountResourceManagerId, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating %s", accountResourceManagerId)
+			}
+
+			endpoint, err := account.DataPlaneEndpoint(client.EndpointTypeDfs)
+			if err != nil {
+				return fmt.Errorf("determining Data Lake Gen2 Filesystems endpoint: %+v", err)
+			}
+			accountId, err := accounts.ParseAccountID(*endpoint, storageClient.StorageDomainSuffix)
+			if err != nil {
+				return fmt.Errorf("parsing Account ID: %+v", err)
+			}
+
+			id := paths.NewPathID(*accountId, model.FileSystemName, model.Path)
+
+			pathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Data Lake Gen2 Paths Client: %+v", err)
+			}
+
+			resp, err := pathsClient.GetProperties(ctx, id.FileSystemName, id.Path, paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetStatus})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			acl, err := expandDataLakeGen2RecursiveAces(model.Ace)
+			if err != nil {
+				return err
+			}
+
+			input := DataLakeGen2AccessControlRecursiveInput{
+				ACL:               acl.String(),
+				Mode:              DataLakeGen2AccessControlRecursiveMode(model.Mode),
+				BatchSize:         int(model.BatchSize),
+				ContinueOnFailure: model.ContinueOnFailureEnabled,
+			}
+			log.Printf("[DEBUG] Applying the ACL %q recursively to %s..", input.ACL, id)
+			if _, err := setDataLakeGen2AccessControlRecursive(ctx, pathsClient, id.FileSystemName, id.Path, input); err != nil {
+				return fmt.Errorf("applying the ACL recursively to %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := paths.ParsePathID(metadata.ResourceData.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			var state StorageDataLakeGen2PathRecursiveAclResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				log.Printf("[DEBUG] Unable to locate Account %q for %s - assuming removed & removing from state!", id.AccountId.AccountName, id)
+				return metadata.MarkAsGone(id)
+			}
+
+			pathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Data Lake Gen2 Paths Client: %+v", err)
+			}
+
+			// only the ACL of the root of the tree is checked for drift, since retrieving the ACL of every path doesn't scale
+			resp, err := pathsClient.GetProperties(ctx, id.FileSystemName, id.Path, paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetAccessControl})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving ACLs for %s: %+v", id, err)
+			}
+
+			acl, err := accesscontrol.ParseACL(resp.ACL)
+			if err != nil {
+				return fmt.Errorf("parsing response ACL %q: %+v", resp.ACL, err)
+			}
+
+			state.StorageAccountId = account.StorageAccountId.ID()
+			state.FileSystemName = id.FileSystemName
+			state.Path = id.Path
+			if state.Mode == "" {
+				state.Mode = string(DataLakeGen2AccessControlRecursiveModeModify)
+			}
+			if state.BatchSize == 0 {
+				state.BatchSize = 2000
+			}
+			state.Ace = flattenDataLakeGen2RecursiveAces(acl, state.Ace, DataLakeGen2AccessControlRecursiveMode(state.Mode))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := paths.ParsePathID(metadata.ResourceData.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			var model StorageDataLakeGen2PathRecursiveAclResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !metadata.ResourceData.HasChange("ace") {
+				return nil
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q", id.AccountId.AccountName)
+			}
+
+			pathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Data Lake Gen2 Paths Client: %+v", err)
+			}
+
+			input := DataLakeGen2AccessControlRecursiveInput{
+				Mode:              DataLakeGen2AccessControlRecursiveMode(model.Mode),
+				BatchSize:         int(model.BatchSize),
+				ContinueOnFailure: model.ContinueOnFailureEnabled,
+			}
+
+			// when modifying the existing ACLs any entries which have been removed from the configuration must be removed explicitly
+			if input.Mode == DataLakeGen2AccessControlRecursiveModeModify {
+				previous := make([]DataLakeGen2RecursiveAce, 0)
+				old, _ := metadata.ResourceData.GetChange("ace")
+				for _, raw := range old.(*pluginsdk.Set).List() {
+					v := raw.(map[string]interface{})
+					previous = append(previous, DataLakeGen2RecursiveAce{
+						Scope: v["scope"].(string),
+						Type:  v["type"].(string),
+						Id:    v["id"].(string),
+					})
+				}
+
+				if removed := dataLakeGen2RecursiveAceRemovals(previous, model.Ace); removed != "" {
+					removeInput := input
+					removeInput.ACL = removed
+					removeInput.Mode = DataLakeGen2AccessControlRecursiveModeRemove
+					log.Printf("[DEBUG] Removing the ACL entries %q recursively from %s..", removed, id)
+					if _, err := setDataLakeGen2AccessControlRecursive(ctx, pathsClient, id.FileSystemName, id.Path, removeInput); err != nil {
+						return fmt.Errorf("removing ACL entries recursively from %s: %+v", id, err)
+					}
+				}
+			}
+
+			acl, err := expandDataLakeGen2RecursiveAces(model.Ace)
+			if err != nil {
+				return err
+			}
+			input.ACL = acl.String()
+
+			log.Printf("[DEBUG] Applying the ACL %q recursively to %s..", input.ACL, id)
+			if _, err := setDataLakeGen2AccessControlRecursive(ctx, pathsClient, id.FileSystemName, id.Path, input); err != nil {
+				return fmt.Errorf("applying the ACL recursively to %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := paths.ParsePathID(metadata.ResourceData.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			var model StorageDataLakeGen2PathRecursiveAclResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the previous ACLs aren't known, so when they've been replaced there's nothing which can be restored
+			if DataLakeGen2AccessControlRecursiveMode(model.Mode) == DataLakeGen2AccessControlRecursiveModeSet {
+				log.Printf("[DEBUG] The ACL for %s was set rather than modified, removing from state only", id)
+				return nil
+			}
+
+			removed := dataLakeGen2RecursiveAceRemovals(model.Ace, nil)
+			if removed == "" {
+				return nil
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				return nil
+			}
+
+			pathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Data Lake Gen2 Paths Client: %+v", err)
+			}
+
+			input := DataLakeGen2AccessControlRecursiveInput{
+				ACL:               removed,
+				Mode:              DataLakeGen2AccessControlRecursiveModeRemove,
+				BatchSize:         int(model.BatchSize),
+				ContinueOnFailure: model.ContinueOnFailureEnabled,
+			}
+			log.Printf("[DEBUG] Removing the ACL entries %q recursively from %s..", removed, id)
+			if _, err := setDataLakeGen2AccessControlRecursive(ctx, pathsClient, id.FileSystemName, id.Path, input); err != nil {
+				return fmt.Errorf("removing ACL entries recursively from %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDataLakeGen2RecursiveAces(input []DataLakeGen2RecursiveAce) (*accesscontrol.ACL, error) {
+	entries := make([]accesscontrol.ACE, 0)
+	for _, v := range input {
+		var id *uuid.UUID
+		if v.Id != "" {
+			parsed, err := uuid.Parse(v.Id)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `id` %q: %+v", v.Id, err)
+			}
+			id = &parsed
+		}
+
+		entries = append(entries, accesscontrol.ACE{
+			IsDefault:    v.Scope == "default",
+			TagType:      accesscontrol.TagType(v.Type),
+			TagQualifier: id,
+			Permissions:  v.Permissions,
+		})
+	}
+
+	return &accesscontrol.ACL{Entries: entries}, nil
+}
+
+// flattenDataLakeGen2RecursiveAces returns the entries from the ACL which are tracked by this resource - these are the
+// entries within the configuration, as well as any other named entries when the ACL is set rather than modified, or
+// when nothing is configured (e.g. when importing)
+func flattenDataLakeGen2RecursiveAces(acl accesscontrol.ACL, configured []DataLakeGen2RecursiveAce, mode DataLakeGen2AccessControlRecursiveMode) []DataLakeGen2RecursiveAce {
+	keys := make(map[string]struct{})
+	for _, v := range configured {
+		keys[dataLakeGen2RecursiveAceKey(v)] = struct{}{}
+	}
+
+	output := make([]DataLakeGen2RecursiveAce, 0)
+	for _, v := range acl.Entries {
+		ace := DataLakeGen2RecursiveAce{
+			Scope:       "access",
+			Type:        string(v.TagType),
+			Permissions: v.Permissions,
+		}
+		if v.IsDefault {
+			ace.Scope = "default"
+		}
+		if v.TagQualifier != nil {
+			ace.Id = v.TagQualifier.String()
+		}
+
+		if _, ok := keys[dataLakeGen2RecursiveAceKey(ace)]; !ok && ((mode != DataLakeGen2AccessControlRecursiveModeSet && len(configured) > 0) || ace.Id == "") {
+			continue
+		}
+
+		output = append(output, ace)
+	}
+
+	return output
+}
+
+// dataLakeGen2RecursiveAceRemovals returns the ACL (without permissions) of the named entries within `existing`
+// which don't exist within `updated`, since only named entries can be removed
+func dataLakeGen2RecursiveAceRemovals(existing []DataLakeGen2RecursiveAce, updated []DataLakeGen2RecursiveAce) string {
+	keys := make(map[string]struct{})
+	for _, v := range updated {
+		keys[dataLakeGen2RecursiveAceKey(v)] = struct{}{}
+	}
+
+	removed := make([]string, 0)
+	for _, v := range existing {
+		if v.Id == "" {
+			continue
+		}
+		key := dataLakeGen2RecursiveAceKey(v)
+		if _, ok := keys[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	return strings.Join(removed, ",")
+}
+
+// dataLakeGen2RecursiveAceKey returns the entry in the format `[default:]type:id` used to remove entries
+func dataLakeGen2RecursiveAceKey(input DataLakeGen2RecursiveAce) string {
+	key := fmt.Sprintf("%s:%s", input.Type, strings.ToLower(input.Id))
+	if input.Scope == "default" {
+		key = "default:" + key
+	}
+	return key
+}
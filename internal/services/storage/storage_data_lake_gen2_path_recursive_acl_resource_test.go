@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/datalakestore/paths"
+)
+
+type StorageDataLakeGen2PathRecursiveAclResource struct{}
+
+func TestAccStorageDataLakeGen2PathRecursiveAcl_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_recursive_acl", "test")
+	r := StorageDataLakeGen2PathRecursiveAclResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "r-x"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageDataLakeGen2PathRecursiveAcl_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_recursive_acl", "test")
+	r := StorageDataLakeGen2PathRecursiveAclResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "r-x"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.basic(data, "rwx"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageDataLakeGen2PathRecursiveAcl_set(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_recursive_acl", "test")
+	r := StorageDataLakeGen2PathRecursiveAclResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.set(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := paths.ParsePathID(state.ID, client.Storage.StorageDomainSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, client.Account.SubscriptionId, id.AccountId.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Account %q for %s", id.AccountId.AccountName, id)
+	}
+
+	pathsClient, err := client.Storage.DataLakePathsDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Data Lake Gen2 Paths Client: %+v", err)
+	}
+
+	resp, err := pathsClient.GetProperties(ctx, id.FileSystemName, id.Path, paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetAccessControl})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving ACLs for %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) basic(data acceptance.TestData, permissions string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_recursive_acl" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = azurerm_storage_data_lake_gen2_path.test.path
+  depends_on         = [azurerm_storage_data_lake_gen2_path.child]
+
+  ace {
+    type        = "user"
+    id          = data.azurerm_client_config.current.object_id
+    permissions = "%s"
+  }
+}
+`, r.template(data), permissions)
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_recursive_acl" "test" {
+  storage_account_id          = azurerm_storage_account.test.id
+  filesystem_name             = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path                        = azurerm_storage_data_lake_gen2_path.test.path
+  depends_on                  = [azurerm_storage_data_lake_gen2_path.child]
+  batch_size                  = 10
+  continue_on_failure_enabled = true
+
+  ace {
+    type        = "user"
+    id          = data.azurerm_client_config.current.object_id
+    permissions = "r-x"
+  }
+
+  ace {
+    scope       = "default"
+    type        = "user"
+    id          = data.azurerm_client_config.current.object_id
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "other"
+    permissions = "--x"
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) set(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_recursive_acl" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = azurerm_storage_data_lake_gen2_path.test.path
+  depends_on         = [azurerm_storage_data_lake_gen2_path.child]
+  mode               = "set"
+
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+
+  ace {
+    type        = "user"
+    id          = data.azurerm_client_config.current.object_id
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "mask"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Owner"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "fstest"
+  storage_account_id = azurerm_storage_account.test.id
+
+  depends_on = [
+    azurerm_role_assignment.test
+  ]
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "testpath"
+  resource           = "directory"
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "child" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "${azurerm_storage_data_lake_gen2_path.test.path}/child"
+  resource           = "directory"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/tables"
+)
+
+type StorageTableEntitiesResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = StorageTableEntitiesResource{}
+	_ sdk.ResourceWithCustomizeDiff = StorageTableEntitiesResource{}
+)
+
+type StorageTableEntitiesResourceModel struct {
+	StorageTableId string                       `tfschema:"storage_table_id"`
+	SourceFile     string                       `tfschema:"source_file"`
+	Entity         []TableEntityDataSourceModel `tfschema:"entity"`
+}
+
+func (r StorageTableEntitiesResource) ResourceType() string {
+	return "azurerm_storage_table_entities"
+}
+
+func (r StorageTableEntitiesResource) ModelObject() interface{} {
+	return &StorageTableEntitiesResourceModel{}
+}
+
+func (r StorageTableEntitiesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return storageValidate.StorageTableDataPlaneID
+}
+
+func (r StorageTableEntitiesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_table_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storageValidate.StorageTableDataPlaneID,
+		},
+
+		"entity": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			// when `source_file` is specified the Entities defined within the file are exposed here
+			Computed:     true,
+			ExactlyOneOf: []string{"entity", "source_file"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"partition_key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"row_key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"properties": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"source_file": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"entity", "source_file"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageTableEntitiesResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			var config StorageTableEntitiesResourceModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if config.SourceFile == "" {
+				if !diff.NewValueKnown("entity") {
+					return nil
+				}
+				return validateTableEntities(config.Entity)
+			}
+
+			// the file may not exist until apply time, for example when it's generated by another resource
+			if !diff.NewValueKnown("source_file") {
+				return diff.SetNewComputed("entity")
+			}
+
+			desired, err := loadTableEntitiesFromFile(config.SourceFile)
+			if err != nil {
+				return err
+			}
+
+			if len(desired) != len(config.Entity) || len(tableEntityOperations(config.Entity, desired)) > 0 {
+				return diff.SetNew("entity", flattenTableEntities(desired))
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StorageTableEntitiesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := tables.ParseTableID(model.StorageTableId, storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			if model.SourceFile != "" {
+				if model.Entity, err = loadTableEntitiesFromFile(model.SourceFile); err != nil {
+					return err
+				}
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q", id.AccountId.AccountName)
+			}
+
+			client, err := storageClient.TableEntityDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Table Entity Client for %s: %+v", account.StorageAccountId, err)
+			}
+
+			log.Printf("[DEBUG] Writing %d Entities into %s..", len(model.Entity), id)
+			if err := executeTableEntityOperations(ctx, client, id.TableName, tableEntityOperations(nil, model.Entity)); err != nil {
+				return fmt.Errorf("creating Entities within %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			var state StorageTableEntitiesResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				log.Printf("[DEBUG] Unable to locate Storage Account %q for %s - assuming removed & removing from state!", id.AccountId.AccountName, id)
+				return metadata.MarkAsGone(id)
+			}
+
+			client, err := storageClient.TableEntityDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Table Entity Client for %s: %+v", account.StorageAccountId, err)
+			}
+
+			remote, err := readTableEntities(ctx, client, id.TableName, state.Entity)
+			if err != nil {
+				return fmt.Errorf("retrieving Entities within %s: %+v", id, err)
+			}
+			if remote == nil {
+				log.Printf("[DEBUG] %s was not found - removing from state!", id)
+				return metadata.MarkAsGone(id)
+			}
+
+			state.StorageTableId = id.ID()
+
+			// when imported no Entities are known, as such every Entity within the table is adopted
+			if len(state.Entity) == 0 && state.SourceFile == "" {
+				for _, entity := range remote {
+					state.Entity = append(state.Entity, entity)
+				}
+				sortTableEntities(state.Entity)
+				return metadata.Encode(&state)
+			}
+
+			// Entities which have been removed outside of Terraform are dropped, so that they're recreated
+			existing := make([]TableEntityDataSourceModel, 0)
+			for _, entity := range state.Entity {
+				if v, ok := remote[tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}]; ok {
+					existing = append(existing, v)
+				}
+			}
+			state.Entity = existing
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			var model StorageTableEntitiesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if model.SourceFile != "" {
+				if model.Entity, err = loadTableEntitiesFromFile(model.SourceFile); err != nil {
+					return err
+				}
+			}
+
+			old, _ := metadata.ResourceData.GetChange("entity")
+			existing := expandTableEntities(old.([]interface{}))
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q", id.AccountId.AccountName)
+			}
+
+			client, err := storageClient.TableEntityDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Table Entity Client for %s: %+v", account.StorageAccountId, err)
+			}
+
+			operations := tableEntityOperations(existing, model.Entity)
+			log.Printf("[DEBUG] Applying %d changes to the Entities within %s..", len(operations), id)
+			if err := executeTableEntityOperations(ctx, client, id.TableName, operations); err != nil {
+				return fmt.Errorf("updating Entities within %s: %+v", id, err)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			var model StorageTableEntitiesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q", id.AccountId.AccountName)
+			}
+
+			client, err := storageClient.TableEntityDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Table Entity Client for %s: %+v", account.StorageAccountId, err)
+			}
+
+			if err := executeTableEntityOperations(ctx, client, id.TableName, tableEntityOperations(model.Entity, nil)); err != nil {
+				return fmt.Errorf("deleting Entities within %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandTableEntities(input []interface{}) []TableEntityDataSourceModel {
+	result := make([]TableEntityDataSourceModel, 0)
+	for _, item := range input {
+		raw, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		entity := TableEntityDataSourceModel{
+			PartitionKey: raw["partition_key"].(string),
+			RowKey:       raw["row_key"].(string),
+			Properties:   map[string]interface{}{},
+		}
+		if v, ok := raw["properties"].(map[string]interface{}); ok {
+			entity.Properties = v
+		}
+		result = append(result, entity)
+	}
+
+	return result
+}
+
+func flattenTableEntities(input []TableEntityDataSourceModel) []interface{} {
+	result := make([]interface{}, 0)
+	for _, entity := range input {
+		properties := make(map[string]interface{}, len(entity.Properties))
+		for k, v := range entity.Properties {
+			properties[k] = fmt.Sprint(v)
+		}
+
+		result = append(result, map[string]interface{}{
+			"partition_key": entity.PartitionKey,
+			"row_key":       entity.RowKey,
+			"properties":    properties,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/entities"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/tables"
+)
+
+type StorageTableEntitiesResource struct{}
+
+func TestAccStorageTableEntities_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTableEntities_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTableEntities_sourceFile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+	directory := t.TempDir()
+	csvFile := r.sourceFile(t, directory, "entities.csv", `PartitionKey,RowKey,Name,Count,Count@odata.type
+first,a,Alice,1,Edm.Int32
+first,b,Bob,2,Edm.Int32
+second,a,Carol,,
+`)
+	jsonFile := r.sourceFile(t, directory, "entities.json", `[
+  {"PartitionKey": "first", "RowKey": "a", "Name": "Alice", "Count": 3},
+  {"PartitionKey": "third", "RowKey": "a", "Name": "Dave", "Enabled": true}
+]`)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sourceFileConfig(data, csvFile),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("3"),
+			),
+		},
+		data.ImportStep("source_file"),
+		{
+			Config: r.sourceFileConfig(data, jsonFile),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("source_file"),
+	})
+}
+
+func (r StorageTableEntitiesResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tables.ParseTableID(state.ID, client.Storage.StorageDomainSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, client.Account.SubscriptionId, id.AccountId.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for %s: %+v", id.AccountId.AccountName, id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Account %q for %s", id.AccountId.AccountName, id)
+	}
+
+	entitiesClient, err := client.Storage.TableEntityDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Table Entity Client: %+v", err)
+	}
+
+	input := entities.GetEntityInput{
+		PartitionKey:  state.Attributes["entity.0.partition_key"],
+		RowKey:        state.Attributes["entity.0.row_key"],
+		MetaDataLevel: entities.NoMetaData,
+	}
+	resp, err := entitiesClient.Get(ctx, id.TableName, input)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Entity (Partition Key %q / Row Key %q) within %s: %+v", input.PartitionKey, input.RowKey, id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r StorageTableEntitiesResource) sourceFile(t *testing.T, directory, name, content string) string {
+	filePath := filepath.Join(directory, name)
+	if err := os.WriteFile(filePath, []byte(content), 0o644); err != nil {
+		t.Fatalf("writing %q: %+v", name, err)
+	}
+	return filePath
+}
+
+func (r StorageTableEntitiesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_table_id = azurerm_storage_table.test.id
+
+  entity {
+    partition_key = "first"
+    row_key       = "a"
+
+    properties = {
+      Name = "Alice"
+    }
+  }
+
+  entity {
+    partition_key = "first"
+    row_key       = "b"
+
+    properties = {
+      Name               = "Bob"
+      Count              = "2"
+      "Count@odata.type" = "Edm.Int32"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageTableEntitiesResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_table_id = azurerm_storage_table.test.id
+
+  entity {
+    partition_key = "first"
+    row_key       = "a"
+
+    properties = {
+      Name                 = "Alice"
+      Enabled              = "true"
+      "Enabled@odata.type" = "Edm.Boolean"
+    }
+  }
+
+  entity {
+    partition_key = "second"
+    row_key       = "a"
+
+    properties = {
+      Name = "Carol"
+    }
+  }
+
+  entity {
+    partition_key = "second"
+    row_key       = "b"
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageTableEntitiesResource) sourceFileConfig(data acceptance.TestData, sourceFile string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_table_id = azurerm_storage_table.test.id
+  source_file      = %q
+}
+`, r.template(data), sourceFile)
+}
+
+func (r StorageTableEntitiesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%[1]d"
+  storage_account_name = azurerm_storage_account.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/entities"
+)
+
+// tableEntitiesMaxPartitionQueries is the number of distinct partitions above which the whole table is queried,
+// rather than querying each partition individually
+const tableEntitiesMaxPartitionQueries = 25
+
+type tableEntityKey struct {
+	PartitionKey string
+	RowKey       string
+}
+
+// loadTableEntitiesFromFile parses the Entities defined within either a CSV or JSON file, sorted by their keys
+func loadTableEntitiesFromFile(path string) ([]TableEntityDataSourceModel, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening %q: %+v", path, err)
+	}
+	defer file.Close()
+
+	var result []TableEntityDataSourceModel
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		result, err = parseTableEntitiesCsv(file)
+	case ".json":
+		result, err = parseTableEntitiesJson(file)
+	default:
+		return nil, fmt.Errorf("%q must be either a `.csv` or `.json` file but got %q", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", path, err)
+	}
+
+	sortTableEntities(result)
+
+	return result, validateTableEntities(result)
+}
+
+func sortTableEntities(input []TableEntityDataSourceModel) {
+	sort.SliceStable(input, func(i, j int) bool {
+		if input[i].PartitionKey != input[j].PartitionKey {
+			return input[i].PartitionKey < input[j].PartitionKey
+		}
+		return input[i].RowKey < input[j].RowKey
+	})
+}
+
+// parseTableEntitiesCsv parses a CSV file where the header row names each property - the `PartitionKey` and `RowKey`
+// columns are required, a property can be typed using a `<name>@odata.type` column and empty cells are omitted
+func parseTableEntitiesCsv(input io.Reader) ([]TableEntityDataSourceModel, error) {
+	reader := csv.NewReader(input)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("the header row is missing")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hasPartitionKey, hasRowKey := false, false
+	for _, column := range header {
+		hasPartitionKey = hasPartitionKey || column == "PartitionKey"
+		hasRowKey = hasRowKey || column == "RowKey"
+	}
+	if !hasPartitionKey || !hasRowKey {
+		return nil, fmt.Errorf("the header row must contain both a `PartitionKey` and `RowKey` column")
+	}
+
+	result := make([]TableEntityDataSourceModel, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entity := TableEntityDataSourceModel{
+			Properties: map[string]interface{}{},
+		}
+		for i, value := range record {
+			switch header[i] {
+			case "PartitionKey":
+				entity.PartitionKey = value
+			case "RowKey":
+				entity.RowKey = value
+			default:
+				if value != "" {
+					entity.Properties[header[i]] = value
+				}
+			}
+		}
+		result = append(result, entity)
+	}
+
+	return result, nil
+}
+
+// parseTableEntitiesJson parses a JSON array of objects, each of which contains the `PartitionKey`, `RowKey` and
+// properties of an Entity - the properties are typed from their JSON type unless a `<name>@odata.type` is specified
+func parseTableEntitiesJson(input io.Reader) ([]TableEntityDataSourceModel, error) {
+	var items []map[string]interface{}
+	if err := json.NewDecoder(input).Decode(&items); err != nil {
+		return nil, err
+	}
+
+	result := make([]TableEntityDataSourceModel, 0)
+	for i, item := range items {
+		for _, key := range []string{"PartitionKey", "RowKey"} {
+			if _, ok := item[key].(string); !ok {
+				return nil, fmt.Errorf("item %d: `%s` must be a string", i, key)
+			}
+		}
+
+		for k, v := range item {
+			switch v.(type) {
+			case bool, float64:
+			case string:
+				// numeric values which have been explicitly typed are sent as numbers, with the exception of 64-bit
+				// integers - which the API represents as strings
+				dtype := item[k+"@odata.type"]
+				if dtype != "Edm.Double" && dtype != "Edm.Int32" {
+					continue
+				}
+				f, err := strconv.ParseFloat(v.(string), 64)
+				if err != nil {
+					return nil, fmt.Errorf("item %d: `%s` must be a number: %+v", i, k, err)
+				}
+				item[k] = f
+			default:
+				return nil, fmt.Errorf("item %d: `%s` must be a string, number or boolean", i, k)
+			}
+		}
+
+		result = append(result, flattenEntityWithMetadata(item))
+	}
+
+	return result, nil
+}
+
+// validateTableEntities ensures each Entity has a Partition Key and a Row Key, and that each Entity is only defined once
+func validateTableEntities(input []TableEntityDataSourceModel) error {
+	seen := make(map[tableEntityKey]struct{}, len(input))
+	for i, entity := range input {
+		if entity.PartitionKey == "" || entity.RowKey == "" {
+			return fmt.Errorf("entity %d: the Partition Key and Row Key must be specified", i)
+		}
+
+		key := tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("the Entity with Partition Key %q and Row Key %q is defined more than once", entity.PartitionKey, entity.RowKey)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// tableEntityOperations returns the operations required to change the Entities from `existing` to `desired` -
+// Entities which are new or whose properties have changed are replaced, and those which are no longer desired are deleted
+func tableEntityOperations(existing, desired []TableEntityDataSourceModel) []TableEntityOperation {
+	existingByKey := make(map[tableEntityKey]TableEntityDataSourceModel, len(existing))
+	for _, entity := range existing {
+		existingByKey[tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}] = entity
+	}
+
+	operations := make([]TableEntityOperation, 0)
+	desiredKeys := make(map[tableEntityKey]struct{}, len(desired))
+	for _, entity := range desired {
+		key := tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}
+		desiredKeys[key] = struct{}{}
+
+		if current, ok := existingByKey[key]; ok && tableEntityPropertiesEqual(current.Properties, entity.Properties) {
+			continue
+		}
+
+		operations = append(operations, TableEntityOperation{
+			Type:         TableEntityOperationTypeInsertOrReplace,
+			PartitionKey: entity.PartitionKey,
+			RowKey:       entity.RowKey,
+			Properties:   entity.Properties,
+		})
+	}
+
+	for _, entity := range existing {
+		if _, ok := desiredKeys[tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}]; ok {
+			continue
+		}
+
+		operations = append(operations, TableEntityOperation{
+			Type:         TableEntityOperationTypeDelete,
+			PartitionKey: entity.PartitionKey,
+			RowKey:       entity.RowKey,
+		})
+	}
+
+	return operations
+}
+
+func tableEntityPropertiesEqual(first, second map[string]interface{}) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for k, v := range first {
+		other, ok := second[k]
+		if !ok || fmt.Sprint(v) != fmt.Sprint(other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// readTableEntities retrieves the Entities within the partitions used by `managed`, or every Entity within the table
+// when `managed` is empty - returning nil when the table doesn't exist
+func readTableEntities(ctx context.Context, entitiesClient *entities.Client, tableName string, managed []TableEntityDataSourceModel) (map[tableEntityKey]TableEntityDataSourceModel, error) {
+	partitions := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, entity := range managed {
+		if _, ok := seen[entity.PartitionKey]; !ok {
+			partitions = append(partitions, entity.PartitionKey)
+			seen[entity.PartitionKey] = struct{}{}
+		}
+	}
+
+	filters := make([]*string, 0)
+	if len(partitions) == 0 || len(partitions) > tableEntitiesMaxPartitionQueries {
+		filters = append(filters, nil)
+	} else {
+		for _, partitionKey := range partitions {
+			filter := fmt.Sprintf("PartitionKey eq '%s'", strings.ReplaceAll(partitionKey, "'", "''"))
+			filters = append(filters, &filter)
+		}
+	}
+
+	result := make(map[tableEntityKey]TableEntityDataSourceModel)
+	for _, filter := range filters {
+		input := entities.QueryEntitiesInput{
+			Filter:        filter,
+			MetaDataLevel: entities.MinimalMetaData,
+		}
+
+		for {
+			resp, err := entitiesClient.Query(ctx, tableName, input)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil, nil
+				}
+				return nil, err
+			}
+
+			for _, item := range resp.Entities {
+				entity := flattenEntityWithMetadata(item)
+				result[tableEntityKey{PartitionKey: entity.PartitionKey, RowKey: entity.RowKey}] = entity
+			}
+
+			nextPartitionKey := resp.HttpResponse.Header.Get("x-ms-continuation-NextPartitionKey")
+			if nextPartitionKey == "" {
+				break
+			}
+			nextRowKey := resp.HttpResponse.Header.Get("x-ms-continuation-NextRowKey")
+			input.NextPartitionKey = &nextPartitionKey
+			input.NextRowKey = &nextRowKey
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestLoadTableEntitiesFromFile(t *testing.T) {
+	cases := []struct {
+		Name          string
+		FileName      string
+		Content       string
+		Expected      []string
+		ExpectedError string
+	}{
+		{
+			Name:     "CSV",
+			FileName: "entities.csv",
+			Content: `PartitionKey,RowKey,Name,Count,Count@odata.type
+second,a,Bob,2,Edm.Int32
+first,b,"Smith, Alice",,
+first,a,Carol,1,Edm.Int32
+`,
+			Expected: []string{
+				"first/a: Count=1,Count@odata.type=Edm.Int32,Name=Carol",
+				"first/b: Name=Smith, Alice",
+				"second/a: Count=2,Count@odata.type=Edm.Int32,Name=Bob",
+			},
+		},
+		{
+			Name:          "CSV Missing Row Key",
+			FileName:      "entities.csv",
+			Content:       "PartitionKey,Name\nfirst,Alice\n",
+			ExpectedError: "the header row must contain both a `PartitionKey` and `RowKey` column",
+		},
+		{
+			Name:          "CSV Empty Key",
+			FileName:      "entities.csv",
+			Content:       "PartitionKey,RowKey\nfirst,\n",
+			ExpectedError: "entity 0: the Partition Key and Row Key must be specified",
+		},
+		{
+			Name:     "JSON",
+			FileName: "entities.json",
+			Content: `[
+  {"PartitionKey": "first", "RowKey": "b", "Enabled": true, "Ratio": 1.5, "Count": 3},
+  {"PartitionKey": "first", "RowKey": "a", "Large": "9223372036854775807", "Large@odata.type": "Edm.Int64", "Small": "4", "Small@odata.type": "Edm.Int32"}
+]`,
+			Expected: []string{
+				"first/a: Large=9223372036854775807,Large@odata.type=Edm.Int64,Small=4,Small@odata.type=Edm.Int32",
+				"first/b: Count=3,Count@odata.type=Edm.Int32,Enabled=true,Enabled@odata.type=Edm.Boolean,Ratio=1.5,Ratio@odata.type=Edm.Double",
+			},
+		},
+		{
+			Name:          "JSON Nested Property",
+			FileName:      "entities.json",
+			Content:       `[{"PartitionKey": "first", "RowKey": "a", "Nested": {"Name": "Alice"}}]`,
+			ExpectedError: "item 0: `Nested` must be a string, number or boolean",
+		},
+		{
+			Name:          "JSON Numeric Key",
+			FileName:      "entities.json",
+			Content:       `[{"PartitionKey": "first", "RowKey": 1}]`,
+			ExpectedError: "item 0: `RowKey` must be a string",
+		},
+		{
+			Name:          "JSON Duplicate Entity",
+			FileName:      "entities.json",
+			Content:       `[{"PartitionKey": "first", "RowKey": "a"}, {"PartitionKey": "first", "RowKey": "a"}]`,
+			ExpectedError: `the Entity with Partition Key "first" and Row Key "a" is defined more than once`,
+		},
+		{
+			Name:          "Unsupported Extension",
+			FileName:      "entities.yaml",
+			Content:       "",
+			ExpectedError: "must be either a `.csv` or `.json` file",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		path := filepath.Join(t.TempDir(), tc.FileName)
+		if err := os.WriteFile(path, []byte(tc.Content), 0o600); err != nil {
+			t.Fatalf("writing %q: %+v", path, err)
+		}
+
+		result, err := loadTableEntitiesFromFile(path)
+		if tc.ExpectedError != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+				t.Fatalf("expected an error containing %q but got %v", tc.ExpectedError, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		actual := make([]string, 0)
+		for _, entity := range result {
+			actual = append(actual, formatTestTableEntity(entity))
+		}
+		if strings.Join(actual, "\n") != strings.Join(tc.Expected, "\n") {
+			t.Fatalf("expected:\n%s\n\nbut got:\n%s", strings.Join(tc.Expected, "\n"), strings.Join(actual, "\n"))
+		}
+	}
+}
+
+func TestTableEntityOperations(t *testing.T) {
+	existing := []TableEntityDataSourceModel{
+		{PartitionKey: "first", RowKey: "a", Properties: map[string]interface{}{"Name": "Alice"}},
+		{PartitionKey: "first", RowKey: "b", Properties: map[string]interface{}{"Name": "Bob"}},
+		{PartitionKey: "second", RowKey: "a", Properties: map[string]interface{}{"Name": "Carol"}},
+	}
+	desired := []TableEntityDataSourceModel{
+		{PartitionKey: "first", RowKey: "a", Properties: map[string]interface{}{"Name": "Alice"}},
+		{PartitionKey: "first", RowKey: "b", Properties: map[string]interface{}{"Name": "Bob", "Count": "2"}},
+		{PartitionKey: "third", RowKey: "a", Properties: map[string]interface{}{}},
+	}
+
+	actual := make([]string, 0)
+	for _, operation := range tableEntityOperations(existing, desired) {
+		actual = append(actual, fmt.Sprintf("%s %s/%s", operation.Type, operation.PartitionKey, operation.RowKey))
+	}
+	if expected := "PUT first/b,PUT third/a,DELETE second/a"; strings.Join(actual, ",") != expected {
+		t.Fatalf("expected %q but got %q", expected, strings.Join(actual, ","))
+	}
+
+	if operations := tableEntityOperations(desired, desired); len(operations) != 0 {
+		t.Fatalf("expected no operations when nothing has changed but got %d", len(operations))
+	}
+}
+
+func formatTestTableEntity(input TableEntityDataSourceModel) string {
+	properties := make([]string, 0)
+	for k, v := range input.Properties {
+		properties = append(properties, fmt.Sprintf("%s=%v", k, v))
+	}
+	sort.Strings(properties)
+
+	return fmt.Sprintf("%s/%s: %s", input.PartitionKey, input.RowKey, strings.Join(properties, ","))
+}
@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/entities"
+)
+
+// TODO: move this into Giovanni
+
+type TableEntityOperationType string
+
+const (
+	TableEntityOperationTypeDelete          TableEntityOperationType = "DELETE"
+	TableEntityOperationTypeInsertOrReplace TableEntityOperationType = "PUT"
+)
+
+// tableEntityGroupTransactionMaxOperations is the maximum number of operations the API accepts within a single change set
+const tableEntityGroupTransactionMaxOperations = 100
+
+type TableEntityOperation struct {
+	Type         TableEntityOperationType
+	PartitionKey string
+	RowKey       string
+
+	// Properties contains the properties of the entity, including any `@odata.type` annotations - and is only
+	// used when inserting or replacing the entity
+	Properties map[string]interface{}
+}
+
+type TableEntityGroupTransactionError struct {
+	// Index is the index of the operation within the change set which failed, or -1 when this can't be determined
+	Index      int
+	StatusCode int
+	Message    string
+}
+
+func (e TableEntityGroupTransactionError) Error() string {
+	return fmt.Sprintf("operation %d failed with status %d: %s", e.Index, e.StatusCode, e.Message)
+}
+
+// executeTableEntityOperations applies the operations using as few entity group transactions as possible - since a
+// transaction can only contain entities within a single partition, the operations are grouped by partition key and
+// then submitted in change sets of up to 100 operations. Deleting an entity which no longer exists isn't an error.
+func executeTableEntityOperations(ctx context.Context, entitiesClient *entities.Client, tableName string, operations []TableEntityOperation) error {
+	if tableName == "" {
+		return fmt.Errorf("`tableName` cannot be an empty string")
+	}
+
+	partitions := make([]string, 0)
+	operationsByPartition := make(map[string][]TableEntityOperation)
+	for _, operation := range operations {
+		if _, ok := operationsByPartition[operation.PartitionKey]; !ok {
+			partitions = append(partitions, operation.PartitionKey)
+		}
+		operationsByPartition[operation.PartitionKey] = append(operationsByPartition[operation.PartitionKey], operation)
+	}
+
+	for _, partitionKey := range partitions {
+		pending := operationsByPartition[partitionKey]
+		for len(pending) > 0 {
+			batch := pending
+			if len(batch) > tableEntityGroupTransactionMaxOperations {
+				batch = batch[:tableEntityGroupTransactionMaxOperations]
+			}
+			pending = pending[len(batch):]
+
+			if err := executeTableEntityChangeSet(ctx, entitiesClient, tableName, batch); err != nil {
+				return fmt.Errorf("applying %d changes to the Entities within Partition %q: %+v", len(batch), partitionKey, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// executeTableEntityChangeSet submits the operations as a single change set, which is atomic - as such when deleting
+// an Entity fails because it's already gone, the change set is re-submitted without that operation
+func executeTableEntityChangeSet(ctx context.Context, entitiesClient *entities.Client, tableName string, operations []TableEntityOperation) error {
+	for len(operations) > 0 {
+		err := executeTableEntityGroupTransaction(ctx, entitiesClient, tableName, operations)
+		if err == nil {
+			return nil
+		}
+
+		txErr, ok := err.(TableEntityGroupTransactionError)
+		if !ok || txErr.StatusCode != http.StatusNotFound || txErr.Index < 0 || txErr.Index >= len(operations) || operations[txErr.Index].Type != TableEntityOperationTypeDelete {
+			return err
+		}
+
+		removed := operations[txErr.Index]
+		log.Printf("[DEBUG] Entity (Partition Key %q / Row Key %q) was already deleted - retrying the remaining operations", removed.PartitionKey, removed.RowKey)
+		remaining := make([]TableEntityOperation, 0, len(operations)-1)
+		remaining = append(remaining, operations[:txErr.Index]...)
+		operations = append(remaining, operations[txErr.Index+1:]...)
+	}
+
+	return nil
+}
+
+func executeTableEntityGroupTransaction(ctx context.Context, entitiesClient *entities.Client, tableName string, operations []TableEntityOperation) error {
+	body, contentType, err := buildTableEntityGroupTransaction(entitiesClient.Client.BaseUri, tableName, operations)
+	if err != nil {
+		return err
+	}
+
+	opts := client.RequestOptions{
+		ContentType: contentType,
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+		},
+		HttpMethod:    http.MethodPost,
+		OptionsObject: tableEntityGroupTransactionOptions{},
+		Path:          "/$batch",
+	}
+
+	req, err := entitiesClient.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+
+	if err := req.Marshal(body); err != nil {
+		return fmt.Errorf("marshalling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("executing request: %+v", err)
+	}
+
+	return parseTableEntityGroupTransactionResponse(resp.Response)
+}
+
+// buildTableEntityGroupTransaction returns the body and content type of a batch request containing a single change set
+func buildTableEntityGroupTransaction(baseUri, tableName string, operations []TableEntityOperation) ([]byte, string, error) {
+	changeSet := &bytes.Buffer{}
+	changeSetWriter := multipart.NewWriter(changeSet)
+
+	for i, operation := range operations {
+		if operation.PartitionKey == "" || operation.RowKey == "" {
+			return nil, "", fmt.Errorf("operation %d: the Partition Key and Row Key must be specified", i)
+		}
+
+		part, err := changeSetWriter.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {"application/http"},
+			"Content-Transfer-Encoding": {"binary"},
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("building operation %d: %+v", i, err)
+		}
+
+		uri := fmt.Sprintf("%s/%s(PartitionKey='%s',RowKey='%s')", strings.TrimSuffix(baseUri, "/"), tableName, escapeTableEntityKey(operation.PartitionKey), escapeTableEntityKey(operation.RowKey))
+		fmt.Fprintf(part, "%s %s HTTP/1.1\r\n", operation.Type, uri)
+		fmt.Fprint(part, "Accept: application/json;odata=minimalmetadata\r\n")
+		fmt.Fprint(part, "DataServiceVersion: 3.0;NetFx\r\n")
+
+		switch operation.Type {
+		case TableEntityOperationTypeDelete:
+			fmt.Fprint(part, "If-Match: *\r\n\r\n")
+
+		case TableEntityOperationTypeInsertOrReplace:
+			entity := make(map[string]interface{}, len(operation.Properties)+2)
+			for k, v := range operation.Properties {
+				entity[k] = v
+			}
+			entity["PartitionKey"] = operation.PartitionKey
+			entity["RowKey"] = operation.RowKey
+
+			payload, err := json.Marshal(entity)
+			if err != nil {
+				return nil, "", fmt.Errorf("marshalling operation %d: %+v", i, err)
+			}
+
+			fmt.Fprint(part, "Content-Type: application/json\r\n")
+			fmt.Fprint(part, "Prefer: return-no-content\r\n")
+			fmt.Fprintf(part, "Content-Length: %d\r\n\r\n", len(payload))
+			if _, err := part.Write(payload); err != nil {
+				return nil, "", fmt.Errorf("building operation %d: %+v", i, err)
+			}
+
+		default:
+			return nil, "", fmt.Errorf("operation %d: unsupported operation type %q", i, operation.Type)
+		}
+	}
+
+	if err := changeSetWriter.Close(); err != nil {
+		return nil, "", fmt.Errorf("building change set: %+v", err)
+	}
+
+	batch := &bytes.Buffer{}
+	batchWriter := multipart.NewWriter(batch)
+	part, err := batchWriter.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {fmt.Sprintf("multipart/mixed; boundary=%s", changeSetWriter.Boundary())},
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("building batch: %+v", err)
+	}
+	if _, err := part.Write(changeSet.Bytes()); err != nil {
+		return nil, "", fmt.Errorf("building batch: %+v", err)
+	}
+	if err := batchWriter.Close(); err != nil {
+		return nil, "", fmt.Errorf("building batch: %+v", err)
+	}
+
+	return batch.Bytes(), fmt.Sprintf("multipart/mixed; boundary=%s", batchWriter.Boundary()), nil
+}
+
+// escapeTableEntityKey escapes a Partition or Row Key for use within the key predicate of an Entity URI
+func escapeTableEntityKey(input string) string {
+	return url.PathEscape(strings.ReplaceAll(input, "'", "''"))
+}
+
+// tableEntityGroupTransactionErrorIndex matches the index of the failed operation, which prefixes the error message
+var tableEntityGroupTransactionErrorIndex = regexp.MustCompile(`^(\d+):`)
+
+// parseTableEntityGroupTransactionResponse inspects the response for each operation within the change set - when an
+// operation fails the change set is rolled back and only the response for the failed operation is returned
+func parseTableEntityGroupTransactionResponse(resp *http.Response) error {
+	if resp == nil || resp.Body == nil {
+		return fmt.Errorf("the response was nil")
+	}
+
+	_, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		return fmt.Errorf("parsing batch response content type: %+v", err)
+	}
+
+	batchReader := multipart.NewReader(resp.Body, params["boundary"])
+	for {
+		batchPart, err := batchReader.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading batch response: %+v", err)
+		}
+
+		_, changeSetParams, err := mime.ParseMediaType(batchPart.Header.Get("Content-Type"))
+		if err != nil {
+			return fmt.Errorf("parsing change set response content type: %+v", err)
+		}
+
+		// the batch contains a single change set, however when the change set fails to parse the API returns the
+		// response inline rather than as a change set
+		if boundary, ok := changeSetParams["boundary"]; ok {
+			changeSetReader := multipart.NewReader(batchPart, boundary)
+			for {
+				part, err := changeSetReader.NextPart()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return fmt.Errorf("reading change set response: %+v", err)
+				}
+
+				if err := parseTableEntityOperationResponse(part); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := parseTableEntityOperationResponse(batchPart); err != nil {
+			return err
+		}
+	}
+}
+
+func parseTableEntityOperationResponse(part io.Reader) error {
+	resp, err := http.ReadResponse(bufio.NewReader(part), nil)
+	if err != nil {
+		return fmt.Errorf("parsing operation response: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading operation response: %+v", err)
+	}
+
+	result := TableEntityGroupTransactionError{
+		Index:      -1,
+		StatusCode: resp.StatusCode,
+		Message:    strings.TrimSpace(string(body)),
+	}
+
+	var payload struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message struct {
+				Value string `json:"value"`
+			} `json:"message"`
+		} `json:"odata.error"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil && payload.Error.Message.Value != "" {
+		message := payload.Error.Message.Value
+		if match := tableEntityGroupTransactionErrorIndex.FindStringSubmatch(message); len(match) == 2 {
+			result.Index, _ = strconv.Atoi(match[1])
+			message = strings.TrimPrefix(message, match[0])
+		}
+
+		// the RequestId and Time are appended to the message on new lines, which isn't useful here
+		message, _, _ = strings.Cut(message, "\n")
+		result.Message = fmt.Sprintf("%s: %s", payload.Error.Code, strings.TrimSpace(message))
+	}
+
+	return result
+}
+
+var _ client.Options = tableEntityGroupTransactionOptions{}
+
+type tableEntityGroupTransactionOptions struct{}
+
+func (o tableEntityGroupTransactionOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("Accept", "application/json;odata=minimalmetadata")
+	headers.Append("DataServiceVersion", "3.0;NetFx")
+	headers.Append("MaxDataServiceVersion", "3.0;NetFx")
+	return headers
+}
+
+func (o tableEntityGroupTransactionOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o tableEntityGroupTransactionOptions) ToQuery() *client.QueryParams {
+	return nil
+}
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/entities"
+)
+
+func TestExecuteTableEntityOperations(t *testing.T) {
+	upsert := func(partitionKey, rowKey string) TableEntityOperation {
+		return TableEntityOperation{
+			Type:         TableEntityOperationTypeInsertOrReplace,
+			PartitionKey: partitionKey,
+			RowKey:       rowKey,
+			Properties: map[string]interface{}{
+				"Count":            "1",
+				"Count@odata.type": "Edm.Int32",
+				"Description":      "some value",
+			},
+		}
+	}
+	remove := func(partitionKey, rowKey string) TableEntityOperation {
+		return TableEntityOperation{
+			Type:         TableEntityOperationTypeDelete,
+			PartitionKey: partitionKey,
+			RowKey:       rowKey,
+		}
+	}
+
+	manyUpserts := make([]TableEntityOperation, 0)
+	for i := 0; i < 150; i++ {
+		manyUpserts = append(manyUpserts, upsert("first", fmt.Sprintf("row%03d", i)))
+	}
+
+	cases := []struct {
+		Name       string
+		Operations []TableEntityOperation
+		// Missing is the set of Entities (formatted as `partition/row`) which don't exist
+		Missing map[string]bool
+		// ExpectedChangeSets is the number of operations within each change set submitted, in order
+		ExpectedChangeSets []int
+		ExpectedError      string
+	}{
+		{
+			Name:               "Single Partition",
+			Operations:         []TableEntityOperation{upsert("first", "a"), upsert("first", "b"), remove("first", "c")},
+			ExpectedChangeSets: []int{3},
+		},
+		{
+			Name:               "Multiple Partitions",
+			Operations:         []TableEntityOperation{upsert("first", "a"), upsert("second", "a"), remove("first", "b")},
+			ExpectedChangeSets: []int{2, 1},
+		},
+		{
+			Name:               "Change Set Limit",
+			Operations:         manyUpserts,
+			ExpectedChangeSets: []int{100, 50},
+		},
+		{
+			Name:               "Deleting Missing Entity",
+			Operations:         []TableEntityOperation{upsert("first", "a"), remove("first", "b"), remove("first", "c")},
+			Missing:            map[string]bool{"first/b": true},
+			ExpectedChangeSets: []int{3, 2},
+		},
+		{
+			Name:               "Replacing Entity Fails",
+			Operations:         []TableEntityOperation{remove("first", "a"), upsert("first", "b")},
+			Missing:            map[string]bool{"first/b": true},
+			ExpectedChangeSets: []int{2},
+			ExpectedError:      "operation 1 failed with status 404: ResourceNotFound: The specified resource does not exist.",
+		},
+		{
+			Name:               "Quoted Keys",
+			Operations:         []TableEntityOperation{upsert("o'neil", "a")},
+			ExpectedChangeSets: []int{1},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		changeSets := make([]int, 0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.URL.Path != "/$batch" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			requests, err := readTestTableEntityGroupTransaction(r)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(err.Error()))
+				return
+			}
+			changeSets = append(changeSets, len(requests))
+
+			w.Header().Set("Content-Type", "multipart/mixed; boundary=batchresponse_test")
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = io.WriteString(w, "--batchresponse_test\r\nContent-Type: multipart/mixed; boundary=changesetresponse_test\r\n\r\n")
+			for i, req := range requests {
+				if req.Method == http.MethodPut && !strings.Contains(req.Body, `"PartitionKey"`) {
+					writeTestTableEntityOperationResponse(w, http.StatusBadRequest, fmt.Sprintf("%d:The Partition Key is missing.", i))
+					break
+				}
+				if tc.Missing[req.Key] {
+					writeTestTableEntityOperationResponse(w, http.StatusNotFound, fmt.Sprintf("%d:The specified resource does not exist.", i))
+					break
+				}
+				writeTestTableEntityOperationResponse(w, http.StatusNoContent, "")
+			}
+			_, _ = io.WriteString(w, "--changesetresponse_test--\r\n--batchresponse_test--\r\n")
+		}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+		client, err := entities.NewWithBaseUri(server.URL)
+		if err != nil {
+			t.Fatalf("building client: %+v", err)
+		}
+
+		err = executeTableEntityOperations(ctx, client, "table", tc.Operations)
+		cancel()
+		server.Close()
+
+		if fmt.Sprint(changeSets) != fmt.Sprint(tc.ExpectedChangeSets) {
+			t.Fatalf("expected change sets of %v but got %v", tc.ExpectedChangeSets, changeSets)
+		}
+
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error containing %q", tc.ExpectedError)
+		}
+		if !strings.Contains(err.Error(), tc.ExpectedError) {
+			t.Fatalf("expected an error containing %q but got %q", tc.ExpectedError, err.Error())
+		}
+	}
+}
+
+type testTableEntityOperationRequest struct {
+	Method string
+	Key    string
+	Body   string
+}
+
+// readTestTableEntityGroupTransaction parses the operations within the change set of a batch request
+func readTestTableEntityGroupTransaction(r *http.Request) ([]testTableEntityOperationRequest, error) {
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+
+	batchPart, err := multipart.NewReader(r.Body, params["boundary"]).NextPart()
+	if err != nil {
+		return nil, err
+	}
+
+	_, changeSetParams, err := mime.ParseMediaType(batchPart.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]testTableEntityOperationRequest, 0)
+	changeSetReader := multipart.NewReader(batchPart, changeSetParams["boundary"])
+	for {
+		part, err := changeSetReader.NextPart()
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.ReadRequest(bufio.NewReader(part))
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		// e.g. /table(PartitionKey='first',RowKey='a')
+		var partitionKey, rowKey string
+		if _, err := fmt.Sscanf(strings.NewReplacer("(", " ", ",", " ", ")", " ").Replace(req.URL.Path), "/table PartitionKey=%s RowKey=%s", &partitionKey, &rowKey); err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", req.URL.Path, err)
+		}
+
+		result = append(result, testTableEntityOperationRequest{
+			Method: req.Method,
+			Key:    fmt.Sprintf("%s/%s", strings.Trim(partitionKey, "'"), strings.Trim(rowKey, "'")),
+			Body:   string(body),
+		})
+	}
+}
+
+func writeTestTableEntityOperationResponse(w io.Writer, statusCode int, message string) {
+	_, _ = io.WriteString(w, "--changesetresponse_test\r\nContent-Type: application/http\r\nContent-Transfer-Encoding: binary\r\n\r\n")
+	if message == "" {
+		_, _ = fmt.Fprintf(w, "HTTP/1.1 %d %s\r\n\r\n\r\n", statusCode, http.StatusText(statusCode))
+		return
+	}
+
+	code := "InvalidInput"
+	if statusCode == http.StatusNotFound {
+		code = "ResourceNotFound"
+	}
+	body := fmt.Sprintf(`{"odata.error":{"code":%q,"message":{"lang":"en-US","value":"%s\nRequestId:00000000-0000-0000-0000-000000000000"}}}`, code, message)
+	_, _ = fmt.Fprintf(w, "HTTP/1.1 %d %s\r\nContent-Type: application/json;odata=minimalmetadata;charset=utf-8\r\nContent-Length: %d\r\n\r\n%s\r\n", statusCode, http.StatusText(statusCode), len(body), body)
+}
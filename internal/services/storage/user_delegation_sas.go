@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	storageClients "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/accounts"
+)
+
+const (
+	// userDelegationSasSignedVersion is the version of the Storage API used to sign the token
+	userDelegationSasSignedVersion = "2023-11-03"
+
+	// userDelegationSasMaximumDuration is the maximum lifetime of a User Delegation Key, and therefore the tokens signed with it
+	userDelegationSasMaximumDuration = 7 * 24 * time.Hour
+
+	userDelegationSasTimeFormat = "2006-01-02T15:04:05Z"
+)
+
+type UserDelegationSasInput struct {
+	ContainerName string
+	BlobName      string
+	DirectoryPath string
+
+	Permissions UserDelegationSasPermissions
+
+	// Start is optional, the token is valid immediately when it's not specified
+	Start     *time.Time
+	Expiry    time.Time
+	IPAddress string
+	HttpsOnly bool
+}
+
+type UserDelegationSasPermissions struct {
+	Read        bool
+	Add         bool
+	Create      bool
+	Write       bool
+	Delete      bool
+	List        bool
+	Tags        bool
+	Move        bool
+	Execute     bool
+	Ownership   bool
+	Permissions bool
+}
+
+// String returns the signed permissions, which must be specified in this order
+func (p UserDelegationSasPermissions) String() string {
+	permissions := []struct {
+		enabled bool
+		value   string
+	}{
+		{p.Read, "r"},
+		{p.Add, "a"},
+		{p.Create, "c"},
+		{p.Write, "w"},
+		{p.Delete, "d"},
+		{p.List, "l"},
+		{p.Tags, "t"},
+		{p.Move, "m"},
+		{p.Execute, "e"},
+		{p.Ownership, "o"},
+		{p.Permissions, "p"},
+	}
+
+	out := ""
+	for _, v := range permissions {
+		if v.enabled {
+			out += v.value
+		}
+	}
+	return out
+}
+
+// signedResource returns the type of resource the token grants access to, along with its canonical path within the account
+func (input UserDelegationSasInput) signedResource() (string, string) {
+	if input.BlobName != "" {
+		return "b", fmt.Sprintf("%s/%s", input.ContainerName, input.BlobName)
+	}
+	if input.DirectoryPath != "" {
+		return "d", fmt.Sprintf("%s/%s", input.ContainerName, input.DirectoryPath)
+	}
+	return "c", input.ContainerName
+}
+
+// validateUserDelegationSasInput validates the combination of values which can't be validated by the schema
+func validateUserDelegationSasInput(input UserDelegationSasInput, isHnsEnabled bool, now time.Time) error {
+	if input.BlobName != "" && input.DirectoryPath != "" {
+		return fmt.Errorf("only one of `blob_name` and `directory_path` can be specified")
+	}
+
+	permissions := input.Permissions
+	if permissions.String() == "" {
+		return fmt.Errorf("at least one permission must be enabled")
+	}
+	if permissions.List && input.BlobName != "" {
+		return fmt.Errorf("the `list` permission can only be enabled for a Container or a Directory")
+	}
+	if !isHnsEnabled {
+		if input.DirectoryPath != "" {
+			return fmt.Errorf("`directory_path` can only be specified when the Storage Account has a Hierarchical Namespace")
+		}
+		if permissions.Move || permissions.Execute || permissions.Ownership || permissions.Permissions {
+			return fmt.Errorf("the `move`, `execute`, `ownership` and `permissions` permissions can only be enabled when the Storage Account has a Hierarchical Namespace")
+		}
+	}
+
+	if !input.Expiry.After(now) {
+		return fmt.Errorf("`expiry` must be in the future")
+	}
+	if input.Expiry.After(now.Add(userDelegationSasMaximumDuration)) {
+		return fmt.Errorf("`expiry` must be within 7 days of the current time, since a User Delegation Key can't be valid for longer")
+	}
+	if input.Start != nil && !input.Start.Before(input.Expiry) {
+		return fmt.Errorf("`start` must be before `expiry`")
+	}
+
+	return nil
+}
+
+// buildUserDelegationSas requests a User Delegation Key using the Entra ID credentials of the Provider, which is then used to
+// sign a Shared Access Signature for the Container, Blob or Directory
+func buildUserDelegationSas(ctx context.Context, storageClient *storageClients.Client, account storageClients.AccountDetails, input UserDelegationSasInput) (*string, error) {
+	if err := validateUserDelegationSasInput(input, account.IsHnsEnabled, time.Now()); err != nil {
+		return nil, err
+	}
+
+	accountsClient, err := storageClient.AccountsDataPlaneClient(ctx, account, storageClient.DataPlaneOperationSupportingOnlyAadAuth())
+	if err != nil {
+		return nil, fmt.Errorf("building Blob Storage Accounts client (a User Delegation SAS can only be generated when `storage_use_azuread` is enabled): %+v", err)
+	}
+
+	keyStart := time.Now().UTC()
+	if input.Start != nil && input.Start.Before(keyStart) {
+		keyStart = input.Start.UTC()
+	}
+	key, err := getUserDelegationKey(ctx, accountsClient, keyStart, input.Expiry.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving User Delegation Key for Storage Account %q: %+v", account.StorageAccountId.StorageAccountName, err)
+	}
+
+	return computeUserDelegationSasToken(account.StorageAccountId.StorageAccountName, *key, input)
+}
+
+// computeUserDelegationSasToken signs the Shared Access Signature using the User Delegation Key, see
+// https://learn.microsoft.com/rest/api/storageservices/create-user-delegation-sas
+func computeUserDelegationSasToken(accountName string, key UserDelegationKey, input UserDelegationSasInput) (*string, error) {
+	signingKey, err := base64.StdEncoding.DecodeString(key.Value)
+	if err != nil {
+		return nil, fmt.Errorf("decoding the User Delegation Key: %+v", err)
+	}
+
+	signedResource, resourcePath := input.signedResource()
+	signedPermissions := input.Permissions.String()
+	signedStart := ""
+	if input.Start != nil {
+		signedStart = input.Start.UTC().Format(userDelegationSasTimeFormat)
+	}
+	signedExpiry := input.Expiry.UTC().Format(userDelegationSasTimeFormat)
+	signedProtocol := "https,http"
+	if input.HttpsOnly {
+		signedProtocol = "https"
+	}
+
+	stringToSign := strings.Join([]string{
+		signedPermissions,
+		signedStart,
+		signedExpiry,
+		fmt.Sprintf("/blob/%s/%s", accountName, resourcePath),
+		key.SignedOid,
+		key.SignedTid,
+		key.SignedStart,
+		key.SignedExpiry,
+		key.SignedService,
+		key.SignedVersion,
+		"", // signedAuthorizedUserObjectId
+		"", // signedUnauthorizedUserObjectId
+		"", // signedCorrelationId
+		input.IPAddress,
+		signedProtocol,
+		userDelegationSasSignedVersion,
+		signedResource,
+		"", // signedSnapshotTime
+		"", // signedEncryptionScope
+		"", // rscc
+		"", // rscd
+		"", // rsce
+		"", // rscl
+		"", // rsct
+	}, "\n")
+
+	hash := hmac.New(sha256.New, signingKey)
+	hash.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(hash.Sum(nil))
+
+	values := url.Values{}
+	values.Set("sv", userDelegationSasSignedVersion)
+	values.Set("sr", signedResource)
+	values.Set("sp", signedPermissions)
+	if signedStart != "" {
+		values.Set("st", signedStart)
+	}
+	values.Set("se", signedExpiry)
+	values.Set("skoid", key.SignedOid)
+	values.Set("sktid", key.SignedTid)
+	values.Set("skt", key.SignedStart)
+	values.Set("ske", key.SignedExpiry)
+	values.Set("sks", key.SignedService)
+	values.Set("skv", key.SignedVersion)
+	if input.IPAddress != "" {
+		values.Set("sip", input.IPAddress)
+	}
+	values.Set("spr", signedProtocol)
+	if signedResource == "d" {
+		values.Set("sdd", strconv.Itoa(len(strings.Split(strings.Trim(input.DirectoryPath, "/"), "/"))))
+	}
+	values.Set("sig", signature)
+
+	token := "?" + values.Encode()
+	return &token, nil
+}
+
+// TODO: move this into Giovanni
+
+type UserDelegationKey struct {
+	SignedOid     string `xml:"SignedOid"`
+	SignedTid     string `xml:"SignedTid"`
+	SignedStart   string `xml:"SignedStart"`
+	SignedExpiry  string `xml:"SignedExpiry"`
+	SignedService string `xml:"SignedService"`
+	SignedVersion string `xml:"SignedVersion"`
+	Value         string `xml:"Value"`
+}
+
+type userDelegationKeyInput struct {
+	XMLName xml.Name `xml:"KeyInfo"`
+	Start   string   `xml:"Start"`
+	Expiry  string   `xml:"Expiry"`
+}
+
+func getUserDelegationKey(ctx context.Context, accountsClient *accounts.Client, start, expiry time.Time) (*UserDelegationKey, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPost,
+		OptionsObject: userDelegationKeyOptions{},
+		Path:          "/",
+	}
+
+	req, err := accountsClient.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	input := userDelegationKeyInput{
+		Start:  start.Format(userDelegationSasTimeFormat),
+		Expiry: expiry.Format(userDelegationSasTimeFormat),
+	}
+	if err := req.Marshal(&input); err != nil {
+		return nil, fmt.Errorf("marshaling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %+v", err)
+	}
+
+	var key UserDelegationKey
+	if err := resp.Unmarshal(&key); err != nil {
+		return nil, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	return &key, nil
+}
+
+var _ client.Options = userDelegationKeyOptions{}
+
+type userDelegationKeyOptions struct{}
+
+func (o userDelegationKeyOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o userDelegationKeyOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o userDelegationKeyOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("restype", "service")
+	out.Append("comp", "userdelegationkey")
+	return out
+}
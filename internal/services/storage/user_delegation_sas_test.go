@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/accounts"
+)
+
+func TestComputeUserDelegationSasToken(t *testing.T) {
+	key := UserDelegationKey{
+		SignedOid:     "11111111-1111-1111-1111-111111111111",
+		SignedTid:     "22222222-2222-2222-2222-222222222222",
+		SignedStart:   "2024-01-01T00:00:00Z",
+		SignedExpiry:  "2024-01-02T00:00:00Z",
+		SignedService: "b",
+		SignedVersion: "2023-11-03",
+		Value:         base64.StdEncoding.EncodeToString([]byte("super-secret-key")),
+	}
+	start := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	expiry := time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Name             string
+		Input            UserDelegationSasInput
+		ExpectedResource string
+		ExpectedPath     string
+		ExpectedDepth    string
+	}{
+		{
+			Name: "Container",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				Permissions:   UserDelegationSasPermissions{Read: true, List: true},
+			},
+			ExpectedResource: "c",
+			ExpectedPath:     "/blob/account1/container",
+		},
+		{
+			Name: "Blob",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				BlobName:      "some/blob.txt",
+				Permissions:   UserDelegationSasPermissions{Read: true, Write: true},
+			},
+			ExpectedResource: "b",
+			ExpectedPath:     "/blob/account1/container/some/blob.txt",
+		},
+		{
+			Name: "Directory",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				DirectoryPath: "raw/sales",
+				Permissions:   UserDelegationSasPermissions{Read: true, List: true, Execute: true},
+			},
+			ExpectedResource: "d",
+			ExpectedPath:     "/blob/account1/container/raw/sales",
+			ExpectedDepth:    "2",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		input := tc.Input
+		input.Start = &start
+		input.Expiry = expiry
+		input.IPAddress = "10.0.0.1-10.0.0.10"
+		input.HttpsOnly = true
+
+		token, err := computeUserDelegationSasToken("account1", key, input)
+		if err != nil {
+			t.Fatalf("computing token: %+v", err)
+		}
+		if !strings.HasPrefix(*token, "?") {
+			t.Fatalf("expected the token to start with `?` but got %q", *token)
+		}
+
+		values, err := url.ParseQuery(strings.TrimPrefix(*token, "?"))
+		if err != nil {
+			t.Fatalf("parsing token: %+v", err)
+		}
+
+		expected := map[string]string{
+			"sv":    "2023-11-03",
+			"sr":    tc.ExpectedResource,
+			"sp":    input.Permissions.String(),
+			"st":    "2024-01-01T01:00:00Z",
+			"se":    "2024-01-01T13:00:00Z",
+			"skoid": key.SignedOid,
+			"sktid": key.SignedTid,
+			"skt":   key.SignedStart,
+			"ske":   key.SignedExpiry,
+			"sks":   key.SignedService,
+			"skv":   key.SignedVersion,
+			"sip":   "10.0.0.1-10.0.0.10",
+			"spr":   "https",
+			"sdd":   tc.ExpectedDepth,
+		}
+		for k, v := range expected {
+			if actual := values.Get(k); actual != v {
+				t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+			}
+		}
+
+		stringToSign := strings.Join([]string{
+			input.Permissions.String(),
+			"2024-01-01T01:00:00Z",
+			"2024-01-01T13:00:00Z",
+			tc.ExpectedPath,
+			key.SignedOid,
+			key.SignedTid,
+			key.SignedStart,
+			key.SignedExpiry,
+			key.SignedService,
+			key.SignedVersion,
+			"", "", "",
+			"10.0.0.1-10.0.0.10",
+			"https",
+			"2023-11-03",
+			tc.ExpectedResource,
+			"", "", "", "", "", "", "",
+		}, "\n")
+		hash := hmac.New(sha256.New, []byte("super-secret-key"))
+		hash.Write([]byte(stringToSign))
+		if expected := base64.StdEncoding.EncodeToString(hash.Sum(nil)); values.Get("sig") != expected {
+			t.Fatalf("expected the signature %q but got %q", expected, values.Get("sig"))
+		}
+	}
+}
+
+func TestUserDelegationSasPermissionsString(t *testing.T) {
+	permissions := UserDelegationSasPermissions{
+		Read:        true,
+		Add:         true,
+		Create:      true,
+		Write:       true,
+		Delete:      true,
+		List:        true,
+		Tags:        true,
+		Move:        true,
+		Execute:     true,
+		Ownership:   true,
+		Permissions: true,
+	}
+	if actual := permissions.String(); actual != "racwdltmeop" {
+		t.Fatalf("expected %q but got %q", "racwdltmeop", actual)
+	}
+}
+
+func TestValidateUserDelegationSasInput(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	start := now.Add(2 * time.Hour)
+
+	cases := []struct {
+		Name         string
+		Input        UserDelegationSasInput
+		IsHnsEnabled bool
+		ShouldError  bool
+	}{
+		{
+			Name: "Valid",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				Permissions:   UserDelegationSasPermissions{Read: true},
+				Expiry:        now.Add(time.Hour),
+			},
+		},
+		{
+			Name: "No Permissions",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				Expiry:        now.Add(time.Hour),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "List Blob",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				BlobName:      "blob.txt",
+				Permissions:   UserDelegationSasPermissions{List: true},
+				Expiry:        now.Add(time.Hour),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Directory Without Hierarchical Namespace",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				DirectoryPath: "raw",
+				Permissions:   UserDelegationSasPermissions{Read: true},
+				Expiry:        now.Add(time.Hour),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Directory With Hierarchical Namespace",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				DirectoryPath: "raw",
+				Permissions:   UserDelegationSasPermissions{Read: true, Execute: true},
+				Expiry:        now.Add(time.Hour),
+			},
+			IsHnsEnabled: true,
+		},
+		{
+			Name: "Execute Without Hierarchical Namespace",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				Permissions:   UserDelegationSasPermissions{Execute: true},
+				Expiry:        now.Add(time.Hour),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Expired",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				Permissions:   UserDelegationSasPermissions{Read: true},
+				Expiry:        now.Add(-time.Hour),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Expiry Too Far In The Future",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				Permissions:   UserDelegationSasPermissions{Read: true},
+				Expiry:        now.Add(8 * 24 * time.Hour),
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Start After Expiry",
+			Input: UserDelegationSasInput{
+				ContainerName: "container",
+				Permissions:   UserDelegationSasPermissions{Read: true},
+				Start:         &start,
+				Expiry:        now.Add(time.Hour),
+			},
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		err := validateUserDelegationSasInput(tc.Input, tc.IsHnsEnabled, now)
+		if tc.ShouldError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !tc.ShouldError && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestGetUserDelegationKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.Method != http.MethodPost || query.Get("restype") != "service" || query.Get("comp") != "userdelegationkey" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "<Start>2024-01-01T00:00:00Z</Start>") || !strings.Contains(string(body), "<Expiry>2024-01-02T00:00:00Z</Expiry>") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?>
+<UserDelegationKey>
+  <SignedOid>11111111-1111-1111-1111-111111111111</SignedOid>
+  <SignedTid>22222222-2222-2222-2222-222222222222</SignedTid>
+  <SignedStart>2024-01-01T00:00:00Z</SignedStart>
+  <SignedExpiry>2024-01-02T00:00:00Z</SignedExpiry>
+  <SignedService>b</SignedService>
+  <SignedVersion>2023-11-03</SignedVersion>
+  <Value>c3VwZXItc2VjcmV0LWtleQ==</Value>
+</UserDelegationKey>`))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	client, err := accounts.NewWithBaseUri(server.URL)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	key, err := getUserDelegationKey(ctx, client, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("retrieving key: %+v", err)
+	}
+	if key.SignedOid != "11111111-1111-1111-1111-111111111111" || key.SignedService != "b" || key.Value != "c3VwZXItc2VjcmV0LWtleQ==" {
+		t.Fatalf("unexpected key: %+v", *key)
+	}
+}
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/amlfilesystems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/autoexportjob"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/autoexportjobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagecache/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagedLustreFileSystemAutoExportJobModel struct {
+	Name                      string                                       `tfschema:"name"`
+	ManagedLustreFileSystemId string                                       `tfschema:"managed_lustre_file_system_id"`
+	AutoExportPrefixes        []string                                     `tfschema:"auto_export_prefixes"`
+	Enabled                   bool                                         `tfschema:"enabled"`
+	Status                    []ManagedLustreFileSystemAutoExportJobStatus `tfschema:"status"`
+	Tags                      map[string]string                            `tfschema:"tags"`
+}
+
+type ManagedLustreFileSystemAutoExportJobStatus struct {
+	State                                 string `tfschema:"state"`
+	StatusCode                            string `tfschema:"status_code"`
+	StatusMessage                         string `tfschema:"status_message"`
+	ExportIterationCount                  int64  `tfschema:"export_iteration_count"`
+	CurrentIterationFilesDiscovered       int64  `tfschema:"current_iteration_files_discovered"`
+	CurrentIterationFilesExported         int64  `tfschema:"current_iteration_files_exported"`
+	CurrentIterationFilesFailed           int64  `tfschema:"current_iteration_files_failed"`
+	CurrentIterationMiBDiscovered         int64  `tfschema:"current_iteration_mib_discovered"`
+	CurrentIterationMiBExported           int64  `tfschema:"current_iteration_mib_exported"`
+	TotalFilesExported                    int64  `tfschema:"total_files_exported"`
+	TotalFilesFailed                      int64  `tfschema:"total_files_failed"`
+	TotalMiBExported                      int64  `tfschema:"total_mib_exported"`
+	LastStartedTime                       string `tfschema:"last_started_time"`
+	LastCompletionTime                    string `tfschema:"last_completion_time"`
+	LastSuccessfulIterationCompletionTime string `tfschema:"last_successful_iteration_completion_time"`
+}
+
+type ManagedLustreFileSystemAutoExportJobResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagedLustreFileSystemAutoExportJobResource{}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) ResourceType() string {
+	return "azurerm_managed_lustre_file_system_auto_export_job"
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) ModelObject() interface{} {
+	return &ManagedLustreFileSystemAutoExportJobModel{}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return autoexportjobs.ValidateAutoExportJobID
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedLustreFileSystemJobName,
+		},
+
+		"managed_lustre_file_system_id": commonschema.ResourceIDReferenceRequiredForceNew(&amlfilesystems.AmlFilesystemId{}),
+
+		"auto_export_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ImportPrefix,
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status_code": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"export_iteration_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"current_iteration_files_discovered": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"current_iteration_files_exported": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"current_iteration_files_failed": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"current_iteration_mib_discovered": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"current_iteration_mib_exported": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"total_files_exported": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"total_files_failed": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"total_mib_exported": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"last_started_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_completion_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_successful_iteration_completion_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagedLustreFileSystemAutoExportJobModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.StorageCache.AutoExportJobs
+
+			fileSystemId, err := amlfilesystems.ParseAmlFilesystemID(model.ManagedLustreFileSystemId)
+			if err != nil {
+				return err
+			}
+
+			id := autoexportjobs.NewAutoExportJobID(fileSystemId.SubscriptionId, fileSystemId.ResourceGroupName, fileSystemId.AmlFilesystemName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the auto export job has to be created in the same location as the parent file system
+			fileSystem, err := metadata.Client.StorageCache.AmlFilesystems.Get(ctx, *fileSystemId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *fileSystemId, err)
+			}
+			if fileSystem.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *fileSystemId)
+			}
+
+			properties := autoexportjob.AutoExportJob{
+				Location: location.Normalize(fileSystem.Model.Location),
+				Properties: &autoexportjob.AutoExportJobProperties{
+					AdminStatus:        expandManagedLustreFileSystemAutoExportJobAdminStatus(model.Enabled),
+					AutoExportPrefixes: pointer.To(model.AutoExportPrefixes),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			createId := autoexportjob.NewAutoExportJobID(id.SubscriptionId, id.ResourceGroupName, id.AmlFilesystemName, id.AutoExportJobName)
+			if err := metadata.Client.StorageCache.AutoExportJob.CreateOrUpdateThenPoll(ctx, createId, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache.AutoExportJob
+
+			id, err := autoexportjob.ParseAutoExportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagedLustreFileSystemAutoExportJobModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := autoexportjob.AutoExportJobUpdate{}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				properties.Properties = &autoexportjob.AutoExportJobUpdateProperties{
+					AdminStatus: expandManagedLustreFileSystemAutoExportJobAdminStatus(model.Enabled),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				properties.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache.AutoExportJobs
+
+			id, err := autoexportjobs.ParseAutoExportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagedLustreFileSystemAutoExportJobModel{
+				Name:                      id.AutoExportJobName,
+				ManagedLustreFileSystemId: amlfilesystems.NewAmlFilesystemID(id.SubscriptionId, id.ResourceGroupName, id.AmlFilesystemName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if properties := model.Properties; properties != nil {
+					state.AutoExportPrefixes = pointer.From(properties.AutoExportPrefixes)
+					state.Enabled = pointer.From(properties.AdminStatus) == autoexportjobs.AutoExportJobAdminStatusEnable
+					state.Status = flattenManagedLustreFileSystemAutoExportJobStatus(properties.Status)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache.AutoExportJobs
+
+			id, err := autoexportjobs.ParseAutoExportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandManagedLustreFileSystemAutoExportJobAdminStatus(input bool) *autoexportjob.AutoExportJobAdminStatus {
+	if input {
+		return pointer.To(autoexportjob.AutoExportJobAdminStatusEnable)
+	}
+
+	return pointer.To(autoexportjob.AutoExportJobAdminStatusDisable)
+}
+
+func flattenManagedLustreFileSystemAutoExportJobStatus(input *autoexportjobs.AutoExportJobPropertiesStatus) []ManagedLustreFileSystemAutoExportJobStatus {
+	if input == nil {
+		return []ManagedLustreFileSystemAutoExportJobStatus{}
+	}
+
+	return []ManagedLustreFileSystemAutoExportJobStatus{
+		{
+			State:                                 string(pointer.From(input.State)),
+			StatusCode:                            pointer.From(input.StatusCode),
+			StatusMessage:                         pointer.From(input.StatusMessage),
+			ExportIterationCount:                  pointer.From(input.ExportIterationCount),
+			CurrentIterationFilesDiscovered:       pointer.From(input.CurrentIterationFilesDiscovered),
+			CurrentIterationFilesExported:         pointer.From(input.CurrentIterationFilesExported),
+			CurrentIterationFilesFailed:           pointer.From(input.CurrentIterationFilesFailed),
+			CurrentIterationMiBDiscovered:         pointer.From(input.CurrentIterationMiBDiscovered),
+			CurrentIterationMiBExported:           pointer.From(input.CurrentIterationMiBExported),
+			TotalFilesExported:                    pointer.From(input.TotalFilesExported),
+			TotalFilesFailed:                      pointer.From(input.TotalFilesFailed),
+			TotalMiBExported:                      pointer.From(input.TotalMiBExported),
+			LastStartedTime:                       pointer.From(input.LastStartedTimeUTC),
+			LastCompletionTime:                    pointer.From(input.LastCompletionTimeUTC),
+			LastSuccessfulIterationCompletionTime: pointer.From(input.LastSuccessfulIterationCompletionTimeUTC),
+		},
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/autoexportjobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagedLustreFileSystemAutoExportJobResource struct{}
+
+func TestAccManagedLustreFileSystemAutoExportJob_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_auto_export_job", "test")
+	r := ManagedLustreFileSystemAutoExportJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status.0.state").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagedLustreFileSystemAutoExportJob_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_auto_export_job", "test")
+	r := ManagedLustreFileSystemAutoExportJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccManagedLustreFileSystemAutoExportJob_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_auto_export_job", "test")
+	r := ManagedLustreFileSystemAutoExportJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.disabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := autoexportjobs.ParseAutoExportJobID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := clients.StorageCache.AutoExportJobs
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_lustre_file_system_auto_export_job" "test" {
+  name                          = "acctest-export-%d"
+  managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+  auto_export_prefixes          = ["/"]
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, ManagedLustreFileSystemImportJobResource{}.template(data), data.RandomInteger)
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_lustre_file_system_auto_export_job" "import" {
+  name                          = azurerm_managed_lustre_file_system_auto_export_job.test.name
+  managed_lustre_file_system_id = azurerm_managed_lustre_file_system_auto_export_job.test.managed_lustre_file_system_id
+  auto_export_prefixes          = azurerm_managed_lustre_file_system_auto_export_job.test.auto_export_prefixes
+}
+`, r.basic(data))
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) disabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_lustre_file_system_auto_export_job" "test" {
+  name                          = "acctest-export-%d"
+  managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+  auto_export_prefixes          = ["/"]
+  enabled                       = false
+
+  tags = {
+    Env = "Test2"
+  }
+}
+`, ManagedLustreFileSystemImportJobResource{}.template(data), data.RandomInteger)
+}
@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/amlfilesystems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/importjobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagecache/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagedLustreFileSystemImportJobModel struct {
+	Name                      string                                   `tfschema:"name"`
+	ManagedLustreFileSystemId string                                   `tfschema:"managed_lustre_file_system_id"`
+	ConflictResolutionMode    string                                   `tfschema:"conflict_resolution_mode"`
+	ImportPrefixes            []string                                 `tfschema:"import_prefixes"`
+	MaximumErrors             int64                                    `tfschema:"maximum_errors"`
+	Status                    []ManagedLustreFileSystemImportJobStatus `tfschema:"status"`
+	Tags                      map[string]string                        `tfschema:"tags"`
+}
+
+type ManagedLustreFileSystemImportJobStatus struct {
+	State                  string `tfschema:"state"`
+	StatusMessage          string `tfschema:"status_message"`
+	BlobsImportedPerSecond int64  `tfschema:"blobs_imported_per_second"`
+	BlobsWalkedPerSecond   int64  `tfschema:"blobs_walked_per_second"`
+	ImportedDirectories    int64  `tfschema:"imported_directories"`
+	ImportedFiles          int64  `tfschema:"imported_files"`
+	ImportedSymlinks       int64  `tfschema:"imported_symlinks"`
+	PreexistingDirectories int64  `tfschema:"preexisting_directories"`
+	PreexistingFiles       int64  `tfschema:"preexisting_files"`
+	PreexistingSymlinks    int64  `tfschema:"preexisting_symlinks"`
+	TotalBlobsImported     int64  `tfschema:"total_blobs_imported"`
+	TotalBlobsWalked       int64  `tfschema:"total_blobs_walked"`
+	TotalConflicts         int64  `tfschema:"total_conflicts"`
+	TotalErrors            int64  `tfschema:"total_errors"`
+	LastStartedTime        string `tfschema:"last_started_time"`
+	LastCompletionTime     string `tfschema:"last_completion_time"`
+}
+
+type ManagedLustreFileSystemImportJobResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagedLustreFileSystemImportJobResource{}
+
+func (r ManagedLustreFileSystemImportJobResource) ResourceType() string {
+	return "azurerm_managed_lustre_file_system_import_job"
+}
+
+func (r ManagedLustreFileSystemImportJobResource) ModelObject() interface{} {
+	return &ManagedLustreFileSystemImportJobModel{}
+}
+
+func (r ManagedLustreFileSystemImportJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return importjobs.ValidateImportJobID
+}
+
+func (r ManagedLustreFileSystemImportJobResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedLustreFileSystemJobName,
+		},
+
+		"managed_lustre_file_system_id": commonschema.ResourceIDReferenceRequiredForceNew(&amlfilesystems.AmlFilesystemId{}),
+
+		"conflict_resolution_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(importjobs.ConflictResolutionModeFail),
+			ValidateFunc: validation.StringInSlice(importjobs.PossibleValuesForConflictResolutionMode(), false),
+		},
+
+		"import_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ImportPrefix,
+			},
+		},
+
+		"maximum_errors": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(-1),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ManagedLustreFileSystemImportJobResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"blobs_imported_per_second": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"blobs_walked_per_second": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"imported_directories": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"imported_files": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"imported_symlinks": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"preexisting_directories": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"preexisting_files": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"preexisting_symlinks": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"total_blobs_imported": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"total_blobs_walked": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"total_conflicts": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"total_errors": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"last_started_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_completion_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemImportJobResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagedLustreFileSystemImportJobModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.StorageCache.ImportJobs
+
+			fileSystemId, err := amlfilesystems.ParseAmlFilesystemID(model.ManagedLustreFileSystemId)
+			if err != nil {
+				return err
+			}
+
+			id := importjobs.NewImportJobID(fileSystemId.SubscriptionId, fileSystemId.ResourceGroupName, fileSystemId.AmlFilesystemName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the import job has to be created in the same location as the parent file system
+			fileSystem, err := metadata.Client.StorageCache.AmlFilesystems.Get(ctx, *fileSystemId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *fileSystemId, err)
+			}
+			if fileSystem.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *fileSystemId)
+			}
+
+			properties := importjobs.ImportJob{
+				Location: location.Normalize(fileSystem.Model.Location),
+				Properties: &importjobs.ImportJobProperties{
+					ConflictResolutionMode: pointer.To(importjobs.ConflictResolutionMode(model.ConflictResolutionMode)),
+					MaximumErrors:          pointer.To(model.MaximumErrors),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if len(model.ImportPrefixes) > 0 {
+				properties.Properties.ImportPrefixes = pointer.To(model.ImportPrefixes)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemImportJobResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache.ImportJobs
+
+			id, err := importjobs.ParseImportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagedLustreFileSystemImportJobModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := importjobs.ImportJobUpdate{}
+
+			if metadata.ResourceData.HasChange("tags") {
+				properties.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemImportJobResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache.ImportJobs
+
+			id, err := importjobs.ParseImportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagedLustreFileSystemImportJobModel{
+				Name:                      id.ImportJobName,
+				ManagedLustreFileSystemId: amlfilesystems.NewAmlFilesystemID(id.SubscriptionId, id.ResourceGroupName, id.AmlFilesystemName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if properties := model.Properties; properties != nil {
+					state.ConflictResolutionMode = string(pointer.From(properties.ConflictResolutionMode))
+					state.ImportPrefixes = pointer.From(properties.ImportPrefixes)
+					state.MaximumErrors = pointer.From(properties.MaximumErrors)
+					state.Status = flattenManagedLustreFileSystemImportJobStatus(properties.Status)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemImportJobResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache.ImportJobs
+
+			id, err := importjobs.ParseImportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenManagedLustreFileSystemImportJobStatus(input *importjobs.ImportJobPropertiesStatus) []ManagedLustreFileSystemImportJobStatus {
+	if input == nil {
+		return []ManagedLustreFileSystemImportJobStatus{}
+	}
+
+	return []ManagedLustreFileSystemImportJobStatus{
+		{
+			State:                  string(pointer.From(input.State)),
+			StatusMessage:          pointer.From(input.StatusMessage),
+			BlobsImportedPerSecond: pointer.From(input.BlobsImportedPerSecond),
+			BlobsWalkedPerSecond:   pointer.From(input.BlobsWalkedPerSecond),
+			ImportedDirectories:    pointer.From(input.ImportedDirectories),
+			ImportedFiles:          pointer.From(input.ImportedFiles),
+			ImportedSymlinks:       pointer.From(input.ImportedSymlinks),
+			PreexistingDirectories: pointer.From(input.PreexistingDirectories),
+			PreexistingFiles:       pointer.From(input.PreexistingFiles),
+			PreexistingSymlinks:    pointer.From(input.PreexistingSymlinks),
+			TotalBlobsImported:     pointer.From(input.TotalBlobsImported),
+			TotalBlobsWalked:       pointer.From(input.TotalBlobsWalked),
+			TotalConflicts:         pointer.From(input.TotalConflicts),
+			TotalErrors:            pointer.From(input.TotalErrors),
+			LastStartedTime:        pointer.From(input.LastStartedTime),
+			LastCompletionTime:     pointer.From(input.LastCompletionTime),
+		},
+	}
+}
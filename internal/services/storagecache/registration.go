@@ -58,5 +58,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ManagedLustreFileSystemResource{},
+		ManagedLustreFileSystemAutoExportJobResource{},
+		ManagedLustreFileSystemImportJobResource{},
 	}
 }
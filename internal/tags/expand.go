@@ -14,3 +14,23 @@ func Expand(tagsMap map[string]interface{}) map[string]*string {
 
 	return output
 }
+
+// RetainIgnored returns the Tags within `existing` which match the ignore rules `rules` and aren't defined within the
+// configuration `tagsMap` - these are the Tags added outside of Terraform which should be kept when the Tags are updated
+func RetainIgnored(tagsMap map[string]interface{}, existing map[string]string, rules IgnoreRules) map[string]string {
+	output := make(map[string]string)
+
+	for k, v := range existing {
+		if !rules.Matches(k) {
+			continue
+		}
+
+		if _, ok := caseInsensitiveLookup(tagsMap, k); ok {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
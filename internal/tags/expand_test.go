@@ -5,6 +5,7 @@ package tags
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -34,3 +35,77 @@ func TestExpand(t *testing.T) {
 		}
 	}
 }
+
+func TestRetainIgnored(t *testing.T) {
+	rules := IgnoreRules{
+		Keys:     []string{"CreatedBy"},
+		Prefixes: []string{"hidden-link:"},
+	}
+
+	testData := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Existing map[string]string
+		Expected map[string]string
+	}{
+		{
+			Name: "No Existing Tags",
+			Config: map[string]interface{}{
+				"environment": "prod",
+			},
+			Existing: nil,
+			Expected: map[string]string{},
+		},
+		{
+			Name: "Adding a Tag retains the Ignored Tags",
+			Config: map[string]interface{}{
+				"environment": "prod",
+				"team":        "platform",
+			},
+			Existing: map[string]string{
+				"environment":                  "prod",
+				"createdby":                    "someone",
+				"hidden-link:/subscriptions/0": "Resource",
+			},
+			Expected: map[string]string{
+				"createdby":                    "someone",
+				"hidden-link:/subscriptions/0": "Resource",
+			},
+		},
+		{
+			Name: "Removed Tags which aren't Ignored",
+			Config: map[string]interface{}{
+				"environment": "prod",
+			},
+			Existing: map[string]string{
+				"environment": "prod",
+				"team":        "platform",
+				"CreatedBy":   "someone",
+			},
+			Expected: map[string]string{
+				"CreatedBy": "someone",
+			},
+		},
+		{
+			Name: "Ignored Tag defined in the Configuration",
+			Config: map[string]interface{}{
+				"environment": "prod",
+				"CreatedBy":   "someone-else",
+			},
+			Existing: map[string]string{
+				"environment": "prod",
+				"createdby":   "someone",
+			},
+			Expected: map[string]string{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := RetainIgnored(v.Config, v.Existing, rules)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
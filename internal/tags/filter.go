@@ -3,7 +3,9 @@
 
 package tags
 
-import "strings"
+import (
+	"strings"
+)
 
 func Filter(tagsMap *map[string]string, tagNames ...string) *map[string]string {
 	if len(tagNames) == 0 || tagsMap == nil {
@@ -28,3 +30,54 @@ func Filter(tagsMap *map[string]string, tagNames ...string) *map[string]string {
 
 	return &tagsRet
 }
+
+// IgnoreRules are the provider-level rules used to determine which Tags should be ignored when they're added to a
+// resource outside of Terraform, for example by Azure Policies with a `modify` effect or by the Azure service itself.
+// These are configured per Provider block and are available from the Client.
+type IgnoreRules struct {
+	// Keys are the Tag keys which should be ignored (case-insensitive)
+	Keys []string
+
+	// Prefixes are the Tag key prefixes which should be ignored (case-insensitive)
+	Prefixes []string
+}
+
+// Matches returns whether the Tag key `key` matches any of the Keys or Prefixes (case-insensitive)
+func (r IgnoreRules) Matches(key string) bool {
+	for _, v := range r.Keys {
+		if v != "" && strings.EqualFold(key, v) {
+			return true
+		}
+	}
+
+	for _, v := range r.Prefixes {
+		if v != "" && len(key) >= len(v) && strings.EqualFold(key[:len(v)], v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsEmpty returns whether there are no Keys or Prefixes to ignore
+func (r IgnoreRules) IsEmpty() bool {
+	return len(r.Keys) == 0 && len(r.Prefixes) == 0
+}
+
+// RemoveIgnored returns the Tags within `tagMap` excluding any matching the ignore rules `rules`, unless they're defined
+// within `tracked` (the Tags within the configuration or state) - Tag keys are compared case-insensitively.
+func RemoveIgnored(tagMap map[string]interface{}, tracked map[string]interface{}, rules IgnoreRules) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagMap))
+
+	for k, v := range tagMap {
+		if rules.Matches(k) {
+			if _, ok := caseInsensitiveLookup(tracked, k); !ok {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
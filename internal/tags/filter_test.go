@@ -4,6 +4,7 @@
 package tags
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -25,3 +26,91 @@ func TestFilter(t *testing.T) {
 		t.Fatalf("Expected %v in filtered tag map, got %v", valueData[1], (*filtered)["key2"])
 	}
 }
+
+func TestIgnoreRulesMatches(t *testing.T) {
+	rules := IgnoreRules{
+		Keys:     []string{"CreatedBy", ""},
+		Prefixes: []string{"hidden-link:", "aks-managed-"},
+	}
+
+	testData := map[string]bool{
+		"createdby":     true,
+		"CREATEDBY":     true,
+		"CreatedByUser": false,
+		"hidden-link:/subscriptions/00000000/blah": true,
+		"Hidden-Link:/subscriptions/00000000/blah": true,
+		"aks-managed-cluster-name":                 true,
+		"aks-managed":                              false,
+		"environment":                              false,
+		"":                                         false,
+	}
+
+	for key, expected := range testData {
+		if actual := rules.Matches(key); actual != expected {
+			t.Fatalf("Expected %t for %q but got %t", expected, key, actual)
+		}
+	}
+}
+
+func TestRemoveIgnored(t *testing.T) {
+	rules := IgnoreRules{
+		Keys:     []string{"CreatedBy"},
+		Prefixes: []string{"hidden-link:"},
+	}
+
+	testData := []struct {
+		Name     string
+		Tracked  map[string]interface{}
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name: "Ignored Tags added outside of Terraform",
+			Tracked: map[string]interface{}{
+				"environment": "prod",
+			},
+			Input: map[string]interface{}{
+				"environment":                  "prod",
+				"team":                         "platform",
+				"createdby":                    "someone",
+				"hidden-link:/subscriptions/0": "Resource",
+			},
+			Expected: map[string]interface{}{
+				"environment": "prod",
+				"team":        "platform",
+			},
+		},
+		{
+			Name: "Ignored Tag defined in the Configuration or State",
+			Tracked: map[string]interface{}{
+				"environment": "prod",
+				"CreatedBy":   "someone",
+			},
+			Input: map[string]interface{}{
+				"environment": "prod",
+				"createdby":   "someone",
+			},
+			Expected: map[string]interface{}{
+				"environment": "prod",
+				"createdby":   "someone",
+			},
+		},
+		{
+			Name:    "Nothing Tracked",
+			Tracked: nil,
+			Input: map[string]interface{}{
+				"CreatedBy": "someone",
+			},
+			Expected: map[string]interface{}{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := RemoveIgnored(v.Input, v.Tracked, rules)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
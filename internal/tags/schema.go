@@ -3,7 +3,11 @@
 
 package tags
 
-import "github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
 
 // SchemaDataSource returns the Schema which should be used for Tags on a Data Source
 func SchemaDataSource() *pluginsdk.Schema {
@@ -65,3 +69,105 @@ func SchemaEnforceLowerCaseKeys() *pluginsdk.Schema {
 		},
 	}
 }
+
+// DiffSuppressWithIgnoreRules returns a function which suppresses the differences within the top-level `tags` field
+// which are caused by either:
+//
+// * a Tag matching the ignore rules returned from `rules` being added to the resource outside of Terraform
+// * the casing of a Tag key being changed by the Azure service (since Tag keys are case-insensitive in Azure)
+func DiffSuppressWithIgnoreRules(rules func() IgnoreRules) pluginsdk.SchemaDiffSuppressFunc {
+	return func(k, _, _ string, d *pluginsdk.ResourceData) bool {
+		return diffSuppress(k, d, rules())
+	}
+}
+
+func diffSuppress(k string, d *pluginsdk.ResourceData, rules IgnoreRules) bool {
+	key, ok := strings.CutPrefix(k, "tags.")
+	if !ok {
+		return false
+	}
+
+	o, n := d.GetChange("tags")
+	oldTags, _ := o.(map[string]interface{})
+	newTags, _ := n.(map[string]interface{})
+
+	if key == "%" {
+		return equalIgnoringRules(oldTags, newTags, rules)
+	}
+
+	oldValue, inOld := oldTags[key]
+	newValue, inNew := newTags[key]
+
+	switch {
+	case inOld && !inNew:
+		// the Tag is being removed - which is ignored if the Tag was added outside of Terraform, or if it's defined
+		// in the configuration using a different casing
+		if _, inConfig := caseInsensitiveLookup(newTags, key); !inConfig && rules.Matches(key) {
+			return true
+		}
+		return matchesDifferentCasing(newTags, oldTags, key, oldValue)
+
+	case !inOld && inNew:
+		// the Tag is being added, which is ignored when it's already present using a different casing
+		return matchesDifferentCasing(oldTags, newTags, key, newValue)
+	}
+
+	return false
+}
+
+// matchesDifferentCasing returns whether `other` contains the Tag `key` with the value `value` using a different
+// casing, where that key isn't also present in `tags`
+func matchesDifferentCasing(other map[string]interface{}, tags map[string]interface{}, key string, value interface{}) bool {
+	for k, v := range other {
+		if k == key || !strings.EqualFold(k, key) {
+			continue
+		}
+		if _, exists := tags[k]; exists {
+			continue
+		}
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func caseInsensitiveLookup(tags map[string]interface{}, key string) (interface{}, bool) {
+	for k, v := range tags {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// equalIgnoringRules returns whether `oldTags` and `newTags` contain the same Tags, comparing keys case-insensitively and
+// excluding any Tags in `oldTags` which match the ignore rules `rules` and aren't defined in `newTags`
+func equalIgnoringRules(oldTags map[string]interface{}, newTags map[string]interface{}, rules IgnoreRules) bool {
+	normalize := func(input map[string]interface{}, ignore bool) map[string]interface{} {
+		output := make(map[string]interface{}, len(input))
+		for k, v := range input {
+			if _, inNew := caseInsensitiveLookup(newTags, k); ignore && !inNew && rules.Matches(k) {
+				continue
+			}
+			output[strings.ToLower(k)] = v
+		}
+		return output
+	}
+
+	normalizedOld := normalize(oldTags, true)
+	normalizedNew := normalize(newTags, false)
+	if len(normalizedOld) != len(normalizedNew) {
+		return false
+	}
+
+	for k, v := range normalizedOld {
+		if other, ok := normalizedNew[k]; !ok || other != v {
+			return false
+		}
+	}
+
+	return true
+}
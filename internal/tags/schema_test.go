@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDiffSuppress(t *testing.T) {
+	tagsSchema := Schema()
+	tagsSchema.DiffSuppressFunc = DiffSuppressWithIgnoreRules(func() IgnoreRules {
+		return IgnoreRules{
+			Keys:     []string{"CreatedBy"},
+			Prefixes: []string{"hidden-link:"},
+		}
+	})
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema,
+		},
+	}
+
+	testData := []struct {
+		name         string
+		state        map[string]string
+		config       map[string]interface{}
+		expectChange bool
+		// retained are the Tags which are expected to be left unchanged
+		retained []string
+	}{
+		{
+			name: "no changes",
+			state: map[string]string{
+				"tags.%":           "1",
+				"tags.environment": "prod",
+			},
+			config: map[string]interface{}{
+				"environment": "prod",
+			},
+		},
+		{
+			name: "ignored tags added outside of terraform",
+			state: map[string]string{
+				"tags.%":                            "3",
+				"tags.environment":                  "prod",
+				"tags.createdby":                    "someone",
+				"tags.hidden-link:/subscriptions/0": "Resource",
+			},
+			config: map[string]interface{}{
+				"environment": "prod",
+			},
+		},
+		{
+			name: "ignored tag defined in the configuration",
+			state: map[string]string{
+				"tags.%":           "2",
+				"tags.environment": "prod",
+				"tags.CreatedBy":   "someone",
+			},
+			config: map[string]interface{}{
+				"environment": "prod",
+				"CreatedBy":   "someone-else",
+			},
+			expectChange: true,
+		},
+		{
+			name: "key casing changed by the service",
+			state: map[string]string{
+				"tags.%":           "1",
+				"tags.environment": "prod",
+			},
+			config: map[string]interface{}{
+				"Environment": "prod",
+			},
+		},
+		{
+			name: "key casing changed with a different value",
+			state: map[string]string{
+				"tags.%":           "1",
+				"tags.environment": "prod",
+			},
+			config: map[string]interface{}{
+				"Environment": "test",
+			},
+			expectChange: true,
+		},
+		{
+			name: "tag added alongside ignored tags",
+			state: map[string]string{
+				"tags.%":           "2",
+				"tags.environment": "prod",
+				"tags.createdby":   "someone",
+			},
+			config: map[string]interface{}{
+				"environment": "prod",
+				"team":        "platform",
+			},
+			expectChange: true,
+			retained:     []string{"createdby"},
+		},
+		{
+			name: "tag removed",
+			state: map[string]string{
+				"tags.%":           "2",
+				"tags.environment": "prod",
+				"tags.team":        "platform",
+			},
+			config: map[string]interface{}{
+				"environment": "prod",
+			},
+			expectChange: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		state := &terraform.InstanceState{
+			ID:         "test",
+			Attributes: v.state,
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"tags": v.config,
+		})
+
+		diff, err := resource.Diff(context.TODO(), state, config, nil)
+		if err != nil {
+			t.Fatalf("computing diff: %+v", err)
+		}
+
+		changed := diff != nil && len(diff.Attributes) > 0
+		if changed != v.expectChange {
+			t.Fatalf("Expected a change to be %t but got %t: %+v", v.expectChange, changed, diff)
+		}
+
+		for _, key := range v.retained {
+			if attr, ok := diff.Attributes["tags."+key]; ok {
+				t.Fatalf("Expected the Tag %q to be retained but got %+v", key, attr)
+			}
+		}
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package normalize
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// number is the canonical representation of a JSON number, so that `1`, `1.0` and `1e0` compare as equal
+// whilst still being distinguishable from the string `"1"`.
+type number string
+
+func (n number) MarshalJSON() ([]byte, error) {
+	return []byte(n), nil
+}
+
+// Json returns a canonical form of the JSON document in `input` - with object keys sorted, insignificant whitespace
+// removed, numbers canonicalised and `null` object members dropped - making it suitable for use as a StateFunc.
+// Invalid JSON is returned unchanged so that validation can surface the error to the user.
+func Json(input interface{}) string {
+	if input == nil {
+		return ""
+	}
+	v, ok := input.(string)
+	if !ok || v == "" {
+		return ""
+	}
+
+	canonical, err := decodeJson(v)
+	if err != nil {
+		return v
+	}
+
+	b, err := json.Marshal(canonical)
+	if err != nil {
+		return v
+	}
+	return string(b)
+}
+
+// JsonEqual compares two JSON documents semantically, ignoring key ordering, whitespace, number formatting and the
+// difference between an object member set to `null` and one which is missing. Any values found at `ignorePaths` in
+// either document are removed prior to comparison - see RemovePaths for the supported syntax.
+func JsonEqual(a, b string, ignorePaths ...string) (bool, error) {
+	first, err := decodeJson(a)
+	if err != nil {
+		return false, fmt.Errorf("parsing JSON %q: %+v", a, err)
+	}
+	second, err := decodeJson(b)
+	if err != nil {
+		return false, fmt.Errorf("parsing JSON %q: %+v", b, err)
+	}
+
+	return equal(first, second, ignorePaths), nil
+}
+
+// YamlEqual compares two YAML documents semantically, using the same rules as JsonEqual - each document is converted
+// to JSON prior to comparison. Since YAML is a superset of JSON, a YAML document can also be compared against its JSON
+// equivalent.
+func YamlEqual(a, b string, ignorePaths ...string) (bool, error) {
+	first, err := yamlToJson(a)
+	if err != nil {
+		return false, fmt.Errorf("parsing YAML %q: %+v", a, err)
+	}
+	second, err := yamlToJson(b)
+	if err != nil {
+		return false, fmt.Errorf("parsing YAML %q: %+v", b, err)
+	}
+
+	return JsonEqual(first, second, ignorePaths...)
+}
+
+func equal(a, b interface{}, ignorePaths []string) bool {
+	for _, path := range ignorePaths {
+		a = RemovePath(a, path)
+		b = RemovePath(b, path)
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// RemovePath returns a copy of the decoded document `input` with the value(s) found at `path` removed, `input` itself
+// isn't modified. Path segments are separated by a `.`, a numeric segment addresses an array element and `*` matches
+// every key of an object or every element of an array, for example `metadata.createdBy` or
+// `resources.*.properties.provisioningState`.
+func RemovePath(input interface{}, path string) interface{} {
+	if path == "" {
+		return input
+	}
+	return removePath(input, strings.Split(path, "."))
+}
+
+func removePath(input interface{}, segments []string) interface{} {
+	segment := segments[0]
+	last := len(segments) == 1
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			if segment != "*" && segment != key {
+				output[key] = value
+				continue
+			}
+			if last {
+				continue
+			}
+			output[key] = removePath(value, segments[1:])
+		}
+		return output
+
+	case []interface{}:
+		output := make([]interface{}, 0, len(v))
+		for i, value := range v {
+			if segment != "*" && segment != fmt.Sprint(i) {
+				output = append(output, value)
+				continue
+			}
+			if last {
+				continue
+			}
+			output = append(output, removePath(value, segments[1:]))
+		}
+		return output
+	}
+
+	return input
+}
+
+func decodeJson(input string) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(input))
+	decoder.UseNumber()
+
+	var out interface{}
+	if err := decoder.Decode(&out); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the top-level value")
+	}
+
+	return canonicalise(out)
+}
+
+// yamlToJson converts the YAML document in `input` to JSON, so that it can be compared using the JSON rules
+func yamlToJson(input string) (string, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(input), &node); err != nil {
+		return "", err
+	}
+
+	v, err := yamlNodeValue(&node)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// yamlNodeValue returns the JSON compatible value of a YAML node - mapping keys are converted to strings and
+// timestamps are kept as written, since JSON has no equivalent of either
+func yamlNodeValue(node *yaml.Node) (interface{}, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return yamlNodeValue(node.Content[0])
+
+	case yaml.AliasNode:
+		return yamlNodeValue(node.Alias)
+
+	case yaml.MappingNode:
+		output := make(map[string]interface{}, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value, err := yamlNodeValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			output[node.Content[i].Value] = value
+		}
+		return output, nil
+
+	case yaml.SequenceNode:
+		output := make([]interface{}, 0, len(node.Content))
+		for _, item := range node.Content {
+			value, err := yamlNodeValue(item)
+			if err != nil {
+				return nil, err
+			}
+			output = append(output, value)
+		}
+		return output, nil
+	}
+
+	if node.ShortTag() == "!!timestamp" {
+		return node.Value, nil
+	}
+
+	var out interface{}
+	if err := node.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// canonicalise converts a value decoded from JSON into a common representation which can be compared using
+// reflect.DeepEqual
+func canonicalise(input interface{}) (interface{}, error) {
+	switch v := input.(type) {
+	case nil:
+		return nil, nil
+
+	case map[string]interface{}:
+		output := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			// `null` and missing are treated the same way by ARM
+			if value == nil {
+				continue
+			}
+			c, err := canonicalise(value)
+			if err != nil {
+				return nil, err
+			}
+			output[key] = c
+		}
+		return output, nil
+
+	case []interface{}:
+		output := make([]interface{}, 0, len(v))
+		for _, value := range v {
+			c, err := canonicalise(value)
+			if err != nil {
+				return nil, err
+			}
+			output = append(output, c)
+		}
+		return output, nil
+
+	case json.Number:
+		return canonicalNumber(v.String())
+
+	case string, bool:
+		return v, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %T", input)
+}
+
+func canonicalNumber(input string) (number, error) {
+	r, ok := new(big.Rat).SetString(input)
+	if !ok {
+		return "", fmt.Errorf("parsing %q as a number", input)
+	}
+
+	if r.IsInt() {
+		return number(r.Num().String()), nil
+	}
+
+	// the value was parsed from a finite decimal, so the denominator only has factors of 2 and 5 and the precision
+	// needed to represent it exactly is the larger of those exponents
+	precision := 0
+	for d := new(big.Int).Set(r.Denom()); d.Cmp(big.NewInt(1)) != 0; precision++ {
+		switch {
+		case new(big.Int).Mod(d, big.NewInt(10)).Sign() == 0:
+			d.Div(d, big.NewInt(10))
+		case new(big.Int).Mod(d, big.NewInt(2)).Sign() == 0:
+			d.Div(d, big.NewInt(2))
+		default:
+			d.Div(d, big.NewInt(5))
+		}
+	}
+
+	return number(strings.TrimRight(r.FloatString(precision), "0")), nil
+}
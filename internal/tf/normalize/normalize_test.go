@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package normalize
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestJson(t *testing.T) {
+	cases := []struct {
+		Input    interface{}
+		Expected string
+	}{
+		{
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "not json",
+			Expected: "not json",
+		},
+		{
+			Input:    `{ "b": 1.50, "a": [ 1e2, null, "x" ] }`,
+			Expected: `{"a":[100,null,"x"],"b":1.5}`,
+		},
+		{
+			Input:    `{"a": null, "b": {"c": null, "d": 0.000}}`,
+			Expected: `{"b":{"d":0}}`,
+		},
+		{
+			Input:    `[12345678901234567890, -0.125]`,
+			Expected: `[12345678901234567890,-0.125]`,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := Json(tc.Input); actual != tc.Expected {
+			t.Fatalf("expected %q for %q but got %q", tc.Expected, tc.Input, actual)
+		}
+	}
+}
+
+func TestJsonEqual(t *testing.T) {
+	cases := []struct {
+		Name        string
+		A           string
+		B           string
+		IgnorePaths []string
+		Equal       bool
+		Error       bool
+	}{
+		{
+			Name:  "invalid json",
+			A:     `{}`,
+			B:     `{`,
+			Error: true,
+		},
+		{
+			Name:  "trailing data",
+			A:     `{}`,
+			B:     `{}{}`,
+			Error: true,
+		},
+		{
+			Name:  "key ordering",
+			A:     `{"a": 1, "b": {"c": true, "d": "e"}}`,
+			B:     `{"b": {"d": "e", "c": true}, "a": 1}`,
+			Equal: true,
+		},
+		{
+			Name:  "number formatting",
+			A:     `{"a": 1, "b": 0.5, "c": 100}`,
+			B:     `{"a": 1.0, "b": 5e-1, "c": 1E2}`,
+			Equal: true,
+		},
+		{
+			Name:  "different numbers",
+			A:     `{"a": 1}`,
+			B:     `{"a": 1.01}`,
+			Equal: false,
+		},
+		{
+			Name:  "number vs string",
+			A:     `{"a": 1}`,
+			B:     `{"a": "1"}`,
+			Equal: false,
+		},
+		{
+			Name:  "null vs missing",
+			A:     `{"a": 1, "b": null}`,
+			B:     `{"a": 1}`,
+			Equal: true,
+		},
+		{
+			Name:  "null in array is significant",
+			A:     `[1, null]`,
+			B:     `[1]`,
+			Equal: false,
+		},
+		{
+			Name:  "array ordering is significant",
+			A:     `[1, 2]`,
+			B:     `[2, 1]`,
+			Equal: false,
+		},
+		{
+			Name:        "ignored top-level path",
+			A:           `{"category": "General", "createdBy": "abc", "createdOn": "2024-01-01"}`,
+			B:           `{"category": "General"}`,
+			IgnorePaths: []string{"createdBy", "createdOn"},
+			Equal:       true,
+		},
+		{
+			Name:        "ignored nested path",
+			A:           `{"a": {"b": {"c": 1, "d": 2}}}`,
+			B:           `{"a": {"b": {"c": 1}}}`,
+			IgnorePaths: []string{"a.b.d"},
+			Equal:       true,
+		},
+		{
+			Name:        "ignored wildcard path",
+			A:           `{"resources": [{"name": "a", "etag": "1"}, {"name": "b", "etag": "2"}]}`,
+			B:           `{"resources": [{"name": "a"}, {"name": "b"}]}`,
+			IgnorePaths: []string{"resources.*.etag"},
+			Equal:       true,
+		},
+		{
+			Name:        "ignored path does not hide other changes",
+			A:           `{"category": "General", "createdBy": "abc"}`,
+			B:           `{"category": "Other"}`,
+			IgnorePaths: []string{"createdBy"},
+			Equal:       false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			equal, err := JsonEqual(tc.A, tc.B, tc.IgnorePaths...)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but didn't get one")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if equal != tc.Equal {
+				t.Fatalf("expected %t for %q == %q but got %t", tc.Equal, tc.A, tc.B, equal)
+			}
+		})
+	}
+}
+
+func TestYamlEqual(t *testing.T) {
+	cases := []struct {
+		Name  string
+		A     string
+		B     string
+		Equal bool
+	}{
+		{
+			Name:  "key ordering and formatting",
+			A:     "a: 1\nb:\n  - x\n  - y\n",
+			B:     "b: [x, y]\na: 1.0\n",
+			Equal: true,
+		},
+		{
+			Name:  "yaml vs json",
+			A:     "a: 1\nb:\n  c: true\n",
+			B:     `{"b": {"c": true}, "a": 1}`,
+			Equal: true,
+		},
+		{
+			Name:  "null vs missing",
+			A:     "a: 1\nb: ~\n",
+			B:     "a: 1\n",
+			Equal: true,
+		},
+		{
+			Name:  "timestamp vs string",
+			A:     "a: 2024-01-01\n",
+			B:     `{"a": "2024-01-01"}`,
+			Equal: true,
+		},
+		{
+			Name:  "different values",
+			A:     "a: 1\n",
+			B:     "a: 2\n",
+			Equal: false,
+		},
+		{
+			Name:  "number vs string",
+			A:     "a: 1\n",
+			B:     "a: \"1\"\n",
+			Equal: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			equal, err := YamlEqual(tc.A, tc.B)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if equal != tc.Equal {
+				t.Fatalf("expected %t for %q == %q but got %t", tc.Equal, tc.A, tc.B, equal)
+			}
+		})
+	}
+}
+
+func TestRemovePathDoesNotModifyInput(t *testing.T) {
+	input := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": "c",
+			"d": "e",
+		},
+		"f": []interface{}{
+			map[string]interface{}{
+				"g": "h",
+			},
+		},
+	}
+	expected := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": "c",
+			"d": "e",
+		},
+		"f": []interface{}{
+			map[string]interface{}{
+				"g": "h",
+			},
+		},
+	}
+
+	actual := RemovePath(RemovePath(input, "a.b"), "f.*.g")
+	if !reflect.DeepEqual(input, expected) {
+		t.Fatalf("expected the input to be unchanged but got %+v", input)
+	}
+	if !reflect.DeepEqual(actual, map[string]interface{}{
+		"a": map[string]interface{}{
+			"d": "e",
+		},
+		"f": []interface{}{
+			map[string]interface{}{},
+		},
+	}) {
+		t.Fatalf("unexpected result %+v", actual)
+	}
+}
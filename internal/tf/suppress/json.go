@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package suppress
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/normalize"
+)
+
+// JsonDiff suppresses the diff between two semantically equal JSON documents, see normalize.JsonEqual for details
+func JsonDiff(_, old, new string, _ *schema.ResourceData) bool {
+	equal, err := normalize.JsonEqual(old, new)
+	if err != nil {
+		return false
+	}
+
+	return equal
+}
+
+// JsonDiffIgnoringPaths returns a DiffSuppressFunc which behaves like JsonDiff, but which additionally ignores any
+// values found at the specified paths - such as fields injected by ARM (e.g. `createdBy` or `updatedOn`)
+func JsonDiffIgnoringPaths(paths ...string) schema.SchemaDiffSuppressFunc {
+	return func(_, old, new string, _ *schema.ResourceData) bool {
+		equal, err := normalize.JsonEqual(old, new, paths...)
+		if err != nil {
+			return false
+		}
+
+		return equal
+	}
+}
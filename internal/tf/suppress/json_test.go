@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package suppress
+
+import "testing"
+
+func TestJsonDiff(t *testing.T) {
+	cases := []struct {
+		Name     string
+		JsonA    string
+		JsonB    string
+		Suppress bool
+	}{
+		{
+			Name:     "empty",
+			JsonA:    "",
+			JsonB:    "",
+			Suppress: false,
+		},
+		{
+			Name:     "invalid json",
+			JsonA:    "{}",
+			JsonB:    "not json",
+			Suppress: false,
+		},
+		{
+			Name:     "different ordering",
+			JsonA:    `{"a": 1, "b": [2, 3]}`,
+			JsonB:    `{"b": [2, 3], "a": 1}`,
+			Suppress: true,
+		},
+		{
+			Name:     "arrays",
+			JsonA:    `[{"a": 1.0}]`,
+			JsonB:    `[{"a": 1}]`,
+			Suppress: true,
+		},
+		{
+			Name:     "different values",
+			JsonA:    `{"a": 1}`,
+			JsonB:    `{"a": 2}`,
+			Suppress: false,
+		},
+		{
+			// NOTE: an object member set to `null` is treated the same as a missing member, since ARM omits these
+			Name:     "null member vs missing member",
+			JsonA:    `{"a": 1, "b": null}`,
+			JsonB:    `{"a": 1}`,
+			Suppress: true,
+		},
+		{
+			Name:     "null array element vs missing element",
+			JsonA:    `[1, null]`,
+			JsonB:    `[1]`,
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if JsonDiff("test", tc.JsonA, tc.JsonB, nil) != tc.Suppress {
+				t.Fatalf("Expected JsonDiff to return %t for '%q' == '%q'", tc.Suppress, tc.JsonA, tc.JsonB)
+			}
+		})
+	}
+}
+
+func TestJsonDiffIgnoringPaths(t *testing.T) {
+	cases := []struct {
+		Name     string
+		JsonA    string
+		JsonB    string
+		Suppress bool
+	}{
+		{
+			Name:     "ignored fields",
+			JsonA:    `{"category": "General", "createdBy": "abc", "updatedOn": "2024-01-01T00:00:00Z"}`,
+			JsonB:    `{"category": "General"}`,
+			Suppress: true,
+		},
+		{
+			Name:     "other fields changed",
+			JsonA:    `{"category": "General", "createdBy": "abc"}`,
+			JsonB:    `{"category": "Other"}`,
+			Suppress: false,
+		},
+	}
+
+	suppressFunc := JsonDiffIgnoringPaths("createdBy", "updatedOn")
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if suppressFunc("test", tc.JsonA, tc.JsonB, nil) != tc.Suppress {
+				t.Fatalf("Expected JsonDiffIgnoringPaths to return %t for '%q' == '%q'", tc.Suppress, tc.JsonA, tc.JsonB)
+			}
+		})
+	}
+}
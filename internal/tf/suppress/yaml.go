@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package suppress
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/normalize"
+)
+
+// YamlDiff suppresses the diff between two semantically equal YAML documents, see normalize.YamlEqual for details
+func YamlDiff(_, old, new string, _ *schema.ResourceData) bool {
+	equal, err := normalize.YamlEqual(old, new)
+	if err != nil {
+		return false
+	}
+
+	return equal
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package suppress
+
+import "testing"
+
+func TestYamlDiff(t *testing.T) {
+	cases := []struct {
+		Name     string
+		YamlA    string
+		YamlB    string
+		Suppress bool
+	}{
+		{
+			Name:     "invalid yaml",
+			YamlA:    "a: 1",
+			YamlB:    "a: [",
+			Suppress: false,
+		},
+		{
+			Name:     "different ordering and style",
+			YamlA:    "a: 1\nb:\n  - c\n  - d\n",
+			YamlB:    "b: [c, d]\na: 1\n",
+			Suppress: true,
+		},
+		{
+			Name:     "different values",
+			YamlA:    "a: 1\n",
+			YamlB:    "a: 2\n",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if YamlDiff("test", tc.YamlA, tc.YamlB, nil) != tc.Suppress {
+				t.Fatalf("Expected YamlDiff to return %t for '%q' == '%q'", tc.Suppress, tc.YamlA, tc.YamlB)
+			}
+		})
+	}
+}